@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/furarico/octo-deck-api/internal/database"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/identicon"
+	"github.com/furarico/octo-deck-api/internal/repository"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/joho/godotenv"
+)
+
+const usage = `Usage: identicon <command>
+
+Commands:
+  status               バージョンごとのカードの数を表示する
+  plan [VERSION]       VERSIONより古いIdenticonのカードを表示する（省略時はIDENTICON_VERSION）
+  upgrade [VERSION]    VERSIONより古いIdenticonのカードをVERSIONの生成方法で作り直す（省略時はIDENTICON_VERSION）`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Printf("Warning: .env file not found: %v", err)
+	}
+
+	identiconConfig, err := identicon.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load identicon config: %v", err)
+	}
+	identiconGen, err := identicon.NewGenerator(identiconConfig, identicon.BuiltinAlgorithms())
+	if err != nil {
+		log.Fatalf("Failed to create identicon generator: %v", err)
+	}
+
+	dbConfig, err := database.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load database config: %v", err)
+	}
+	db, err := database.Connect(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer func() {
+		if err := database.Close(db); err != nil {
+			log.Printf("Failed to close database: %v", err)
+		}
+	}()
+
+	if err := database.CheckSchema(db); err != nil {
+		log.Fatalf("Database schema is not up to date, run `go run ./cmd/migrate up`: %v", err)
+	}
+
+	ctx := context.Background()
+	cardRepo := repository.NewCardRepository(db)
+	// Identiconの作り直しには描画や署名は使わない
+	cardService := service.NewCardService(cardRepo, identiconGen, nil, nil, nil, nil)
+
+	switch os.Args[1] {
+	case "status":
+		cards, err := cardRepo.FindAllCardsInDB(ctx)
+		if err != nil {
+			log.Fatalf("Failed to get cards: %v", err)
+		}
+		counts := make(map[domain.IdenticonVersion]int)
+		for _, card := range cards {
+			counts[card.IdenticonVersion]++
+		}
+		versions := make([]domain.IdenticonVersion, 0, len(counts))
+		for version := range counts {
+			versions = append(versions, version)
+		}
+		sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+		for _, version := range versions {
+			log.Printf("Version %d: %d cards", version, counts[version])
+		}
+		log.Printf("Current version for new cards: %d", identiconGen.CurrentVersion())
+
+	case "plan", "upgrade":
+		version := identiconGen.CurrentVersion()
+		if len(os.Args) >= 3 {
+			v, err := strconv.Atoi(os.Args[2])
+			if err != nil {
+				log.Fatalf("Invalid version: %s", os.Args[2])
+			}
+			version = domain.IdenticonVersion(v)
+		}
+
+		dryRun := os.Args[1] == "plan"
+		cards, err := cardService.UpgradeIdenticons(ctx, version, dryRun)
+		if err != nil {
+			log.Fatalf("Failed to upgrade identicons: %v", err)
+		}
+		for _, card := range cards {
+			if dryRun {
+				log.Printf("Would upgrade %s (ID: %s) to version %d", card.UserName, card.GithubID, version)
+			} else {
+				log.Printf("Upgraded %s (ID: %s) to version %d", card.UserName, card.GithubID, version)
+			}
+		}
+		log.Printf("Completed: %d cards", len(cards))
+
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/furarico/octo-deck-api/internal/database"
+	"github.com/joho/godotenv"
+)
+
+const usage = `Usage: migrate <command>
+
+Commands:
+  up           未適用のマイグレーションをすべて適用する
+  down [N]     適用済みのマイグレーションを新しい順にN個取り消す（省略時は1）
+  status       現在のスキーマのバージョンを表示する`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Printf("Warning: .env file not found: %v", err)
+	}
+
+	dbConfig, err := database.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load database config: %v", err)
+	}
+	db, err := database.Connect(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer func() {
+		if err := database.Close(db); err != nil {
+			log.Printf("Failed to close database: %v", err)
+		}
+	}()
+
+	switch os.Args[1] {
+	case "up":
+		applied, err := database.MigrateUp(db)
+		for _, m := range applied {
+			log.Printf("Applied %d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("Failed to migrate up: %v", err)
+		}
+		if len(applied) == 0 {
+			log.Printf("No migrations to apply")
+		}
+
+	case "down":
+		steps := 1
+		if len(os.Args) >= 3 {
+			steps, err = strconv.Atoi(os.Args[2])
+			if err != nil || steps <= 0 {
+				log.Fatalf("Invalid number of steps: %s", os.Args[2])
+			}
+		}
+		reverted, err := database.MigrateDown(db, steps)
+		for _, m := range reverted {
+			log.Printf("Reverted %d_%s", m.Version, m.Name)
+		}
+		if err != nil {
+			log.Fatalf("Failed to migrate down: %v", err)
+		}
+		if len(reverted) == 0 {
+			log.Printf("No migrations to revert")
+		}
+
+	case "status":
+		current, err := database.CurrentVersion(db)
+		if err != nil {
+			log.Fatalf("Failed to get current version: %v", err)
+		}
+		latest, err := database.LatestVersion()
+		if err != nil {
+			log.Fatalf("Failed to get latest version: %v", err)
+		}
+		log.Printf("Current version: %d, latest version: %d", current, latest)
+
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
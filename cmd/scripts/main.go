@@ -2,41 +2,42 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
-	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"strconv"
 
 	"github.com/furarico/octo-deck-api/internal/database"
 	"github.com/furarico/octo-deck-api/internal/domain"
 	"github.com/furarico/octo-deck-api/internal/github"
+	"github.com/furarico/octo-deck-api/internal/githubapp"
 	"github.com/furarico/octo-deck-api/internal/identicon"
 	"github.com/furarico/octo-deck-api/internal/repository"
 	"github.com/joho/godotenv"
-	"gorm.io/gorm"
 )
 
-// GitHubMember represents a member from the GitHub Organization Members API
-type GitHubMember struct {
-	ID        int64  `json:"id"`
-	NodeID    string `json:"node_id"`
-	Login     string `json:"login"`
-	AvatarURL string `json:"avatar_url"`
-	FullName  string `json:"full_name"`
-}
-
 func main() {
 	// 環境変数から設定を読み込み
 	if err := godotenv.Load(); err != nil {
 		log.Printf("Warning: .env file not found: %v", err)
 	}
-	token := os.Getenv("GITHUB_TOKEN")
-	if token == "" {
-		log.Fatal("GITHUB_TOKEN environment variable is required")
+	// Organizationのメンバーの取得にはGitHub App（Members の読み取り権限）を使う
+	githubAppConfig, err := githubapp.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load GitHub App config: %v", err)
 	}
+	if !githubAppConfig.Enabled() {
+		log.Fatal("GITHUB_APP_ID, GITHUB_APP_INSTALLATION_ID and GITHUB_APP_PRIVATE_KEY environment variables are required")
+	}
+	languageConfig, err := github.LoadLanguageConfig()
+	if err != nil {
+		log.Fatalf("Failed to load GitHub language config: %v", err)
+	}
+	appClient, err := githubapp.NewClient(githubAppConfig)
+	if err != nil {
+		log.Fatalf("Failed to create GitHub App client: %v", err)
+	}
+	githubClient := appClient.WithLanguageConfig(languageConfig)
 
 	orgName := os.Getenv("ORG_NAME")
 	if orgName == "" {
@@ -46,7 +47,11 @@ func main() {
 	log.Printf("Starting card creation for organization: %s", orgName)
 
 	// DB接続
-	db, err := database.ConnectWithConnectorIAMAuthN()
+	dbConfig, err := database.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load database config: %v", err)
+	}
+	db, err := database.Connect(dbConfig)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
@@ -54,11 +59,17 @@ func main() {
 
 	// リポジトリとジェネレーターの初期化
 	cardRepo := repository.NewCardRepository(db)
-	identiconGen := identicon.NewGenerator()
-	githubClient := github.NewClient(token)
+	identiconConfig, err := identicon.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load identicon config: %v", err)
+	}
+	identiconGen, err := identicon.NewGenerator(identiconConfig, identicon.BuiltinAlgorithms())
+	if err != nil {
+		log.Fatalf("Failed to create identicon generator: %v", err)
+	}
 
 	// Organization メンバー一覧を取得
-	members, err := fetchOrgMembers(token, orgName)
+	members, err := githubClient.ListOrgMembers(context.Background(), orgName)
 	if err != nil {
 		log.Fatalf("Failed to fetch organization members: %v", err)
 	}
@@ -96,7 +107,7 @@ func main() {
 			continue
 		}
 
-		if !errors.Is(err, gorm.ErrRecordNotFound) {
+		if !errors.Is(err, domain.ErrNotFound) {
 			// 予期しないエラー
 			log.Printf("Error checking card for %s (ID: %s): %v", member.Login, githubID, err)
 			failed++
@@ -104,7 +115,8 @@ func main() {
 		}
 
 		// Identicon生成
-		color, blocks, err := identiconGen.Generate(githubID)
+		version := identiconGen.CurrentVersion()
+		color, blocks, err := identiconGen.Generate(githubID, version)
 		if err != nil {
 			log.Printf("Failed to generate identicon for %s (ID: %s): %v", member.Login, githubID, err)
 			failed++
@@ -119,7 +131,8 @@ func main() {
 		}
 
 		// カード作成
-		card := domain.NewCard(githubID, member.NodeID, color, blocks, mostUsedLanguage, member.Login, member.FullName, member.AvatarURL)
+		card := domain.NewCard(githubID, member.NodeID, color, blocks, version, mostUsedLanguage, member.Login, member.Name, member.AvatarURL)
+		card.TopLanguages = github.ToDomainLanguageBreakdown(langInfo.Breakdown)
 		if err := cardRepo.Create(ctx, card); err != nil {
 			log.Printf("Failed to create card for %s (ID: %s): %v", member.Login, githubID, err)
 			failed++
@@ -132,50 +145,3 @@ func main() {
 
 	log.Printf("Completed: %d created, %d skipped, %d failed", created, skipped, failed)
 }
-
-// fetchOrgMembers fetches all members from a GitHub organization with pagination
-func fetchOrgMembers(token, orgName string) ([]GitHubMember, error) {
-	var allMembers []GitHubMember
-	page := 1
-	perPage := 100
-
-	client := &http.Client{}
-
-	for {
-		url := fmt.Sprintf("https://api.github.com/orgs/%s/members?per_page=%d&page=%d", orgName, perPage, page)
-
-		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
-		}
-
-		req.Header.Set("Accept", "application/vnd.github+json")
-		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
-
-		resp, err := client.Do(req)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch members: %w", err)
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("GitHub API returned status %d", resp.StatusCode)
-		}
-
-		var members []GitHubMember
-		if err := json.NewDecoder(resp.Body).Decode(&members); err != nil {
-			return nil, fmt.Errorf("failed to decode response: %w", err)
-		}
-
-		allMembers = append(allMembers, members...)
-
-		// ページネーション: 取得数がperPage未満なら最後のページ
-		if len(members) < perPage {
-			break
-		}
-
-		page++
-	}
-
-	return allMembers, nil
-}
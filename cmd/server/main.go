@@ -4,12 +4,17 @@ import (
 	"log"
 
 	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/cardimage"
 	"github.com/furarico/octo-deck-api/internal/database"
+	"github.com/furarico/octo-deck-api/internal/github"
+	"github.com/furarico/octo-deck-api/internal/githubapp"
+	"github.com/furarico/octo-deck-api/internal/githubcache"
 	"github.com/furarico/octo-deck-api/internal/handler"
 	"github.com/furarico/octo-deck-api/internal/identicon"
 	authmiddleware "github.com/furarico/octo-deck-api/internal/middleware"
 	"github.com/furarico/octo-deck-api/internal/repository"
 	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/furarico/octo-deck-api/internal/share"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/gin-gonic/gin"
@@ -22,7 +27,11 @@ func main() {
 		log.Printf("Warning: .env file not found: %v", err)
 	}
 
-	db, err := database.ConnectWithConnectorIAMAuthN()
+	dbConfig, err := database.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load database config: %v", err)
+	}
+	db, err := database.Connect(dbConfig)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
@@ -32,8 +41,9 @@ func main() {
 		}
 	}()
 
-	if err := database.AutoMigrate(db); err != nil {
-		log.Fatalf("Failed to migrate database: %v", err)
+	// スキーマの変更は migrate コマンドで行う。未適用のマイグレーションがある場合は起動しない
+	if err := database.CheckSchema(db); err != nil {
+		log.Fatalf("Database schema is not up to date, run `go run ./cmd/migrate up`: %v", err)
 	}
 
 	spec, err := openapi3.NewLoader().LoadFromFile("openapi/openapi.yaml")
@@ -43,27 +53,101 @@ func main() {
 	spec.Servers = nil
 
 	router := gin.Default()
+	router.Use(handler.RequestErrorMiddleware)
 
 	router.Use(oapimiddleware.OapiRequestValidatorWithOptions(spec, &oapimiddleware.Options{
+		ErrorHandler: handler.ValidationErrorHandler,
 		Options: openapi3filter.Options{
 			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
 		},
 	}))
-	router.Use(authmiddleware.AuthMiddleware())
+	// GitHub APIの応答はユーザーをまたいでキャッシュし、レート制限に当たりにくくする
+	githubCacheConfig, err := githubcache.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load GitHub cache config: %v", err)
+	}
+	tokenCacheConfig, err := authmiddleware.LoadTokenCacheConfig()
+	if err != nil {
+		log.Fatalf("Failed to load token cache config: %v", err)
+	}
+	// 言語を集計するリポジトリの範囲は、ユーザーのトークンでもGitHub Appでも同じにする
+	languageConfig, err := github.LoadLanguageConfig()
+	if err != nil {
+		log.Fatalf("Failed to load GitHub language config: %v", err)
+	}
+	// レート制限の残りはトークンごとに保持し、リクエストをまたいで同時実行数の調整に使う
+	rateLimiters := github.NewRateLimiters(tokenCacheConfig.Size)
+	authOptions := authmiddleware.Options{
+		// カードの画像はREADMEやSNSに貼り付けられるように認証なしで公開する
+		PublicRoutes: []string{"/cards/:githubId/image"},
+		TokenCache:   authmiddleware.NewTokenCache(tokenCacheConfig),
+		NewClient: func(token string) service.GitHubClient {
+			return rateLimiters.NewClient(token).WithLanguageConfig(languageConfig)
+		},
+	}
+	var githubCache *githubcache.Cache
+	if store := githubcache.NewStore(githubCacheConfig, repository.NewGitHubCacheRepository(db)); store != nil {
+		githubCache = githubcache.New(store, githubCacheConfig)
+		authOptions.WrapClient = githubCache.Wrap
+	}
+	router.Use(authmiddleware.AuthMiddleware(authOptions))
+
+	// 全カードの更新などサービスとして行う処理は、ユーザーのトークンではなくGitHub Appで行う
+	githubAppConfig, err := githubapp.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load GitHub App config: %v", err)
+	}
+	var systemGitHubClient service.GitHubClient
+	if githubAppConfig.Enabled() {
+		appClient, err := githubapp.NewClient(githubAppConfig)
+		if err != nil {
+			log.Fatalf("Failed to create GitHub App client: %v", err)
+		}
+		systemGitHubClient = appClient.WithLanguageConfig(languageConfig)
+		if githubCache != nil {
+			systemGitHubClient = githubCache.Wrap(systemGitHubClient)
+		}
+	} else {
+		log.Printf("Warning: GitHub App is not configured, system tasks use the caller's token")
+	}
+
+	identiconConfig, err := identicon.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load identicon config: %v", err)
+	}
+	identiconGen, err := identicon.NewGenerator(identiconConfig, identicon.BuiltinAlgorithms())
+	if err != nil {
+		log.Fatalf("Failed to create identicon generator: %v", err)
+	}
+	identiconRenderer := identicon.NewRenderer()
+	cardImageRenderer, err := cardimage.NewRenderer(cardimage.NewHTTPAvatarFetcher())
+	if err != nil {
+		log.Fatalf("Failed to create card image renderer: %v", err)
+	}
 
-	identiconGen := identicon.NewGenerator()
+	shareConfig, err := share.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load card share config: %v", err)
+	}
+	shareSigner, err := share.NewSigner(shareConfig)
+	if err != nil {
+		log.Fatalf("Failed to create card share signer: %v", err)
+	}
 
 	cardRepository := repository.NewCardRepository(db)
 	communityRepository := repository.NewCommunityRepository(db)
+	contributionSnapshotRepository := repository.NewContributionSnapshotRepository(db)
 	//cardRepository := repository.NewMockCardRepository()
-	cardService := service.NewCardService(cardRepository, identiconGen)
-	communityService := service.NewCommunityService(communityRepository, cardRepository)
-	statsService := service.NewStatsService()
+	cardService := service.NewCardService(cardRepository, identiconGen, identiconRenderer, cardImageRenderer, shareSigner, systemGitHubClient)
+	communityService := service.NewCommunityService(communityRepository, cardRepository, contributionSnapshotRepository)
+	statsService := service.NewStatsService(contributionSnapshotRepository)
 	h := handler.NewHandler(cardService, communityService, statsService)
 
 	// StrictServerInterface を使用してハンドラーを登録
-	strictHandler := api.NewStrictHandler(h, nil)
-	api.RegisterHandlers(router, strictHandler)
+	// ErrorHandlerMiddleware でドメインエラーをHTTPステータスコードに変換する
+	// リクエストを解釈できなかった場合は RequestErrorMiddleware と RequestErrorHandler で同じ形式のエラーを返す
+	strictHandler := api.NewStrictHandler(h, []api.StrictMiddlewareFunc{handler.ErrorHandlerMiddleware})
+	api.RegisterHandlersWithOptions(router, strictHandler, api.GinServerOptions{ErrorHandler: handler.RequestErrorHandler})
 
 	addr := ":8080"
 	log.Printf("Server starting on %s", addr)
@@ -0,0 +1,183 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/database"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/github"
+	"github.com/furarico/octo-deck-api/internal/githubapp"
+	"github.com/furarico/octo-deck-api/internal/identicon"
+	"github.com/furarico/octo-deck-api/internal/repository"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/furarico/octo-deck-api/internal/worker"
+	"github.com/joho/godotenv"
+)
+
+const usage = `Usage: worker <command>
+
+Commands:
+  run                  期限が来たジョブを定期的に実行し続ける
+  once JOB             JOBを前回の実行日時に関わらずすぐに1回実行する
+  history [JOB] [N]    ジョブの実行履歴を新しい順にN件表示する（省略時はすべてのジョブを20件）
+
+Jobs:
+  refresh-cards        更新が古いカードをGitHubの最新の情報で更新する
+  refresh-communities  期間中のコミュニティのHighlightedCardを計算し直す
+  purge-communities    復元期間を過ぎた論理削除済みのコミュニティを物理削除する`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err := godotenv.Load(); err != nil {
+		log.Printf("Warning: .env file not found: %v", err)
+	}
+
+	dbConfig, err := database.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load database config: %v", err)
+	}
+	db, err := database.Connect(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer func() {
+		if err := database.Close(db); err != nil {
+			log.Printf("Failed to close database: %v", err)
+		}
+	}()
+
+	if err := database.CheckSchema(db); err != nil {
+		log.Fatalf("Database schema is not up to date, run `go run ./cmd/migrate up`: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	jobRepo := repository.NewJobRepository(db)
+
+	if os.Args[1] == "history" {
+		printHistory(ctx, jobRepo, os.Args[2:])
+		return
+	}
+
+	workerConfig, err := worker.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load worker config: %v", err)
+	}
+
+	// ジョブはユーザーのトークンを使わずにGitHub Appで実行する
+	githubAppConfig, err := githubapp.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load GitHub App config: %v", err)
+	}
+	if !githubAppConfig.Enabled() {
+		log.Fatal("GITHUB_APP_ID, GITHUB_APP_INSTALLATION_ID and GITHUB_APP_PRIVATE_KEY environment variables are required")
+	}
+	languageConfig, err := github.LoadLanguageConfig()
+	if err != nil {
+		log.Fatalf("Failed to load GitHub language config: %v", err)
+	}
+	appClient, err := githubapp.NewClient(githubAppConfig)
+	if err != nil {
+		log.Fatalf("Failed to create GitHub App client: %v", err)
+	}
+	githubClient := appClient.WithLanguageConfig(languageConfig)
+
+	identiconConfig, err := identicon.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load identicon config: %v", err)
+	}
+	identiconGen, err := identicon.NewGenerator(identiconConfig, identicon.BuiltinAlgorithms())
+	if err != nil {
+		log.Fatalf("Failed to create identicon generator: %v", err)
+	}
+
+	cardRepository := repository.NewCardRepository(db)
+	communityRepository := repository.NewCommunityRepository(db)
+	contributionSnapshotRepository := repository.NewContributionSnapshotRepository(db)
+	// ジョブでは画像の描画や署名は使わない
+	cardService := service.NewCardService(cardRepository, identiconGen, nil, nil, nil, githubClient)
+	communityService := service.NewCommunityService(communityRepository, cardRepository, contributionSnapshotRepository)
+
+	jobs := worker.NewJobs(workerConfig, cardService, communityService, githubClient)
+	scheduler := worker.NewScheduler(jobRepo, jobs, workerConfig)
+
+	switch os.Args[1] {
+	case "run":
+		log.Printf("Worker started")
+		if err := scheduler.Run(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Fatalf("Worker stopped: %v", err)
+		}
+		log.Printf("Worker stopped")
+
+	case "once":
+		if len(os.Args) < 3 {
+			fmt.Fprintln(os.Stderr, usage)
+			os.Exit(2)
+		}
+		run, err := scheduler.RunJob(ctx, os.Args[2])
+		if err != nil {
+			log.Fatalf("Failed to run job: %v", err)
+		}
+		if run == nil {
+			log.Printf("Job %s is running on another instance", os.Args[2])
+			return
+		}
+		if run.Error != "" {
+			log.Fatalf("Job %s failed: %s", run.JobName, run.Error)
+		}
+
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+}
+
+// jobHistoryRepository は実行履歴の表示に必要なRepositoryのインターフェース
+type jobHistoryRepository interface {
+	FindRuns(ctx context.Context, jobName string, limit int) ([]domain.JobRun, error)
+}
+
+// printHistory はジョブの実行履歴を表示する
+func printHistory(ctx context.Context, jobRepo jobHistoryRepository, args []string) {
+	jobName := ""
+	limit := 20
+	if len(args) >= 1 {
+		if n, err := strconv.Atoi(args[0]); err == nil {
+			limit = n
+		} else {
+			jobName = args[0]
+		}
+	}
+	if len(args) >= 2 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n <= 0 {
+			log.Fatalf("Invalid number of runs: %s", args[1])
+		}
+		limit = n
+	}
+
+	runs, err := jobRepo.FindRuns(ctx, jobName, limit)
+	if err != nil {
+		log.Fatalf("Failed to get job runs: %v", err)
+	}
+	for _, run := range runs {
+		duration := "-"
+		if run.FinishedAt != nil {
+			duration = run.FinishedAt.Sub(run.StartedAt).Round(time.Second).String()
+		}
+		fmt.Printf("%s  %-20s %-9s %8s  processed=%d  %s\n",
+			run.StartedAt.Local().Format(time.DateTime), run.JobName, run.Status, duration, run.ProcessedCount, run.Error)
+	}
+}
@@ -21,6 +21,74 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for CardImageFormat.
+const (
+	CardImageFormatPng CardImageFormat = "png"
+	CardImageFormatSvg CardImageFormat = "svg"
+)
+
+// Defines values for CollectSource.
+const (
+	CollectSourceManual CollectSource = "manual"
+	CollectSourceNfc    CollectSource = "nfc"
+	CollectSourceQr     CollectSource = "qr"
+)
+
+// Defines values for UserStatsSummaryMostActiveWeekday.
+const (
+	Friday    UserStatsSummaryMostActiveWeekday = "friday"
+	Monday    UserStatsSummaryMostActiveWeekday = "monday"
+	Saturday  UserStatsSummaryMostActiveWeekday = "saturday"
+	Sunday    UserStatsSummaryMostActiveWeekday = "sunday"
+	Thursday  UserStatsSummaryMostActiveWeekday = "thursday"
+	Tuesday   UserStatsSummaryMostActiveWeekday = "tuesday"
+	Wednesday UserStatsSummaryMostActiveWeekday = "wednesday"
+)
+
+// Defines values for Order.
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+// Defines values for GetCardsParamsOrder.
+const (
+	GetCardsParamsOrderAsc  GetCardsParamsOrder = "asc"
+	GetCardsParamsOrderDesc GetCardsParamsOrder = "desc"
+)
+
+// Defines values for GetCardsParamsSort.
+const (
+	GetCardsParamsSortCollectedAt GetCardsParamsSort = "collected_at"
+	GetCardsParamsSortLanguage    GetCardsParamsSort = "language"
+	GetCardsParamsSortUserName    GetCardsParamsSort = "user_name"
+)
+
+// Defines values for GetCommunitiesParamsOrder.
+const (
+	GetCommunitiesParamsOrderAsc  GetCommunitiesParamsOrder = "asc"
+	GetCommunitiesParamsOrderDesc GetCommunitiesParamsOrder = "desc"
+)
+
+// Defines values for GetCommunityCardsParamsOrder.
+const (
+	GetCommunityCardsParamsOrderAsc  GetCommunityCardsParamsOrder = "asc"
+	GetCommunityCardsParamsOrderDesc GetCommunityCardsParamsOrder = "desc"
+)
+
+// Defines values for GetCommunityCardsParamsSort.
+const (
+	GetCommunityCardsParamsSortContribution GetCommunityCardsParamsSort = "contribution"
+	GetCommunityCardsParamsSortLanguage     GetCommunityCardsParamsSort = "language"
+	GetCommunityCardsParamsSortUserName     GetCommunityCardsParamsSort = "user_name"
+)
+
+// Defines values for UpdateCommunityMemberRoleJSONBodyRole.
+const (
+	Admin  UpdateCommunityMemberRoleJSONBodyRole = "admin"
+	Member UpdateCommunityMemberRoleJSONBodyRole = "member"
+)
+
 // Card defines model for Card.
 type Card struct {
 	FullName         string    `json:"fullName"`
@@ -28,23 +96,114 @@ type Card struct {
 	IconUrl          string    `json:"iconUrl"`
 	Identicon        Identicon `json:"identicon"`
 	MostUsedLanguage Language  `json:"mostUsedLanguage"`
-	UserName         string    `json:"userName"`
+
+	// TopLanguages コードの量が多い順の言語の内訳（最大10件）。言語のバーの表示に使う
+	TopLanguages []LanguageBreakdown `json:"topLanguages"`
+	UserName     string              `json:"userName"`
+}
+
+// CardExchange defines model for CardExchange.
+type CardExchange struct {
+	AcceptedAt   *time.Time `json:"acceptedAt,omitempty"`
+	AcceptedCard *Card      `json:"acceptedCard,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	ExpiresAt    time.Time  `json:"expiresAt"`
+	OfferedCard  Card       `json:"offeredCard"`
+
+	// Status カード交換の状態 pending / accepted / expired
+	Status string `json:"status"`
+
+	// Token 交換トークン。QRコードやNFCで相手に渡す
+	Token string `json:"token"`
+}
+
+// CardImageFormat カード全体の画像の形式 svg / png
+type CardImageFormat string
+
+// CardSettings defines model for CardSettings.
+type CardSettings struct {
+	// IncludePrivateRepositories プライベートリポジトリも言語の内訳に含めるかどうか。含める場合、言語は自分でカードを取得したときだけ更新する
+	IncludePrivateRepositories bool `json:"includePrivateRepositories"`
+}
+
+// CardShare defines model for CardShare.
+type CardShare struct {
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Payload 署名付きのペイロード。QRコードやNFCにそのまま載せる
+	Payload string `json:"payload"`
+}
+
+// CollectSource カードを集めた方法 qr / nfc / manual
+type CollectSource string
+
+// CollectedCard defines model for CollectedCard.
+type CollectedCard struct {
+	CollectedAt time.Time `json:"collectedAt"`
+
+	// CommunityId カードを交換したコミュニティのID
+	CommunityId      *string   `json:"communityId,omitempty"`
+	FullName         string    `json:"fullName"`
+	GithubId         string    `json:"githubId"`
+	IconUrl          string    `json:"iconUrl"`
+	Identicon        Identicon `json:"identicon"`
+	MostUsedLanguage Language  `json:"mostUsedLanguage"`
+
+	// Note カードに付けたメモ
+	Note *string `json:"note,omitempty"`
+
+	// Source カードを集めた方法 qr / nfc / manual
+	Source CollectSource `json:"source"`
+
+	// TopLanguages コードの量が多い順の言語の内訳（最大10件）。言語のバーの表示に使う
+	TopLanguages []LanguageBreakdown `json:"topLanguages"`
+	UserName     string              `json:"userName"`
 }
 
 // Community defines model for Community.
 type Community struct {
-	EndDateTime   time.Time `json:"endDateTime"`
-	Id            string    `json:"id"`
-	Name          string    `json:"name"`
+	EndDateTime time.Time `json:"endDateTime"`
+	Id          string    `json:"id"`
+	Name        string    `json:"name"`
+
+	// OwnerGithubId コミュニティのオーナーのGitHub ID
+	OwnerGithubId string    `json:"ownerGithubId"`
 	StartDateTime time.Time `json:"startDateTime"`
 }
 
+// CommunityInvite defines model for CommunityInvite.
+type CommunityInvite struct {
+	// Code 招待コード。QRコードにして配布できる
+	Code        string    `json:"code"`
+	CommunityId string    `json:"communityId"`
+	CreatedAt   time.Time `json:"createdAt"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+	SingleUse   bool      `json:"singleUse"`
+	UsedCount   int       `json:"usedCount"`
+}
+
+// CommunityMember defines model for CommunityMember.
+type CommunityMember struct {
+	Card Card `json:"card"`
+
+	// Role コミュニティ内での役割 owner / admin / member
+	Role string `json:"role"`
+}
+
 // Contribution defines model for Contribution.
 type Contribution struct {
 	Count int32              `json:"count"`
 	Date  openapi_types.Date `json:"date"`
 }
 
+// ContributionAggregate defines model for ContributionAggregate.
+type ContributionAggregate struct {
+	Count int32 `json:"count"`
+
+	// Start 週（日曜日始まり）または月の初日
+	Start openapi_types.Date `json:"start"`
+}
+
 // ContributionDetail defines model for ContributionDetail.
 type ContributionDetail struct {
 	CommitCount      int32 `json:"commitCount"`
@@ -53,6 +212,41 @@ type ContributionDetail struct {
 	ReviewCount      int32 `json:"reviewCount"`
 }
 
+// ContributionDetailSnapshot ある期間のコントリビューションの内訳の記録
+type ContributionDetailSnapshot struct {
+	ContributionDetail ContributionDetail `json:"contributionDetail"`
+
+	// FetchedAt GitHubから取得した日時
+	FetchedAt         time.Time `json:"fetchedAt"`
+	From              time.Time `json:"from"`
+	To                time.Time `json:"to"`
+	TotalContribution int32     `json:"totalContribution"`
+}
+
+// ContributionHistory defines model for ContributionHistory.
+type ContributionHistory struct {
+	// ContributionDetail ある期間のコントリビューションの内訳の記録
+	ContributionDetail *ContributionDetailSnapshot `json:"contributionDetail,omitempty"`
+
+	// Contributions 記録がある日のコントリビューション数
+	Contributions     []Contribution     `json:"contributions"`
+	From              openapi_types.Date `json:"from"`
+	To                openapi_types.Date `json:"to"`
+	TotalContribution int32              `json:"totalContribution"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	// Code エラーの種類 invalid_argument / unauthorized / forbidden / not_found / already_exists / upstream_unavailable / rate_limited / internal
+	Code string `json:"code"`
+
+	// Message エラーの詳細
+	Message string `json:"message"`
+
+	// ResetAt レート制限が解除される日時（codeがrate_limitedの場合のみ）
+	ResetAt *time.Time `json:"resetAt,omitempty"`
+}
+
 // HighlightedCard defines model for HighlightedCard.
 type HighlightedCard struct {
 	BestCommitter     Card `json:"bestCommitter"`
@@ -64,7 +258,7 @@ type HighlightedCard struct {
 
 // Identicon defines model for Identicon.
 type Identicon struct {
-	// Blocks 5x5の二次元配列。trueがブロック
+	// Blocks 正方形の二次元配列（5x5 または 7x7）。trueがブロック
 	Blocks [][]bool `json:"blocks"`
 
 	// Color カラーコード 例: #RRGGBB
@@ -80,17 +274,214 @@ type Language struct {
 	Name string `json:"name"`
 }
 
+// LanguageBreakdown defines model for LanguageBreakdown.
+type LanguageBreakdown struct {
+	// Bytes リポジトリのコードの量（バイト）。カードの設定で含める場合はプライベートリポジトリも含む
+	Bytes int64 `json:"bytes"`
+
+	// Color カラーコード 例: #RRGGBB
+	Color string `json:"color"`
+
+	// Name 言語名
+	Name string `json:"name"`
+
+	// Percentage 全ての言語の合計に対する割合（0〜100、小数第1位まで）
+	Percentage float64 `json:"percentage"`
+}
+
 // UserStats defines model for UserStats.
 type UserStats struct {
 	ContributionDetail ContributionDetail `json:"contributionDetail"`
 	Contributions      []Contribution     `json:"contributions"`
-	MostUsedLanguage   Language           `json:"mostUsedLanguage"`
-	TotalContribution  int32              `json:"totalContribution"`
+
+	// From 実際に集計した期間の始まり
+	From             time.Time `json:"from"`
+	MostUsedLanguage Language  `json:"mostUsedLanguage"`
+
+	// Summary 統計情報から求めた連続記録や集計
+	Summary UserStatsSummary `json:"summary"`
+
+	// To 実際に集計した期間の終わり
+	To time.Time `json:"to"`
+
+	// TopLanguages コードの量が多い順の言語の内訳（最大10件）
+	TopLanguages      []LanguageBreakdown `json:"topLanguages"`
+	TotalContribution int32               `json:"totalContribution"`
+}
+
+// UserStatsSummary 統計情報から求めた連続記録や集計
+type UserStatsSummary struct {
+	// CurrentStreak 期間の最終日（その日がまだ0件の場合は前日）まで続いている、コントリビューションがある日の連続日数
+	CurrentStreak int32 `json:"currentStreak"`
+
+	// LongestStreak 期間の中で最も長く続いた、コントリビューションがある日の連続日数
+	LongestStreak int32                   `json:"longestStreak"`
+	Monthly       []ContributionAggregate `json:"monthly"`
+
+	// MostActiveHourBucket コントリビューションが最も多い3時間ごとの時間帯の開始時刻。時刻が分かるIssue・PR・レビューから求め、ない場合は含まない
+	MostActiveHourBucket *int32 `json:"mostActiveHourBucket,omitempty"`
+
+	// MostActiveWeekday コントリビューションが最も多い曜日。コントリビューションがない場合は含まない
+	MostActiveWeekday *UserStatsSummaryMostActiveWeekday `json:"mostActiveWeekday,omitempty"`
+
+	// PeerCount 比べた仲間の人数
+	PeerCount int32 `json:"peerCount"`
+
+	// Percentile 同じコミュニティの仲間のうち、期間の合計が自分より少ない人の割合。仲間の記録がない場合は含まない
+	Percentile *float64 `json:"percentile,omitempty"`
+
+	// Timezone 連続記録や時間帯を求めたタイムゾーン
+	Timezone string                  `json:"timezone"`
+	Weekly   []ContributionAggregate `json:"weekly"`
+}
+
+// UserStatsSummaryMostActiveWeekday コントリビューションが最も多い曜日。コントリビューションがない場合は含まない
+type UserStatsSummaryMostActiveWeekday string
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// ImageBackground defines model for ImageBackground.
+type ImageBackground = string
+
+// ImagePadding defines model for ImagePadding.
+type ImagePadding = int
+
+// ImageSize defines model for ImageSize.
+type ImageSize = int
+
+// Limit defines model for Limit.
+type Limit = int
+
+// Order defines model for Order.
+type Order string
+
+// BadGateway defines model for BadGateway.
+type BadGateway = Error
+
+// BadRequest defines model for BadRequest.
+type BadRequest = Error
+
+// Conflict defines model for Conflict.
+type Conflict = Error
+
+// Forbidden defines model for Forbidden.
+type Forbidden = Error
+
+// NotFound defines model for NotFound.
+type NotFound = Error
+
+// ServiceUnavailable defines model for ServiceUnavailable.
+type ServiceUnavailable = Error
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = Error
+
+// GetCardsParams defines parameters for GetCards.
+type GetCardsParams struct {
+	// Q ユーザー名またはフルネームに含まれる文字列（大文字小文字を区別しない）
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Language 最も使われている言語名（大文字小文字を区別しない）
+	Language *string `form:"language,omitempty" json:"language,omitempty"`
+
+	// CommunityId カードの持ち主と自分がともに参加しているコミュニティのID
+	CommunityId *string `form:"communityId,omitempty" json:"communityId,omitempty"`
+
+	// Limit 1ページに含める件数
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスに含まれるnextCursor。指定しない場合は最初のページを返す
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Order 並び順
+	Order *GetCardsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Sort 並び替えの項目
+	Sort *GetCardsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 }
 
+// GetCardsParamsOrder defines parameters for GetCards.
+type GetCardsParamsOrder string
+
+// GetCardsParamsSort defines parameters for GetCards.
+type GetCardsParamsSort string
+
 // AddCardToDeckTextBody defines parameters for AddCardToDeck.
 type AddCardToDeckTextBody = string
 
+// AddCardToDeckParams defines parameters for AddCardToDeck.
+type AddCardToDeckParams struct {
+	// Source カードを集めた方法
+	Source *CollectSource `form:"source,omitempty" json:"source,omitempty"`
+
+	// CommunityId カードを交換したコミュニティのID
+	CommunityId *string `form:"communityId,omitempty" json:"communityId,omitempty"`
+
+	// Note カードに付けるメモ
+	Note *string `form:"note,omitempty" json:"note,omitempty"`
+}
+
+// GetCardIdenticonPngParams defines parameters for GetCardIdenticonPng.
+type GetCardIdenticonPngParams struct {
+	// Size 画像の一辺の大きさ（px）
+	Size *ImageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Padding 画像の周りの余白（px）。省略した場合は一辺の大きさの1/12
+	Padding *ImagePadding `form:"padding,omitempty" json:"padding,omitempty"`
+
+	// Background 背景色。ffffff のような16進数のカラーコード（#は省略できる）。省略した場合は透明
+	Background *ImageBackground `form:"background,omitempty" json:"background,omitempty"`
+
+	// IfNoneMatch 前のレスポンスのETag
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetCardIdenticonSvgParams defines parameters for GetCardIdenticonSvg.
+type GetCardIdenticonSvgParams struct {
+	// Size 画像の一辺の大きさ（px）
+	Size *ImageSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// Padding 画像の周りの余白（px）。省略した場合は一辺の大きさの1/12
+	Padding *ImagePadding `form:"padding,omitempty" json:"padding,omitempty"`
+
+	// Background 背景色。ffffff のような16進数のカラーコード（#は省略できる）。省略した場合は透明
+	Background *ImageBackground `form:"background,omitempty" json:"background,omitempty"`
+
+	// IfNoneMatch 前のレスポンスのETag
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetCardImageParams defines parameters for GetCardImage.
+type GetCardImageParams struct {
+	// Format 画像の形式
+	Format *CardImageFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Contributions trueの場合、保存済みのコントリビューションの記録があれば過去1年間のコントリビュート数も描く
+	Contributions *bool `form:"contributions,omitempty" json:"contributions,omitempty"`
+
+	// IfNoneMatch 前のレスポンスのETag
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// GetCommunitiesParams defines parameters for GetCommunities.
+type GetCommunitiesParams struct {
+	// Limit 1ページに含める件数
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスに含まれるnextCursor。指定しない場合は最初のページを返す
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Order 並び順
+	Order *GetCommunitiesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// GetCommunitiesParamsOrder defines parameters for GetCommunities.
+type GetCommunitiesParamsOrder string
+
 // CreateCommunityJSONBody defines parameters for CreateCommunity.
 type CreateCommunityJSONBody struct {
 	EndDateTime   time.Time `json:"endDateTime"`
@@ -98,23 +489,188 @@ type CreateCommunityJSONBody struct {
 	StartDateTime time.Time `json:"startDateTime"`
 }
 
+// JoinCommunityJSONBody defines parameters for JoinCommunity.
+type JoinCommunityJSONBody struct {
+	// Code 招待コード。大文字・小文字は区別しない
+	Code string `json:"code"`
+}
+
+// DeleteCommunityParams defines parameters for DeleteCommunity.
+type DeleteCommunityParams struct {
+	// Permanent trueの場合はメンバーや招待コードも含めて完全に削除する。完全に削除したコミュニティは復元できない
+	Permanent *bool `form:"permanent,omitempty" json:"permanent,omitempty"`
+}
+
+// UpdateCommunityJSONBody defines parameters for UpdateCommunity.
+type UpdateCommunityJSONBody struct {
+	EndDateTime   *time.Time `json:"endDateTime,omitempty"`
+	Name          *string    `json:"name,omitempty"`
+	StartDateTime *time.Time `json:"startDateTime,omitempty"`
+}
+
+// GetCommunityCardsParams defines parameters for GetCommunityCards.
+type GetCommunityCardsParams struct {
+	// Limit 1ページに含める件数
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのレスポンスに含まれるnextCursor。指定しない場合は最初のページを返す
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Order 並び順
+	Order *GetCommunityCardsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Sort 並び替えの項目
+	Sort *GetCommunityCardsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetCommunityCardsParamsOrder defines parameters for GetCommunityCards.
+type GetCommunityCardsParamsOrder string
+
+// GetCommunityCardsParamsSort defines parameters for GetCommunityCards.
+type GetCommunityCardsParamsSort string
+
+// AddCardToCommunityJSONBody defines parameters for AddCardToCommunity.
+type AddCardToCommunityJSONBody struct {
+	// GithubId 追加するユーザーのGitHub ID
+	GithubId string `json:"githubId"`
+}
+
+// CreateCommunityInviteJSONBody defines parameters for CreateCommunityInvite.
+type CreateCommunityInviteJSONBody struct {
+	// ExpiresInMinutes 有効期限（分）。省略した場合は24時間
+	ExpiresInMinutes *int `json:"expiresInMinutes,omitempty"`
+
+	// SingleUse trueの場合は1回だけ使える。省略した場合はfalse
+	SingleUse *bool `json:"singleUse,omitempty"`
+}
+
+// UpdateCommunityMemberRoleJSONBody defines parameters for UpdateCommunityMemberRole.
+type UpdateCommunityMemberRoleJSONBody struct {
+	Role UpdateCommunityMemberRoleJSONBodyRole `json:"role"`
+}
+
+// UpdateCommunityMemberRoleJSONBodyRole defines parameters for UpdateCommunityMemberRole.
+type UpdateCommunityMemberRoleJSONBodyRole string
+
+// TransferCommunityOwnershipJSONBody defines parameters for TransferCommunityOwnership.
+type TransferCommunityOwnershipJSONBody struct {
+	// GithubId 新しいオーナーのGitHub ID。コミュニティに参加している必要がある
+	GithubId string `json:"githubId"`
+}
+
+// CreateExchangeJSONBody defines parameters for CreateExchange.
+type CreateExchangeJSONBody struct {
+	// ExpiresInSeconds 有効期限（秒）。省略した場合は5分
+	ExpiresInSeconds *int `json:"expiresInSeconds,omitempty"`
+}
+
+// AcceptExchangeJSONBody defines parameters for AcceptExchange.
+type AcceptExchangeJSONBody struct {
+	// CommunityId カードを交換したコミュニティのID
+	CommunityId *string `json:"communityId,omitempty"`
+
+	// Note 受け取ったカードに付けるメモ。自分のデッキにだけ付く
+	Note *string `json:"note,omitempty"`
+
+	// Source カードを集めた方法 qr / nfc / manual
+	Source *CollectSource `json:"source,omitempty"`
+}
+
+// GetMyStatsParams defines parameters for GetMyStats.
+type GetMyStatsParams struct {
+	// From 集計する期間の始まり。1年より長い期間も指定できる（最長10年）
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 集計する期間の終わり。省略した場合は現在まで
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Timezone 連続記録や時間帯を求めるタイムゾーン（IANAのタイムゾーン名 例: Asia/Tokyo）。省略した場合はUTC
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// GetMyStatsHistoryParams defines parameters for GetMyStatsHistory.
+type GetMyStatsHistoryParams struct {
+	// From 期間の初日
+	From openapi_types.Date `form:"from" json:"from"`
+
+	// To 期間の最終日（この日を含む）
+	To openapi_types.Date `form:"to" json:"to"`
+}
+
+// GetUserStatsParams defines parameters for GetUserStats.
+type GetUserStatsParams struct {
+	// From 集計する期間の始まり。1年より長い期間も指定できる（最長10年）
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 集計する期間の終わり。省略した場合は現在まで
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Timezone 連続記録や時間帯を求めるタイムゾーン（IANAのタイムゾーン名 例: Asia/Tokyo）。省略した場合はUTC
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// GetUserStatsHistoryParams defines parameters for GetUserStatsHistory.
+type GetUserStatsHistoryParams struct {
+	// From 期間の初日
+	From openapi_types.Date `form:"from" json:"from"`
+
+	// To 期間の最終日（この日を含む）
+	To openapi_types.Date `form:"to" json:"to"`
+}
+
 // AddCardToDeckTextRequestBody defines body for AddCardToDeck for text/plain ContentType.
 type AddCardToDeckTextRequestBody = AddCardToDeckTextBody
 
+// UpdateMyCardSettingsJSONRequestBody defines body for UpdateMyCardSettings for application/json ContentType.
+type UpdateMyCardSettingsJSONRequestBody = CardSettings
+
 // CreateCommunityJSONRequestBody defines body for CreateCommunity for application/json ContentType.
 type CreateCommunityJSONRequestBody CreateCommunityJSONBody
 
+// JoinCommunityJSONRequestBody defines body for JoinCommunity for application/json ContentType.
+type JoinCommunityJSONRequestBody JoinCommunityJSONBody
+
+// UpdateCommunityJSONRequestBody defines body for UpdateCommunity for application/json ContentType.
+type UpdateCommunityJSONRequestBody UpdateCommunityJSONBody
+
+// AddCardToCommunityJSONRequestBody defines body for AddCardToCommunity for application/json ContentType.
+type AddCardToCommunityJSONRequestBody AddCardToCommunityJSONBody
+
+// CreateCommunityInviteJSONRequestBody defines body for CreateCommunityInvite for application/json ContentType.
+type CreateCommunityInviteJSONRequestBody CreateCommunityInviteJSONBody
+
+// UpdateCommunityMemberRoleJSONRequestBody defines body for UpdateCommunityMemberRole for application/json ContentType.
+type UpdateCommunityMemberRoleJSONRequestBody UpdateCommunityMemberRoleJSONBody
+
+// TransferCommunityOwnershipJSONRequestBody defines body for TransferCommunityOwnership for application/json ContentType.
+type TransferCommunityOwnershipJSONRequestBody TransferCommunityOwnershipJSONBody
+
+// CreateExchangeJSONRequestBody defines body for CreateExchange for application/json ContentType.
+type CreateExchangeJSONRequestBody CreateExchangeJSONBody
+
+// AcceptExchangeJSONRequestBody defines body for AcceptExchange for application/json ContentType.
+type AcceptExchangeJSONRequestBody AcceptExchangeJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// カード一覧取得
 	// (GET /cards)
-	GetCards(c *gin.Context)
+	GetCards(c *gin.Context, params GetCardsParams)
 	// カードをデッキに追加
 	// (POST /cards)
-	AddCardToDeck(c *gin.Context)
+	AddCardToDeck(c *gin.Context, params AddCardToDeckParams)
 	// 自分のカード取得
 	// (GET /cards/me)
 	GetMyCard(c *gin.Context)
+	// 自分のカードの設定を取得
+	// (GET /cards/me/settings)
+	GetMyCardSettings(c *gin.Context)
+	// 自分のカードの設定を変更
+	// (PUT /cards/me/settings)
+	UpdateMyCardSettings(c *gin.Context)
+	// 自分のカードの共有ペイロードを発行
+	// (GET /cards/me/share)
+	GetMyCardShare(c *gin.Context)
 	// データベース内のカードすべてを更新
 	// (PUT /cards/refresh)
 	RefreshAllCards(c *gin.Context)
@@ -124,36 +680,87 @@ type ServerInterface interface {
 	// 指定したカード取得
 	// (GET /cards/{githubId})
 	GetCard(c *gin.Context, githubId string)
+	// カードのIdenticonをPNGで取得
+	// (GET /cards/{githubId}/identicon.png)
+	GetCardIdenticonPng(c *gin.Context, githubId string, params GetCardIdenticonPngParams)
+	// カードのIdenticonをSVGで取得
+	// (GET /cards/{githubId}/identicon.svg)
+	GetCardIdenticonSvg(c *gin.Context, githubId string, params GetCardIdenticonSvgParams)
+	// カード全体の画像を取得
+	// (GET /cards/{githubId}/image)
+	GetCardImage(c *gin.Context, githubId string, params GetCardImageParams)
 	// コミュニティ一覧取得
 	// (GET /communities)
-	GetCommunities(c *gin.Context)
+	GetCommunities(c *gin.Context, params GetCommunitiesParams)
 	// コミュニティを作成
 	// (POST /communities)
 	CreateCommunity(c *gin.Context)
+	// 招待コードでコミュニティに参加
+	// (POST /communities/join)
+	JoinCommunity(c *gin.Context)
 	// コミュニティを削除
 	// (DELETE /communities/{id})
-	DeleteCommunity(c *gin.Context, id string)
+	DeleteCommunity(c *gin.Context, id string, params DeleteCommunityParams)
 	// 指定したコミュニティ取得
 	// (GET /communities/{id})
 	GetCommunity(c *gin.Context, id string)
+	// コミュニティを編集
+	// (PATCH /communities/{id})
+	UpdateCommunity(c *gin.Context, id string)
 	// 指定したコミュニティの自分のカードを削除
 	// (DELETE /communities/{id}/cards)
 	RemoveCardFromCommunity(c *gin.Context, id string)
 	// 指定したコミュニティのカード一覧取得
 	// (GET /communities/{id}/cards)
-	GetCommunityCards(c *gin.Context, id string)
-	// 指定したコミュニティに自分のカードを追加
+	GetCommunityCards(c *gin.Context, id string, params GetCommunityCardsParams)
+	// 指定したコミュニティにユーザーのカードを追加
 	// (POST /communities/{id}/cards)
 	AddCardToCommunity(c *gin.Context, id string)
+	// コミュニティの招待コード一覧取得
+	// (GET /communities/{id}/invites)
+	GetCommunityInvites(c *gin.Context, id string)
+	// コミュニティの招待コードを作成
+	// (POST /communities/{id}/invites)
+	CreateCommunityInvite(c *gin.Context, id string)
+	// コミュニティの招待コードを取り消し
+	// (DELETE /communities/{id}/invites/{code})
+	RevokeCommunityInvite(c *gin.Context, id string, code string)
+	// コミュニティのメンバーの役割を変更
+	// (PUT /communities/{id}/members/{githubId}/role)
+	UpdateCommunityMemberRole(c *gin.Context, id string, githubId string)
+	// コミュニティのオーナー権限を移譲
+	// (PUT /communities/{id}/owner)
+	TransferCommunityOwnership(c *gin.Context, id string)
 	// コミュニティのHighlightedCardを更新
 	// (PUT /communities/{id}/refresh)
 	RefreshCommunity(c *gin.Context, id string)
+	// 削除したコミュニティを復元
+	// (POST /communities/{id}/restore)
+	RestoreCommunity(c *gin.Context, id string)
+	// カード交換一覧取得
+	// (GET /exchanges)
+	GetExchanges(c *gin.Context)
+	// カード交換を申し出る
+	// (POST /exchanges)
+	CreateExchange(c *gin.Context)
+	// カード交換を取得
+	// (GET /exchanges/{token})
+	GetExchange(c *gin.Context, token string)
+	// カード交換を受け取る
+	// (POST /exchanges/{token}/accept)
+	AcceptExchange(c *gin.Context, token string)
 	// 自分の統計情報取得
 	// (GET /stats/me)
-	GetMyStats(c *gin.Context)
+	GetMyStats(c *gin.Context, params GetMyStatsParams)
+	// 自分の統計情報の履歴取得
+	// (GET /stats/me/history)
+	GetMyStatsHistory(c *gin.Context, params GetMyStatsHistoryParams)
 	// ユーザーの統計情報取得
 	// (GET /stats/{githubId})
-	GetUserStats(c *gin.Context, githubId string)
+	GetUserStats(c *gin.Context, githubId string, params GetUserStatsParams)
+	// ユーザーの統計情報の履歴取得
+	// (GET /stats/{githubId}/history)
+	GetUserStatsHistory(c *gin.Context, githubId string, params GetUserStatsHistoryParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -168,8 +775,69 @@ type MiddlewareFunc func(c *gin.Context)
 // GetCards operation middleware
 func (siw *ServerInterfaceWrapper) GetCards(c *gin.Context) {
 
+	var err error
+
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCardsParams
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", c.Request.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter language: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "communityId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "communityId", c.Request.URL.Query(), &params.CommunityId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter communityId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -177,14 +845,43 @@ func (siw *ServerInterfaceWrapper) GetCards(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCards(c)
+	siw.Handler.GetCards(c, params)
 }
 
 // AddCardToDeck operation middleware
 func (siw *ServerInterfaceWrapper) AddCardToDeck(c *gin.Context) {
 
+	var err error
+
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddCardToDeckParams
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", c.Request.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter source: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "communityId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "communityId", c.Request.URL.Query(), &params.CommunityId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter communityId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "note" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "note", c.Request.URL.Query(), &params.Note)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter note: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -192,7 +889,7 @@ func (siw *ServerInterfaceWrapper) AddCardToDeck(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AddCardToDeck(c)
+	siw.Handler.AddCardToDeck(c, params)
 }
 
 // GetMyCard operation middleware
@@ -210,6 +907,51 @@ func (siw *ServerInterfaceWrapper) GetMyCard(c *gin.Context) {
 	siw.Handler.GetMyCard(c)
 }
 
+// GetMyCardSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetMyCardSettings(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMyCardSettings(c)
+}
+
+// UpdateMyCardSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMyCardSettings(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateMyCardSettings(c)
+}
+
+// GetMyCardShare operation middleware
+func (siw *ServerInterfaceWrapper) GetMyCardShare(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMyCardShare(c)
+}
+
 // RefreshAllCards operation middleware
 func (siw *ServerInterfaceWrapper) RefreshAllCards(c *gin.Context) {
 
@@ -277,11 +1019,252 @@ func (siw *ServerInterfaceWrapper) GetCard(c *gin.Context) {
 	siw.Handler.GetCard(c, githubId)
 }
 
+// GetCardIdenticonPng operation middleware
+func (siw *ServerInterfaceWrapper) GetCardIdenticonPng(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "githubId" -------------
+	var githubId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "githubId", c.Param("githubId"), &githubId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter githubId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCardIdenticonPngParams
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "padding" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "padding", c.Request.URL.Query(), &params.Padding)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter padding: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "background" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "background", c.Request.URL.Query(), &params.Background)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter background: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCardIdenticonPng(c, githubId, params)
+}
+
+// GetCardIdenticonSvg operation middleware
+func (siw *ServerInterfaceWrapper) GetCardIdenticonSvg(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "githubId" -------------
+	var githubId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "githubId", c.Param("githubId"), &githubId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter githubId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCardIdenticonSvgParams
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", c.Request.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "padding" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "padding", c.Request.URL.Query(), &params.Padding)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter padding: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "background" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "background", c.Request.URL.Query(), &params.Background)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter background: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCardIdenticonSvg(c, githubId, params)
+}
+
+// GetCardImage operation middleware
+func (siw *ServerInterfaceWrapper) GetCardImage(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "githubId" -------------
+	var githubId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "githubId", c.Param("githubId"), &githubId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter githubId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCardImageParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "contributions" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "contributions", c.Request.URL.Query(), &params.Contributions)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contributions: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCardImage(c, githubId, params)
+}
+
 // GetCommunities operation middleware
 func (siw *ServerInterfaceWrapper) GetCommunities(c *gin.Context) {
 
+	var err error
+
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCommunitiesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -289,7 +1272,7 @@ func (siw *ServerInterfaceWrapper) GetCommunities(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCommunities(c)
+	siw.Handler.GetCommunities(c, params)
 }
 
 // CreateCommunity operation middleware
@@ -307,6 +1290,21 @@ func (siw *ServerInterfaceWrapper) CreateCommunity(c *gin.Context) {
 	siw.Handler.CreateCommunity(c)
 }
 
+// JoinCommunity operation middleware
+func (siw *ServerInterfaceWrapper) JoinCommunity(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.JoinCommunity(c)
+}
+
 // DeleteCommunity operation middleware
 func (siw *ServerInterfaceWrapper) DeleteCommunity(c *gin.Context) {
 
@@ -323,6 +1321,17 @@ func (siw *ServerInterfaceWrapper) DeleteCommunity(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteCommunityParams
+
+	// ------------- Optional query parameter "permanent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "permanent", c.Request.URL.Query(), &params.Permanent)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter permanent: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -330,7 +1339,7 @@ func (siw *ServerInterfaceWrapper) DeleteCommunity(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteCommunity(c, id)
+	siw.Handler.DeleteCommunity(c, id, params)
 }
 
 // GetCommunity operation middleware
@@ -359,8 +1368,8 @@ func (siw *ServerInterfaceWrapper) GetCommunity(c *gin.Context) {
 	siw.Handler.GetCommunity(c, id)
 }
 
-// RemoveCardFromCommunity operation middleware
-func (siw *ServerInterfaceWrapper) RemoveCardFromCommunity(c *gin.Context) {
+// UpdateCommunity operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCommunity(c *gin.Context) {
 
 	var err error
 
@@ -382,11 +1391,11 @@ func (siw *ServerInterfaceWrapper) RemoveCardFromCommunity(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RemoveCardFromCommunity(c, id)
+	siw.Handler.UpdateCommunity(c, id)
 }
 
-// GetCommunityCards operation middleware
-func (siw *ServerInterfaceWrapper) GetCommunityCards(c *gin.Context) {
+// RemoveCardFromCommunity operation middleware
+func (siw *ServerInterfaceWrapper) RemoveCardFromCommunity(c *gin.Context) {
 
 	var err error
 
@@ -408,11 +1417,11 @@ func (siw *ServerInterfaceWrapper) GetCommunityCards(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCommunityCards(c, id)
+	siw.Handler.RemoveCardFromCommunity(c, id)
 }
 
-// AddCardToCommunity operation middleware
-func (siw *ServerInterfaceWrapper) AddCardToCommunity(c *gin.Context) {
+// GetCommunityCards operation middleware
+func (siw *ServerInterfaceWrapper) GetCommunityCards(c *gin.Context) {
 
 	var err error
 
@@ -427,6 +1436,41 @@ func (siw *ServerInterfaceWrapper) AddCardToCommunity(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCommunityCardsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -434,11 +1478,11 @@ func (siw *ServerInterfaceWrapper) AddCardToCommunity(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AddCardToCommunity(c, id)
+	siw.Handler.GetCommunityCards(c, id, params)
 }
 
-// RefreshCommunity operation middleware
-func (siw *ServerInterfaceWrapper) RefreshCommunity(c *gin.Context) {
+// AddCardToCommunity operation middleware
+func (siw *ServerInterfaceWrapper) AddCardToCommunity(c *gin.Context) {
 
 	var err error
 
@@ -460,13 +1504,24 @@ func (siw *ServerInterfaceWrapper) RefreshCommunity(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RefreshCommunity(c, id)
+	siw.Handler.AddCardToCommunity(c, id)
 }
 
-// GetMyStats operation middleware
-func (siw *ServerInterfaceWrapper) GetMyStats(c *gin.Context) {
+// GetCommunityInvites operation middleware
+func (siw *ServerInterfaceWrapper) GetCommunityInvites(c *gin.Context) {
 
-	c.Set(BearerAuthScopes, []string{})
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -475,20 +1530,20 @@ func (siw *ServerInterfaceWrapper) GetMyStats(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMyStats(c)
+	siw.Handler.GetCommunityInvites(c, id)
 }
 
-// GetUserStats operation middleware
-func (siw *ServerInterfaceWrapper) GetUserStats(c *gin.Context) {
+// CreateCommunityInvite operation middleware
+func (siw *ServerInterfaceWrapper) CreateCommunityInvite(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "githubId" -------------
-	var githubId string
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "githubId", c.Param("githubId"), &githubId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter githubId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -501,357 +1556,2524 @@ func (siw *ServerInterfaceWrapper) GetUserStats(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUserStats(c, githubId)
+	siw.Handler.CreateCommunityInvite(c, id)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// RevokeCommunityInvite operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCommunityInvite(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.GET(options.BaseURL+"/cards", wrapper.GetCards)
-	router.POST(options.BaseURL+"/cards", wrapper.AddCardToDeck)
-	router.GET(options.BaseURL+"/cards/me", wrapper.GetMyCard)
-	router.PUT(options.BaseURL+"/cards/refresh", wrapper.RefreshAllCards)
-	router.DELETE(options.BaseURL+"/cards/:githubId", wrapper.RemoveCardFromDeck)
-	router.GET(options.BaseURL+"/cards/:githubId", wrapper.GetCard)
-	router.GET(options.BaseURL+"/communities", wrapper.GetCommunities)
-	router.POST(options.BaseURL+"/communities", wrapper.CreateCommunity)
-	router.DELETE(options.BaseURL+"/communities/:id", wrapper.DeleteCommunity)
-	router.GET(options.BaseURL+"/communities/:id", wrapper.GetCommunity)
-	router.DELETE(options.BaseURL+"/communities/:id/cards", wrapper.RemoveCardFromCommunity)
-	router.GET(options.BaseURL+"/communities/:id/cards", wrapper.GetCommunityCards)
-	router.POST(options.BaseURL+"/communities/:id/cards", wrapper.AddCardToCommunity)
-	router.PUT(options.BaseURL+"/communities/:id/refresh", wrapper.RefreshCommunity)
-	router.GET(options.BaseURL+"/stats/me", wrapper.GetMyStats)
-	router.GET(options.BaseURL+"/stats/:githubId", wrapper.GetUserStats)
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type GetCardsRequestObject struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetCardsResponseObject interface {
-	VisitGetCardsResponse(w http.ResponseWriter) error
+	siw.Handler.RevokeCommunityInvite(c, id, code)
 }
 
-type GetCards200JSONResponse struct {
-	Cards []Card `json:"cards"`
-}
+// UpdateCommunityMemberRole operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCommunityMemberRole(c *gin.Context) {
 
-func (response GetCards200JSONResponse) VisitGetCardsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type AddCardToDeckRequestObject struct {
-	Body *AddCardToDeckTextRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AddCardToDeckResponseObject interface {
-	VisitAddCardToDeckResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "githubId" -------------
+	var githubId string
 
-type AddCardToDeck200JSONResponse struct {
-	Card Card `json:"card"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "githubId", c.Param("githubId"), &githubId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter githubId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response AddCardToDeck200JSONResponse) VisitAddCardToDeckResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetMyCardRequestObject struct {
+	siw.Handler.UpdateCommunityMemberRole(c, id, githubId)
 }
 
-type GetMyCardResponseObject interface {
-	VisitGetMyCardResponse(w http.ResponseWriter) error
-}
+// TransferCommunityOwnership operation middleware
+func (siw *ServerInterfaceWrapper) TransferCommunityOwnership(c *gin.Context) {
 
-type GetMyCard200JSONResponse struct {
-	Card Card `json:"card"`
-}
+	var err error
 
-func (response GetMyCard200JSONResponse) VisitGetMyCardResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type RefreshAllCardsRequestObject struct {
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type RefreshAllCardsResponseObject interface {
-	VisitRefreshAllCardsResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type RefreshAllCards200JSONResponse struct {
-	Card []Card `json:"card"`
+	siw.Handler.TransferCommunityOwnership(c, id)
 }
 
-func (response RefreshAllCards200JSONResponse) VisitRefreshAllCardsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// RefreshCommunity operation middleware
+func (siw *ServerInterfaceWrapper) RefreshCommunity(c *gin.Context) {
 
-type RemoveCardFromDeckRequestObject struct {
-	GithubId string `json:"githubId"`
-}
+	var err error
 
-type RemoveCardFromDeckResponseObject interface {
-	VisitRemoveCardFromDeckResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-type RemoveCardFromDeck200JSONResponse struct {
-	Card Card `json:"card"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response RemoveCardFromDeck200JSONResponse) VisitRemoveCardFromDeckResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetCardRequestObject struct {
-	GithubId string `json:"githubId"`
+	siw.Handler.RefreshCommunity(c, id)
 }
 
-type GetCardResponseObject interface {
-	VisitGetCardResponse(w http.ResponseWriter) error
-}
+// RestoreCommunity operation middleware
+func (siw *ServerInterfaceWrapper) RestoreCommunity(c *gin.Context) {
 
-type GetCard200JSONResponse struct {
-	Card Card `json:"card"`
-}
+	var err error
 
-func (response GetCard200JSONResponse) VisitGetCardResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetCommunitiesRequestObject struct {
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type GetCommunitiesResponseObject interface {
-	VisitGetCommunitiesResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetCommunities200JSONResponse struct {
-	Communities []Community `json:"communities"`
+	siw.Handler.RestoreCommunity(c, id)
 }
 
-func (response GetCommunities200JSONResponse) VisitGetCommunitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetExchanges operation middleware
+func (siw *ServerInterfaceWrapper) GetExchanges(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type CreateCommunityRequestObject struct {
-	Body *CreateCommunityJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CreateCommunityResponseObject interface {
-	VisitCreateCommunityResponse(w http.ResponseWriter) error
+	siw.Handler.GetExchanges(c)
 }
 
-type CreateCommunity200JSONResponse struct {
-	Community Community `json:"community"`
-}
+// CreateExchange operation middleware
+func (siw *ServerInterfaceWrapper) CreateExchange(c *gin.Context) {
 
-func (response CreateCommunity200JSONResponse) VisitCreateCommunityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type DeleteCommunityRequestObject struct {
-	Id string `json:"id"`
+	siw.Handler.CreateExchange(c)
 }
 
-type DeleteCommunityResponseObject interface {
-	VisitDeleteCommunityResponse(w http.ResponseWriter) error
-}
+// GetExchange operation middleware
+func (siw *ServerInterfaceWrapper) GetExchange(c *gin.Context) {
 
-type DeleteCommunity200JSONResponse struct {
-	Community Community `json:"community"`
-}
+	var err error
 
-func (response DeleteCommunity200JSONResponse) VisitDeleteCommunityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "token" -------------
+	var token string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "token", c.Param("token"), &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetCommunityRequestObject struct {
-	Id string `json:"id"`
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type GetCommunityResponseObject interface {
-	VisitGetCommunityResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetCommunity200JSONResponse struct {
-	Community       Community       `json:"community"`
-	HighlightedCard HighlightedCard `json:"highlightedCard"`
+	siw.Handler.GetExchange(c, token)
 }
 
-func (response GetCommunity200JSONResponse) VisitGetCommunityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// AcceptExchange operation middleware
+func (siw *ServerInterfaceWrapper) AcceptExchange(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type RemoveCardFromCommunityRequestObject struct {
-	Id string `json:"id"`
-}
+	// ------------- Path parameter "token" -------------
+	var token string
 
-type RemoveCardFromCommunityResponseObject interface {
-	VisitRemoveCardFromCommunityResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "token", c.Param("token"), &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type RemoveCardFromCommunity200JSONResponse struct {
-	Card Card `json:"card"`
-}
+	c.Set(BearerAuthScopes, []string{})
 
-func (response RemoveCardFromCommunity200JSONResponse) VisitRemoveCardFromCommunityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.AcceptExchange(c, token)
 }
 
-type GetCommunityCardsRequestObject struct {
-	Id string `json:"id"`
-}
+// GetMyStats operation middleware
+func (siw *ServerInterfaceWrapper) GetMyStats(c *gin.Context) {
 
-type GetCommunityCardsResponseObject interface {
-	VisitGetCommunityCardsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetCommunityCards200JSONResponse struct {
-	Cards []Card `json:"cards"`
-}
+	c.Set(BearerAuthScopes, []string{})
 
-func (response GetCommunityCards200JSONResponse) VisitGetCommunityCardsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMyStatsParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "from" -------------
 
-type AddCardToCommunityRequestObject struct {
-	Id string `json:"id"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AddCardToCommunityResponseObject interface {
-	VisitAddCardToCommunityResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "to" -------------
 
-type AddCardToCommunity200JSONResponse struct {
-	Card Card `json:"card"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response AddCardToCommunity200JSONResponse) VisitAddCardToCommunityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "timezone" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "timezone", c.Request.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timezone: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type RefreshCommunityRequestObject struct {
-	Id string `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type RefreshCommunityResponseObject interface {
-	VisitRefreshCommunityResponse(w http.ResponseWriter) error
+	siw.Handler.GetMyStats(c, params)
 }
 
-type RefreshCommunity200JSONResponse struct {
-	Community       Community       `json:"community"`
-	HighlightedCard HighlightedCard `json:"highlightedCard"`
-}
+// GetMyStatsHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetMyStatsHistory(c *gin.Context) {
 
-func (response RefreshCommunity200JSONResponse) VisitRefreshCommunityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type GetMyStatsRequestObject struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMyStatsHistoryParams
 
-type GetMyStatsResponseObject interface {
-	VisitGetMyStatsResponse(w http.ResponseWriter) error
-}
+	// ------------- Required query parameter "from" -------------
 
-type GetMyStats200JSONResponse struct {
-	Stats UserStats `json:"stats"`
-}
+	if paramValue := c.Query("from"); paramValue != "" {
 
-func (response GetMyStats200JSONResponse) VisitGetMyStatsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetUserStatsRequestObject struct {
-	GithubId string `json:"githubId"`
-}
+	// ------------- Required query parameter "to" -------------
 
-type GetUserStatsResponseObject interface {
-	VisitGetUserStatsResponse(w http.ResponseWriter) error
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetMyStatsHistory(c, params)
 }
 
-type GetUserStats200JSONResponse struct {
-	Stats UserStats `json:"stats"`
+// GetUserStats operation middleware
+func (siw *ServerInterfaceWrapper) GetUserStats(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "githubId" -------------
+	var githubId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "githubId", c.Param("githubId"), &githubId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter githubId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserStatsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "timezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timezone", c.Request.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timezone: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserStats(c, githubId, params)
 }
 
-func (response GetUserStats200JSONResponse) VisitGetUserStatsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetUserStatsHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetUserStatsHistory(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
+	var err error
+
+	// ------------- Path parameter "githubId" -------------
+	var githubId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "githubId", c.Param("githubId"), &githubId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter githubId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserStatsHistoryParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserStatsHistory(c, githubId, params)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/cards", wrapper.GetCards)
+	router.POST(options.BaseURL+"/cards", wrapper.AddCardToDeck)
+	router.GET(options.BaseURL+"/cards/me", wrapper.GetMyCard)
+	router.GET(options.BaseURL+"/cards/me/settings", wrapper.GetMyCardSettings)
+	router.PUT(options.BaseURL+"/cards/me/settings", wrapper.UpdateMyCardSettings)
+	router.GET(options.BaseURL+"/cards/me/share", wrapper.GetMyCardShare)
+	router.PUT(options.BaseURL+"/cards/refresh", wrapper.RefreshAllCards)
+	router.DELETE(options.BaseURL+"/cards/:githubId", wrapper.RemoveCardFromDeck)
+	router.GET(options.BaseURL+"/cards/:githubId", wrapper.GetCard)
+	router.GET(options.BaseURL+"/cards/:githubId/identicon.png", wrapper.GetCardIdenticonPng)
+	router.GET(options.BaseURL+"/cards/:githubId/identicon.svg", wrapper.GetCardIdenticonSvg)
+	router.GET(options.BaseURL+"/cards/:githubId/image", wrapper.GetCardImage)
+	router.GET(options.BaseURL+"/communities", wrapper.GetCommunities)
+	router.POST(options.BaseURL+"/communities", wrapper.CreateCommunity)
+	router.POST(options.BaseURL+"/communities/join", wrapper.JoinCommunity)
+	router.DELETE(options.BaseURL+"/communities/:id", wrapper.DeleteCommunity)
+	router.GET(options.BaseURL+"/communities/:id", wrapper.GetCommunity)
+	router.PATCH(options.BaseURL+"/communities/:id", wrapper.UpdateCommunity)
+	router.DELETE(options.BaseURL+"/communities/:id/cards", wrapper.RemoveCardFromCommunity)
+	router.GET(options.BaseURL+"/communities/:id/cards", wrapper.GetCommunityCards)
+	router.POST(options.BaseURL+"/communities/:id/cards", wrapper.AddCardToCommunity)
+	router.GET(options.BaseURL+"/communities/:id/invites", wrapper.GetCommunityInvites)
+	router.POST(options.BaseURL+"/communities/:id/invites", wrapper.CreateCommunityInvite)
+	router.DELETE(options.BaseURL+"/communities/:id/invites/:code", wrapper.RevokeCommunityInvite)
+	router.PUT(options.BaseURL+"/communities/:id/members/:githubId/role", wrapper.UpdateCommunityMemberRole)
+	router.PUT(options.BaseURL+"/communities/:id/owner", wrapper.TransferCommunityOwnership)
+	router.PUT(options.BaseURL+"/communities/:id/refresh", wrapper.RefreshCommunity)
+	router.POST(options.BaseURL+"/communities/:id/restore", wrapper.RestoreCommunity)
+	router.GET(options.BaseURL+"/exchanges", wrapper.GetExchanges)
+	router.POST(options.BaseURL+"/exchanges", wrapper.CreateExchange)
+	router.GET(options.BaseURL+"/exchanges/:token", wrapper.GetExchange)
+	router.POST(options.BaseURL+"/exchanges/:token/accept", wrapper.AcceptExchange)
+	router.GET(options.BaseURL+"/stats/me", wrapper.GetMyStats)
+	router.GET(options.BaseURL+"/stats/me/history", wrapper.GetMyStatsHistory)
+	router.GET(options.BaseURL+"/stats/:githubId", wrapper.GetUserStats)
+	router.GET(options.BaseURL+"/stats/:githubId/history", wrapper.GetUserStatsHistory)
+}
+
+type BadGatewayJSONResponse Error
+
+type BadRequestJSONResponse Error
+
+type ConflictJSONResponse Error
+
+type ForbiddenJSONResponse Error
+
+type NotFoundJSONResponse Error
+
+type NotModifiedResponseHeaders struct {
+	ETag string
+}
+type NotModifiedResponse struct {
+	Headers NotModifiedResponseHeaders
+}
+
+type ServiceUnavailableResponseHeaders struct {
+	RetryAfter int
+}
+type ServiceUnavailableJSONResponse struct {
+	Body Error
+
+	Headers ServiceUnavailableResponseHeaders
+}
+
+type UnauthorizedJSONResponse Error
+
+type GetCardsRequestObject struct {
+	Params GetCardsParams
+}
+
+type GetCardsResponseObject interface {
+	VisitGetCardsResponse(w http.ResponseWriter) error
+}
+
+type GetCards200JSONResponse struct {
+	Cards []CollectedCard `json:"cards"`
+
+	// NextCursor 次のページを取得するためのカーソル。次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+func (response GetCards200JSONResponse) VisitGetCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCards400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCards400JSONResponse) VisitGetCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCards401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCards401JSONResponse) VisitGetCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToDeckRequestObject struct {
+	Params AddCardToDeckParams
+	Body   *AddCardToDeckTextRequestBody
+}
+
+type AddCardToDeckResponseObject interface {
+	VisitAddCardToDeckResponse(w http.ResponseWriter) error
+}
+
+type AddCardToDeck200JSONResponse struct {
+	Card Card `json:"card"`
+}
+
+func (response AddCardToDeck200JSONResponse) VisitAddCardToDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToDeck400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddCardToDeck400JSONResponse) VisitAddCardToDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToDeck401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddCardToDeck401JSONResponse) VisitAddCardToDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToDeck404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddCardToDeck404JSONResponse) VisitAddCardToDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToDeck409JSONResponse struct{ ConflictJSONResponse }
+
+func (response AddCardToDeck409JSONResponse) VisitAddCardToDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToDeck502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response AddCardToDeck502JSONResponse) VisitAddCardToDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToDeck503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response AddCardToDeck503JSONResponse) VisitAddCardToDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetMyCardRequestObject struct {
+}
+
+type GetMyCardResponseObject interface {
+	VisitGetMyCardResponse(w http.ResponseWriter) error
+}
+
+type GetMyCard200JSONResponse struct {
+	Card Card `json:"card"`
+}
+
+func (response GetMyCard200JSONResponse) VisitGetMyCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyCard401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetMyCard401JSONResponse) VisitGetMyCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyCard404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetMyCard404JSONResponse) VisitGetMyCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyCard502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response GetMyCard502JSONResponse) VisitGetMyCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyCard503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response GetMyCard503JSONResponse) VisitGetMyCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetMyCardSettingsRequestObject struct {
+}
+
+type GetMyCardSettingsResponseObject interface {
+	VisitGetMyCardSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetMyCardSettings200JSONResponse struct {
+	Settings CardSettings `json:"settings"`
+}
+
+func (response GetMyCardSettings200JSONResponse) VisitGetMyCardSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyCardSettings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetMyCardSettings401JSONResponse) VisitGetMyCardSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyCardSettings404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetMyCardSettings404JSONResponse) VisitGetMyCardSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyCardSettingsRequestObject struct {
+	Body *UpdateMyCardSettingsJSONRequestBody
+}
+
+type UpdateMyCardSettingsResponseObject interface {
+	VisitUpdateMyCardSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdateMyCardSettings200JSONResponse struct {
+	Card     Card         `json:"card"`
+	Settings CardSettings `json:"settings"`
+}
+
+func (response UpdateMyCardSettings200JSONResponse) VisitUpdateMyCardSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyCardSettings400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateMyCardSettings400JSONResponse) VisitUpdateMyCardSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyCardSettings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateMyCardSettings401JSONResponse) VisitUpdateMyCardSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyCardSettings404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateMyCardSettings404JSONResponse) VisitUpdateMyCardSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyCardSettings502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response UpdateMyCardSettings502JSONResponse) VisitUpdateMyCardSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMyCardSettings503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response UpdateMyCardSettings503JSONResponse) VisitUpdateMyCardSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetMyCardShareRequestObject struct {
+}
+
+type GetMyCardShareResponseObject interface {
+	VisitGetMyCardShareResponse(w http.ResponseWriter) error
+}
+
+type GetMyCardShare200JSONResponse struct {
+	Share CardShare `json:"share"`
+}
+
+func (response GetMyCardShare200JSONResponse) VisitGetMyCardShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyCardShare401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetMyCardShare401JSONResponse) VisitGetMyCardShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyCardShare404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetMyCardShare404JSONResponse) VisitGetMyCardShareResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshAllCardsRequestObject struct {
+}
+
+type RefreshAllCardsResponseObject interface {
+	VisitRefreshAllCardsResponse(w http.ResponseWriter) error
+}
+
+type RefreshAllCards200JSONResponse struct {
+	Card []Card `json:"card"`
+}
+
+func (response RefreshAllCards200JSONResponse) VisitRefreshAllCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshAllCards401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RefreshAllCards401JSONResponse) VisitRefreshAllCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshAllCards502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response RefreshAllCards502JSONResponse) VisitRefreshAllCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshAllCards503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response RefreshAllCards503JSONResponse) VisitRefreshAllCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RemoveCardFromDeckRequestObject struct {
+	GithubId string `json:"githubId"`
+}
+
+type RemoveCardFromDeckResponseObject interface {
+	VisitRemoveCardFromDeckResponse(w http.ResponseWriter) error
+}
+
+type RemoveCardFromDeck200JSONResponse struct {
+	Card Card `json:"card"`
+}
+
+func (response RemoveCardFromDeck200JSONResponse) VisitRemoveCardFromDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromDeck401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveCardFromDeck401JSONResponse) VisitRemoveCardFromDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromDeck404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveCardFromDeck404JSONResponse) VisitRemoveCardFromDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromDeck502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response RemoveCardFromDeck502JSONResponse) VisitRemoveCardFromDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromDeck503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response RemoveCardFromDeck503JSONResponse) VisitRemoveCardFromDeckResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCardRequestObject struct {
+	GithubId string `json:"githubId"`
+}
+
+type GetCardResponseObject interface {
+	VisitGetCardResponse(w http.ResponseWriter) error
+}
+
+type GetCard200JSONResponse struct {
+	Card Card `json:"card"`
+}
+
+func (response GetCard200JSONResponse) VisitGetCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCard401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCard401JSONResponse) VisitGetCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCard404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCard404JSONResponse) VisitGetCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCard502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response GetCard502JSONResponse) VisitGetCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCard503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response GetCard503JSONResponse) VisitGetCardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCardIdenticonPngRequestObject struct {
+	GithubId string `json:"githubId"`
+	Params   GetCardIdenticonPngParams
+}
+
+type GetCardIdenticonPngResponseObject interface {
+	VisitGetCardIdenticonPngResponse(w http.ResponseWriter) error
+}
+
+type GetCardIdenticonPng200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetCardIdenticonPng200ImagepngResponse struct {
+	Body          io.Reader
+	Headers       GetCardIdenticonPng200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetCardIdenticonPng200ImagepngResponse) VisitGetCardIdenticonPngResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCardIdenticonPng304Response = NotModifiedResponse
+
+func (response GetCardIdenticonPng304Response) VisitGetCardIdenticonPngResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetCardIdenticonPng400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCardIdenticonPng400JSONResponse) VisitGetCardIdenticonPngResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCardIdenticonPng401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCardIdenticonPng401JSONResponse) VisitGetCardIdenticonPngResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCardIdenticonPng404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCardIdenticonPng404JSONResponse) VisitGetCardIdenticonPngResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCardIdenticonSvgRequestObject struct {
+	GithubId string `json:"githubId"`
+	Params   GetCardIdenticonSvgParams
+}
+
+type GetCardIdenticonSvgResponseObject interface {
+	VisitGetCardIdenticonSvgResponse(w http.ResponseWriter) error
+}
+
+type GetCardIdenticonSvg200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetCardIdenticonSvg200ImagesvgXmlResponse struct {
+	Body          io.Reader
+	Headers       GetCardIdenticonSvg200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetCardIdenticonSvg200ImagesvgXmlResponse) VisitGetCardIdenticonSvgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCardIdenticonSvg304Response = NotModifiedResponse
+
+func (response GetCardIdenticonSvg304Response) VisitGetCardIdenticonSvgResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetCardIdenticonSvg400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCardIdenticonSvg400JSONResponse) VisitGetCardIdenticonSvgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCardIdenticonSvg401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCardIdenticonSvg401JSONResponse) VisitGetCardIdenticonSvgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCardIdenticonSvg404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCardIdenticonSvg404JSONResponse) VisitGetCardIdenticonSvgResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCardImageRequestObject struct {
+	GithubId string `json:"githubId"`
+	Params   GetCardImageParams
+}
+
+type GetCardImageResponseObject interface {
+	VisitGetCardImageResponse(w http.ResponseWriter) error
+}
+
+type GetCardImage200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetCardImage200ImagepngResponse struct {
+	Body          io.Reader
+	Headers       GetCardImage200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetCardImage200ImagepngResponse) VisitGetCardImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCardImage200ImagesvgXmlResponse struct {
+	Body          io.Reader
+	Headers       GetCardImage200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetCardImage200ImagesvgXmlResponse) VisitGetCardImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/svg+xml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetCardImage304Response = NotModifiedResponse
+
+func (response GetCardImage304Response) VisitGetCardImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetCardImage400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCardImage400JSONResponse) VisitGetCardImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCardImage404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCardImage404JSONResponse) VisitGetCardImageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunitiesRequestObject struct {
+	Params GetCommunitiesParams
+}
+
+type GetCommunitiesResponseObject interface {
+	VisitGetCommunitiesResponse(w http.ResponseWriter) error
+}
+
+type GetCommunities200JSONResponse struct {
+	Communities []Community `json:"communities"`
+
+	// NextCursor 次のページを取得するためのカーソル。次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+func (response GetCommunities200JSONResponse) VisitGetCommunitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunities400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCommunities400JSONResponse) VisitGetCommunitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunities401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCommunities401JSONResponse) VisitGetCommunitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommunityRequestObject struct {
+	Body *CreateCommunityJSONRequestBody
+}
+
+type CreateCommunityResponseObject interface {
+	VisitCreateCommunityResponse(w http.ResponseWriter) error
+}
+
+type CreateCommunity200JSONResponse struct {
+	Community Community `json:"community"`
+}
+
+func (response CreateCommunity200JSONResponse) VisitCreateCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommunity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateCommunity400JSONResponse) VisitCreateCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommunity401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateCommunity401JSONResponse) VisitCreateCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinCommunityRequestObject struct {
+	Body *JoinCommunityJSONRequestBody
+}
+
+type JoinCommunityResponseObject interface {
+	VisitJoinCommunityResponse(w http.ResponseWriter) error
+}
+
+type JoinCommunity200JSONResponse struct {
+	Community Community `json:"community"`
+}
+
+func (response JoinCommunity200JSONResponse) VisitJoinCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinCommunity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response JoinCommunity400JSONResponse) VisitJoinCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinCommunity401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response JoinCommunity401JSONResponse) VisitJoinCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinCommunity404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response JoinCommunity404JSONResponse) VisitJoinCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type JoinCommunity409JSONResponse struct{ ConflictJSONResponse }
+
+func (response JoinCommunity409JSONResponse) VisitJoinCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCommunityRequestObject struct {
+	Id     string `json:"id"`
+	Params DeleteCommunityParams
+}
+
+type DeleteCommunityResponseObject interface {
+	VisitDeleteCommunityResponse(w http.ResponseWriter) error
+}
+
+type DeleteCommunity200JSONResponse struct {
+	Community Community `json:"community"`
+}
+
+func (response DeleteCommunity200JSONResponse) VisitDeleteCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCommunity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteCommunity400JSONResponse) VisitDeleteCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCommunity401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteCommunity401JSONResponse) VisitDeleteCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCommunity403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteCommunity403JSONResponse) VisitDeleteCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCommunity404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteCommunity404JSONResponse) VisitDeleteCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunityRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetCommunityResponseObject interface {
+	VisitGetCommunityResponse(w http.ResponseWriter) error
+}
+
+type GetCommunity200JSONResponse struct {
+	Community       Community       `json:"community"`
+	HighlightedCard HighlightedCard `json:"highlightedCard"`
+}
+
+func (response GetCommunity200JSONResponse) VisitGetCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCommunity400JSONResponse) VisitGetCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunity401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCommunity401JSONResponse) VisitGetCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunity404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCommunity404JSONResponse) VisitGetCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommunityRequestObject struct {
+	Id   string `json:"id"`
+	Body *UpdateCommunityJSONRequestBody
+}
+
+type UpdateCommunityResponseObject interface {
+	VisitUpdateCommunityResponse(w http.ResponseWriter) error
+}
+
+type UpdateCommunity200JSONResponse struct {
+	Community Community `json:"community"`
+}
+
+func (response UpdateCommunity200JSONResponse) VisitUpdateCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommunity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateCommunity400JSONResponse) VisitUpdateCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommunity401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateCommunity401JSONResponse) VisitUpdateCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommunity403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateCommunity403JSONResponse) VisitUpdateCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommunity404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateCommunity404JSONResponse) VisitUpdateCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromCommunityRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RemoveCardFromCommunityResponseObject interface {
+	VisitRemoveCardFromCommunityResponse(w http.ResponseWriter) error
+}
+
+type RemoveCardFromCommunity200JSONResponse struct {
+	Card Card `json:"card"`
+}
+
+func (response RemoveCardFromCommunity200JSONResponse) VisitRemoveCardFromCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromCommunity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RemoveCardFromCommunity400JSONResponse) VisitRemoveCardFromCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromCommunity401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveCardFromCommunity401JSONResponse) VisitRemoveCardFromCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromCommunity403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RemoveCardFromCommunity403JSONResponse) VisitRemoveCardFromCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromCommunity404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveCardFromCommunity404JSONResponse) VisitRemoveCardFromCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromCommunity502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response RemoveCardFromCommunity502JSONResponse) VisitRemoveCardFromCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveCardFromCommunity503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response RemoveCardFromCommunity503JSONResponse) VisitRemoveCardFromCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCommunityCardsRequestObject struct {
+	Id     string `json:"id"`
+	Params GetCommunityCardsParams
+}
+
+type GetCommunityCardsResponseObject interface {
+	VisitGetCommunityCardsResponse(w http.ResponseWriter) error
+}
+
+type GetCommunityCards200JSONResponse struct {
+	Cards []Card `json:"cards"`
+
+	// NextCursor 次のページを取得するためのカーソル。次のページがない場合は含まれない
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+func (response GetCommunityCards200JSONResponse) VisitGetCommunityCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunityCards400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCommunityCards400JSONResponse) VisitGetCommunityCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunityCards401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCommunityCards401JSONResponse) VisitGetCommunityCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunityCards404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCommunityCards404JSONResponse) VisitGetCommunityCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToCommunityRequestObject struct {
+	Id   string `json:"id"`
+	Body *AddCardToCommunityJSONRequestBody
+}
+
+type AddCardToCommunityResponseObject interface {
+	VisitAddCardToCommunityResponse(w http.ResponseWriter) error
+}
+
+type AddCardToCommunity200JSONResponse struct {
+	Card Card `json:"card"`
+}
+
+func (response AddCardToCommunity200JSONResponse) VisitAddCardToCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToCommunity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AddCardToCommunity400JSONResponse) VisitAddCardToCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToCommunity401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AddCardToCommunity401JSONResponse) VisitAddCardToCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToCommunity403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AddCardToCommunity403JSONResponse) VisitAddCardToCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToCommunity404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AddCardToCommunity404JSONResponse) VisitAddCardToCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToCommunity409JSONResponse struct{ ConflictJSONResponse }
+
+func (response AddCardToCommunity409JSONResponse) VisitAddCardToCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToCommunity502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response AddCardToCommunity502JSONResponse) VisitAddCardToCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddCardToCommunity503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response AddCardToCommunity503JSONResponse) VisitAddCardToCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCommunityInvitesRequestObject struct {
+	Id string `json:"id"`
+}
+
+type GetCommunityInvitesResponseObject interface {
+	VisitGetCommunityInvitesResponse(w http.ResponseWriter) error
+}
+
+type GetCommunityInvites200JSONResponse struct {
+	Invites []CommunityInvite `json:"invites"`
+}
+
+func (response GetCommunityInvites200JSONResponse) VisitGetCommunityInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunityInvites400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetCommunityInvites400JSONResponse) VisitGetCommunityInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunityInvites401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCommunityInvites401JSONResponse) VisitGetCommunityInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunityInvites403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetCommunityInvites403JSONResponse) VisitGetCommunityInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommunityInvites404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCommunityInvites404JSONResponse) VisitGetCommunityInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommunityInviteRequestObject struct {
+	Id   string `json:"id"`
+	Body *CreateCommunityInviteJSONRequestBody
+}
+
+type CreateCommunityInviteResponseObject interface {
+	VisitCreateCommunityInviteResponse(w http.ResponseWriter) error
+}
+
+type CreateCommunityInvite200JSONResponse struct {
+	Invite CommunityInvite `json:"invite"`
+}
+
+func (response CreateCommunityInvite200JSONResponse) VisitCreateCommunityInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommunityInvite400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateCommunityInvite400JSONResponse) VisitCreateCommunityInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommunityInvite401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateCommunityInvite401JSONResponse) VisitCreateCommunityInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommunityInvite403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateCommunityInvite403JSONResponse) VisitCreateCommunityInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommunityInvite404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateCommunityInvite404JSONResponse) VisitCreateCommunityInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCommunityInviteRequestObject struct {
+	Id   string `json:"id"`
+	Code string `json:"code"`
+}
+
+type RevokeCommunityInviteResponseObject interface {
+	VisitRevokeCommunityInviteResponse(w http.ResponseWriter) error
+}
+
+type RevokeCommunityInvite200JSONResponse struct {
+	Invite CommunityInvite `json:"invite"`
+}
+
+func (response RevokeCommunityInvite200JSONResponse) VisitRevokeCommunityInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCommunityInvite400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RevokeCommunityInvite400JSONResponse) VisitRevokeCommunityInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCommunityInvite401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeCommunityInvite401JSONResponse) VisitRevokeCommunityInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCommunityInvite403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RevokeCommunityInvite403JSONResponse) VisitRevokeCommunityInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCommunityInvite404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeCommunityInvite404JSONResponse) VisitRevokeCommunityInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommunityMemberRoleRequestObject struct {
+	Id       string `json:"id"`
+	GithubId string `json:"githubId"`
+	Body     *UpdateCommunityMemberRoleJSONRequestBody
+}
+
+type UpdateCommunityMemberRoleResponseObject interface {
+	VisitUpdateCommunityMemberRoleResponse(w http.ResponseWriter) error
+}
+
+type UpdateCommunityMemberRole200JSONResponse struct {
+	Member CommunityMember `json:"member"`
+}
+
+func (response UpdateCommunityMemberRole200JSONResponse) VisitUpdateCommunityMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommunityMemberRole400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateCommunityMemberRole400JSONResponse) VisitUpdateCommunityMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommunityMemberRole401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateCommunityMemberRole401JSONResponse) VisitUpdateCommunityMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommunityMemberRole403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateCommunityMemberRole403JSONResponse) VisitUpdateCommunityMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommunityMemberRole404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateCommunityMemberRole404JSONResponse) VisitUpdateCommunityMemberRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferCommunityOwnershipRequestObject struct {
+	Id   string `json:"id"`
+	Body *TransferCommunityOwnershipJSONRequestBody
+}
+
+type TransferCommunityOwnershipResponseObject interface {
+	VisitTransferCommunityOwnershipResponse(w http.ResponseWriter) error
+}
+
+type TransferCommunityOwnership200JSONResponse struct {
+	Community Community `json:"community"`
+}
+
+func (response TransferCommunityOwnership200JSONResponse) VisitTransferCommunityOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferCommunityOwnership400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response TransferCommunityOwnership400JSONResponse) VisitTransferCommunityOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferCommunityOwnership401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response TransferCommunityOwnership401JSONResponse) VisitTransferCommunityOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferCommunityOwnership403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response TransferCommunityOwnership403JSONResponse) VisitTransferCommunityOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TransferCommunityOwnership404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response TransferCommunityOwnership404JSONResponse) VisitTransferCommunityOwnershipResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCommunityRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RefreshCommunityResponseObject interface {
+	VisitRefreshCommunityResponse(w http.ResponseWriter) error
+}
+
+type RefreshCommunity200JSONResponse struct {
+	Community       Community       `json:"community"`
+	HighlightedCard HighlightedCard `json:"highlightedCard"`
+}
+
+func (response RefreshCommunity200JSONResponse) VisitRefreshCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCommunity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RefreshCommunity400JSONResponse) VisitRefreshCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCommunity401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RefreshCommunity401JSONResponse) VisitRefreshCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCommunity403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RefreshCommunity403JSONResponse) VisitRefreshCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCommunity404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RefreshCommunity404JSONResponse) VisitRefreshCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCommunity502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response RefreshCommunity502JSONResponse) VisitRefreshCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshCommunity503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response RefreshCommunity503JSONResponse) VisitRefreshCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestoreCommunityRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RestoreCommunityResponseObject interface {
+	VisitRestoreCommunityResponse(w http.ResponseWriter) error
+}
+
+type RestoreCommunity200JSONResponse struct {
+	Community Community `json:"community"`
+}
+
+func (response RestoreCommunity200JSONResponse) VisitRestoreCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreCommunity400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RestoreCommunity400JSONResponse) VisitRestoreCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreCommunity401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RestoreCommunity401JSONResponse) VisitRestoreCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreCommunity403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RestoreCommunity403JSONResponse) VisitRestoreCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreCommunity404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RestoreCommunity404JSONResponse) VisitRestoreCommunityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchangesRequestObject struct {
+}
+
+type GetExchangesResponseObject interface {
+	VisitGetExchangesResponse(w http.ResponseWriter) error
+}
+
+type GetExchanges200JSONResponse struct {
+	Exchanges []CardExchange `json:"exchanges"`
+}
+
+func (response GetExchanges200JSONResponse) VisitGetExchangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchanges401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetExchanges401JSONResponse) VisitGetExchangesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExchangeRequestObject struct {
+	Body *CreateExchangeJSONRequestBody
+}
+
+type CreateExchangeResponseObject interface {
+	VisitCreateExchangeResponse(w http.ResponseWriter) error
+}
+
+type CreateExchange200JSONResponse struct {
+	Exchange CardExchange `json:"exchange"`
+}
+
+func (response CreateExchange200JSONResponse) VisitCreateExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExchange400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateExchange400JSONResponse) VisitCreateExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExchange401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateExchange401JSONResponse) VisitCreateExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateExchange404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateExchange404JSONResponse) VisitCreateExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchangeRequestObject struct {
+	Token string `json:"token"`
+}
+
+type GetExchangeResponseObject interface {
+	VisitGetExchangeResponse(w http.ResponseWriter) error
+}
+
+type GetExchange200JSONResponse struct {
+	Exchange CardExchange `json:"exchange"`
+}
+
+func (response GetExchange200JSONResponse) VisitGetExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchange401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetExchange401JSONResponse) VisitGetExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchange404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetExchange404JSONResponse) VisitGetExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptExchangeRequestObject struct {
+	Token string `json:"token"`
+	Body  *AcceptExchangeJSONRequestBody
+}
+
+type AcceptExchangeResponseObject interface {
+	VisitAcceptExchangeResponse(w http.ResponseWriter) error
+}
+
+type AcceptExchange200JSONResponse struct {
+	Exchange CardExchange `json:"exchange"`
+}
+
+func (response AcceptExchange200JSONResponse) VisitAcceptExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptExchange400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AcceptExchange400JSONResponse) VisitAcceptExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptExchange401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AcceptExchange401JSONResponse) VisitAcceptExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptExchange404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AcceptExchange404JSONResponse) VisitAcceptExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptExchange409JSONResponse struct{ ConflictJSONResponse }
+
+func (response AcceptExchange409JSONResponse) VisitAcceptExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyStatsRequestObject struct {
+	Params GetMyStatsParams
+}
+
+type GetMyStatsResponseObject interface {
+	VisitGetMyStatsResponse(w http.ResponseWriter) error
+}
+
+type GetMyStats200JSONResponse struct {
+	Stats UserStats `json:"stats"`
+}
+
+func (response GetMyStats200JSONResponse) VisitGetMyStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyStats401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetMyStats401JSONResponse) VisitGetMyStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyStats404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetMyStats404JSONResponse) VisitGetMyStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyStats502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response GetMyStats502JSONResponse) VisitGetMyStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyStats503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response GetMyStats503JSONResponse) VisitGetMyStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetMyStatsHistoryRequestObject struct {
+	Params GetMyStatsHistoryParams
+}
+
+type GetMyStatsHistoryResponseObject interface {
+	VisitGetMyStatsHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetMyStatsHistory200JSONResponse struct {
+	History ContributionHistory `json:"history"`
+}
+
+func (response GetMyStatsHistory200JSONResponse) VisitGetMyStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyStatsHistory400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetMyStatsHistory400JSONResponse) VisitGetMyStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyStatsHistory401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetMyStatsHistory401JSONResponse) VisitGetMyStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMyStatsHistory503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response GetMyStatsHistory503JSONResponse) VisitGetMyStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUserStatsRequestObject struct {
+	GithubId string `json:"githubId"`
+	Params   GetUserStatsParams
+}
+
+type GetUserStatsResponseObject interface {
+	VisitGetUserStatsResponse(w http.ResponseWriter) error
+}
+
+type GetUserStats200JSONResponse struct {
+	Stats UserStats `json:"stats"`
+}
+
+func (response GetUserStats200JSONResponse) VisitGetUserStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserStats400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUserStats400JSONResponse) VisitGetUserStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserStats401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUserStats401JSONResponse) VisitGetUserStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserStats404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetUserStats404JSONResponse) VisitGetUserStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserStats502JSONResponse struct{ BadGatewayJSONResponse }
+
+func (response GetUserStats502JSONResponse) VisitGetUserStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(502)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserStats503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response GetUserStats503JSONResponse) VisitGetUserStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetUserStatsHistoryRequestObject struct {
+	GithubId string `json:"githubId"`
+	Params   GetUserStatsHistoryParams
+}
+
+type GetUserStatsHistoryResponseObject interface {
+	VisitGetUserStatsHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetUserStatsHistory200JSONResponse struct {
+	History ContributionHistory `json:"history"`
+}
+
+func (response GetUserStatsHistory200JSONResponse) VisitGetUserStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserStatsHistory400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUserStatsHistory400JSONResponse) VisitGetUserStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserStatsHistory401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUserStatsHistory401JSONResponse) VisitGetUserStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserStatsHistory503JSONResponse struct{ ServiceUnavailableJSONResponse }
+
+func (response GetUserStatsHistory503JSONResponse) VisitGetUserStatsHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
 	// カード一覧取得
 	// (GET /cards)
 	GetCards(ctx context.Context, request GetCardsRequestObject) (GetCardsResponseObject, error)
@@ -861,6 +4083,15 @@ type StrictServerInterface interface {
 	// 自分のカード取得
 	// (GET /cards/me)
 	GetMyCard(ctx context.Context, request GetMyCardRequestObject) (GetMyCardResponseObject, error)
+	// 自分のカードの設定を取得
+	// (GET /cards/me/settings)
+	GetMyCardSettings(ctx context.Context, request GetMyCardSettingsRequestObject) (GetMyCardSettingsResponseObject, error)
+	// 自分のカードの設定を変更
+	// (PUT /cards/me/settings)
+	UpdateMyCardSettings(ctx context.Context, request UpdateMyCardSettingsRequestObject) (UpdateMyCardSettingsResponseObject, error)
+	// 自分のカードの共有ペイロードを発行
+	// (GET /cards/me/share)
+	GetMyCardShare(ctx context.Context, request GetMyCardShareRequestObject) (GetMyCardShareResponseObject, error)
 	// データベース内のカードすべてを更新
 	// (PUT /cards/refresh)
 	RefreshAllCards(ctx context.Context, request RefreshAllCardsRequestObject) (RefreshAllCardsResponseObject, error)
@@ -870,59 +4101,601 @@ type StrictServerInterface interface {
 	// 指定したカード取得
 	// (GET /cards/{githubId})
 	GetCard(ctx context.Context, request GetCardRequestObject) (GetCardResponseObject, error)
+	// カードのIdenticonをPNGで取得
+	// (GET /cards/{githubId}/identicon.png)
+	GetCardIdenticonPng(ctx context.Context, request GetCardIdenticonPngRequestObject) (GetCardIdenticonPngResponseObject, error)
+	// カードのIdenticonをSVGで取得
+	// (GET /cards/{githubId}/identicon.svg)
+	GetCardIdenticonSvg(ctx context.Context, request GetCardIdenticonSvgRequestObject) (GetCardIdenticonSvgResponseObject, error)
+	// カード全体の画像を取得
+	// (GET /cards/{githubId}/image)
+	GetCardImage(ctx context.Context, request GetCardImageRequestObject) (GetCardImageResponseObject, error)
 	// コミュニティ一覧取得
 	// (GET /communities)
 	GetCommunities(ctx context.Context, request GetCommunitiesRequestObject) (GetCommunitiesResponseObject, error)
 	// コミュニティを作成
 	// (POST /communities)
 	CreateCommunity(ctx context.Context, request CreateCommunityRequestObject) (CreateCommunityResponseObject, error)
+	// 招待コードでコミュニティに参加
+	// (POST /communities/join)
+	JoinCommunity(ctx context.Context, request JoinCommunityRequestObject) (JoinCommunityResponseObject, error)
 	// コミュニティを削除
 	// (DELETE /communities/{id})
 	DeleteCommunity(ctx context.Context, request DeleteCommunityRequestObject) (DeleteCommunityResponseObject, error)
 	// 指定したコミュニティ取得
 	// (GET /communities/{id})
 	GetCommunity(ctx context.Context, request GetCommunityRequestObject) (GetCommunityResponseObject, error)
+	// コミュニティを編集
+	// (PATCH /communities/{id})
+	UpdateCommunity(ctx context.Context, request UpdateCommunityRequestObject) (UpdateCommunityResponseObject, error)
 	// 指定したコミュニティの自分のカードを削除
 	// (DELETE /communities/{id}/cards)
 	RemoveCardFromCommunity(ctx context.Context, request RemoveCardFromCommunityRequestObject) (RemoveCardFromCommunityResponseObject, error)
 	// 指定したコミュニティのカード一覧取得
 	// (GET /communities/{id}/cards)
 	GetCommunityCards(ctx context.Context, request GetCommunityCardsRequestObject) (GetCommunityCardsResponseObject, error)
-	// 指定したコミュニティに自分のカードを追加
+	// 指定したコミュニティにユーザーのカードを追加
 	// (POST /communities/{id}/cards)
 	AddCardToCommunity(ctx context.Context, request AddCardToCommunityRequestObject) (AddCardToCommunityResponseObject, error)
+	// コミュニティの招待コード一覧取得
+	// (GET /communities/{id}/invites)
+	GetCommunityInvites(ctx context.Context, request GetCommunityInvitesRequestObject) (GetCommunityInvitesResponseObject, error)
+	// コミュニティの招待コードを作成
+	// (POST /communities/{id}/invites)
+	CreateCommunityInvite(ctx context.Context, request CreateCommunityInviteRequestObject) (CreateCommunityInviteResponseObject, error)
+	// コミュニティの招待コードを取り消し
+	// (DELETE /communities/{id}/invites/{code})
+	RevokeCommunityInvite(ctx context.Context, request RevokeCommunityInviteRequestObject) (RevokeCommunityInviteResponseObject, error)
+	// コミュニティのメンバーの役割を変更
+	// (PUT /communities/{id}/members/{githubId}/role)
+	UpdateCommunityMemberRole(ctx context.Context, request UpdateCommunityMemberRoleRequestObject) (UpdateCommunityMemberRoleResponseObject, error)
+	// コミュニティのオーナー権限を移譲
+	// (PUT /communities/{id}/owner)
+	TransferCommunityOwnership(ctx context.Context, request TransferCommunityOwnershipRequestObject) (TransferCommunityOwnershipResponseObject, error)
 	// コミュニティのHighlightedCardを更新
 	// (PUT /communities/{id}/refresh)
 	RefreshCommunity(ctx context.Context, request RefreshCommunityRequestObject) (RefreshCommunityResponseObject, error)
+	// 削除したコミュニティを復元
+	// (POST /communities/{id}/restore)
+	RestoreCommunity(ctx context.Context, request RestoreCommunityRequestObject) (RestoreCommunityResponseObject, error)
+	// カード交換一覧取得
+	// (GET /exchanges)
+	GetExchanges(ctx context.Context, request GetExchangesRequestObject) (GetExchangesResponseObject, error)
+	// カード交換を申し出る
+	// (POST /exchanges)
+	CreateExchange(ctx context.Context, request CreateExchangeRequestObject) (CreateExchangeResponseObject, error)
+	// カード交換を取得
+	// (GET /exchanges/{token})
+	GetExchange(ctx context.Context, request GetExchangeRequestObject) (GetExchangeResponseObject, error)
+	// カード交換を受け取る
+	// (POST /exchanges/{token}/accept)
+	AcceptExchange(ctx context.Context, request AcceptExchangeRequestObject) (AcceptExchangeResponseObject, error)
 	// 自分の統計情報取得
 	// (GET /stats/me)
 	GetMyStats(ctx context.Context, request GetMyStatsRequestObject) (GetMyStatsResponseObject, error)
+	// 自分の統計情報の履歴取得
+	// (GET /stats/me/history)
+	GetMyStatsHistory(ctx context.Context, request GetMyStatsHistoryRequestObject) (GetMyStatsHistoryResponseObject, error)
 	// ユーザーの統計情報取得
 	// (GET /stats/{githubId})
 	GetUserStats(ctx context.Context, request GetUserStatsRequestObject) (GetUserStatsResponseObject, error)
+	// ユーザーの統計情報の履歴取得
+	// (GET /stats/{githubId}/history)
+	GetUserStatsHistory(ctx context.Context, request GetUserStatsHistoryRequestObject) (GetUserStatsHistoryResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetCards operation middleware
+func (sh *strictHandler) GetCards(ctx *gin.Context, params GetCardsParams) {
+	var request GetCardsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCards(ctx, request.(GetCardsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCards")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCardsResponseObject); ok {
+		if err := validResponse.VisitGetCardsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AddCardToDeck operation middleware
+func (sh *strictHandler) AddCardToDeck(ctx *gin.Context, params AddCardToDeckParams) {
+	var request AddCardToDeckRequestObject
+
+	request.Params = params
+
+	data, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	body := AddCardToDeckTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddCardToDeck(ctx, request.(AddCardToDeckRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddCardToDeck")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AddCardToDeckResponseObject); ok {
+		if err := validResponse.VisitAddCardToDeckResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMyCard operation middleware
+func (sh *strictHandler) GetMyCard(ctx *gin.Context) {
+	var request GetMyCardRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMyCard(ctx, request.(GetMyCardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMyCard")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMyCardResponseObject); ok {
+		if err := validResponse.VisitGetMyCardResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMyCardSettings operation middleware
+func (sh *strictHandler) GetMyCardSettings(ctx *gin.Context) {
+	var request GetMyCardSettingsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMyCardSettings(ctx, request.(GetMyCardSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMyCardSettings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMyCardSettingsResponseObject); ok {
+		if err := validResponse.VisitGetMyCardSettingsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateMyCardSettings operation middleware
+func (sh *strictHandler) UpdateMyCardSettings(ctx *gin.Context) {
+	var request UpdateMyCardSettingsRequestObject
+
+	var body UpdateMyCardSettingsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateMyCardSettings(ctx, request.(UpdateMyCardSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateMyCardSettings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateMyCardSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdateMyCardSettingsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMyCardShare operation middleware
+func (sh *strictHandler) GetMyCardShare(ctx *gin.Context) {
+	var request GetMyCardShareRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMyCardShare(ctx, request.(GetMyCardShareRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMyCardShare")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMyCardShareResponseObject); ok {
+		if err := validResponse.VisitGetMyCardShareResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RefreshAllCards operation middleware
+func (sh *strictHandler) RefreshAllCards(ctx *gin.Context) {
+	var request RefreshAllCardsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RefreshAllCards(ctx, request.(RefreshAllCardsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefreshAllCards")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RefreshAllCardsResponseObject); ok {
+		if err := validResponse.VisitRefreshAllCardsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveCardFromDeck operation middleware
+func (sh *strictHandler) RemoveCardFromDeck(ctx *gin.Context, githubId string) {
+	var request RemoveCardFromDeckRequestObject
+
+	request.GithubId = githubId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveCardFromDeck(ctx, request.(RemoveCardFromDeckRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveCardFromDeck")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RemoveCardFromDeckResponseObject); ok {
+		if err := validResponse.VisitRemoveCardFromDeckResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCard operation middleware
+func (sh *strictHandler) GetCard(ctx *gin.Context, githubId string) {
+	var request GetCardRequestObject
+
+	request.GithubId = githubId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCard(ctx, request.(GetCardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCard")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCardResponseObject); ok {
+		if err := validResponse.VisitGetCardResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCardIdenticonPng operation middleware
+func (sh *strictHandler) GetCardIdenticonPng(ctx *gin.Context, githubId string, params GetCardIdenticonPngParams) {
+	var request GetCardIdenticonPngRequestObject
+
+	request.GithubId = githubId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCardIdenticonPng(ctx, request.(GetCardIdenticonPngRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCardIdenticonPng")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCardIdenticonPngResponseObject); ok {
+		if err := validResponse.VisitGetCardIdenticonPngResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCardIdenticonSvg operation middleware
+func (sh *strictHandler) GetCardIdenticonSvg(ctx *gin.Context, githubId string, params GetCardIdenticonSvgParams) {
+	var request GetCardIdenticonSvgRequestObject
+
+	request.GithubId = githubId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCardIdenticonSvg(ctx, request.(GetCardIdenticonSvgRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCardIdenticonSvg")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCardIdenticonSvgResponseObject); ok {
+		if err := validResponse.VisitGetCardIdenticonSvgResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCardImage operation middleware
+func (sh *strictHandler) GetCardImage(ctx *gin.Context, githubId string, params GetCardImageParams) {
+	var request GetCardImageRequestObject
+
+	request.GithubId = githubId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCardImage(ctx, request.(GetCardImageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCardImage")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCardImageResponseObject); ok {
+		if err := validResponse.VisitGetCardImageResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommunities operation middleware
+func (sh *strictHandler) GetCommunities(ctx *gin.Context, params GetCommunitiesParams) {
+	var request GetCommunitiesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommunities(ctx, request.(GetCommunitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommunities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCommunitiesResponseObject); ok {
+		if err := validResponse.VisitGetCommunitiesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCommunity operation middleware
+func (sh *strictHandler) CreateCommunity(ctx *gin.Context) {
+	var request CreateCommunityRequestObject
+
+	var body CreateCommunityJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCommunity(ctx, request.(CreateCommunityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCommunity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateCommunityResponseObject); ok {
+		if err := validResponse.VisitCreateCommunityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// JoinCommunity operation middleware
+func (sh *strictHandler) JoinCommunity(ctx *gin.Context) {
+	var request JoinCommunityRequestObject
+
+	var body JoinCommunityJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.JoinCommunity(ctx, request.(JoinCommunityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "JoinCommunity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(JoinCommunityResponseObject); ok {
+		if err := validResponse.VisitJoinCommunityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCommunity operation middleware
+func (sh *strictHandler) DeleteCommunity(ctx *gin.Context, id string, params DeleteCommunityParams) {
+	var request DeleteCommunityRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCommunity(ctx, request.(DeleteCommunityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCommunity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteCommunityResponseObject); ok {
+		if err := validResponse.VisitDeleteCommunityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommunity operation middleware
+func (sh *strictHandler) GetCommunity(ctx *gin.Context, id string) {
+	var request GetCommunityRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommunity(ctx, request.(GetCommunityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommunity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCommunityResponseObject); ok {
+		if err := validResponse.VisitGetCommunityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
-
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+// UpdateCommunity operation middleware
+func (sh *strictHandler) UpdateCommunity(ctx *gin.Context, id string) {
+	var request UpdateCommunityRequestObject
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-}
+	request.Id = id
 
-// GetCards operation middleware
-func (sh *strictHandler) GetCards(ctx *gin.Context) {
-	var request GetCardsRequestObject
+	var body UpdateCommunityJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCards(ctx, request.(GetCardsRequestObject))
+		return sh.ssi.UpdateCommunity(ctx, request.(UpdateCommunityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCards")
+		handler = middleware(handler, "UpdateCommunity")
 	}
 
 	response, err := handler(ctx, request)
@@ -930,8 +4703,8 @@ func (sh *strictHandler) GetCards(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetCardsResponseObject); ok {
-		if err := validResponse.VisitGetCardsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateCommunityResponseObject); ok {
+		if err := validResponse.VisitUpdateCommunityResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -939,23 +4712,45 @@ func (sh *strictHandler) GetCards(ctx *gin.Context) {
 	}
 }
 
-// AddCardToDeck operation middleware
-func (sh *strictHandler) AddCardToDeck(ctx *gin.Context) {
-	var request AddCardToDeckRequestObject
+// RemoveCardFromCommunity operation middleware
+func (sh *strictHandler) RemoveCardFromCommunity(ctx *gin.Context, id string) {
+	var request RemoveCardFromCommunityRequestObject
+
+	request.Id = id
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveCardFromCommunity(ctx, request.(RemoveCardFromCommunityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveCardFromCommunity")
+	}
+
+	response, err := handler(ctx, request)
 
-	data, err := io.ReadAll(ctx.Request.Body)
 	if err != nil {
 		ctx.Error(err)
-		return
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RemoveCardFromCommunityResponseObject); ok {
+		if err := validResponse.VisitRemoveCardFromCommunityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-	body := AddCardToDeckTextRequestBody(data)
-	request.Body = &body
+}
+
+// GetCommunityCards operation middleware
+func (sh *strictHandler) GetCommunityCards(ctx *gin.Context, id string, params GetCommunityCardsParams) {
+	var request GetCommunityCardsRequestObject
+
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AddCardToDeck(ctx, request.(AddCardToDeckRequestObject))
+		return sh.ssi.GetCommunityCards(ctx, request.(GetCommunityCardsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddCardToDeck")
+		handler = middleware(handler, "GetCommunityCards")
 	}
 
 	response, err := handler(ctx, request)
@@ -963,8 +4758,8 @@ func (sh *strictHandler) AddCardToDeck(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AddCardToDeckResponseObject); ok {
-		if err := validResponse.VisitAddCardToDeckResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetCommunityCardsResponseObject); ok {
+		if err := validResponse.VisitGetCommunityCardsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -972,15 +4767,25 @@ func (sh *strictHandler) AddCardToDeck(ctx *gin.Context) {
 	}
 }
 
-// GetMyCard operation middleware
-func (sh *strictHandler) GetMyCard(ctx *gin.Context) {
-	var request GetMyCardRequestObject
+// AddCardToCommunity operation middleware
+func (sh *strictHandler) AddCardToCommunity(ctx *gin.Context, id string) {
+	var request AddCardToCommunityRequestObject
+
+	request.Id = id
+
+	var body AddCardToCommunityJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMyCard(ctx, request.(GetMyCardRequestObject))
+		return sh.ssi.AddCardToCommunity(ctx, request.(AddCardToCommunityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMyCard")
+		handler = middleware(handler, "AddCardToCommunity")
 	}
 
 	response, err := handler(ctx, request)
@@ -988,8 +4793,8 @@ func (sh *strictHandler) GetMyCard(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetMyCardResponseObject); ok {
-		if err := validResponse.VisitGetMyCardResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(AddCardToCommunityResponseObject); ok {
+		if err := validResponse.VisitAddCardToCommunityResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -997,15 +4802,17 @@ func (sh *strictHandler) GetMyCard(ctx *gin.Context) {
 	}
 }
 
-// RefreshAllCards operation middleware
-func (sh *strictHandler) RefreshAllCards(ctx *gin.Context) {
-	var request RefreshAllCardsRequestObject
+// GetCommunityInvites operation middleware
+func (sh *strictHandler) GetCommunityInvites(ctx *gin.Context, id string) {
+	var request GetCommunityInvitesRequestObject
+
+	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RefreshAllCards(ctx, request.(RefreshAllCardsRequestObject))
+		return sh.ssi.GetCommunityInvites(ctx, request.(GetCommunityInvitesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RefreshAllCards")
+		handler = middleware(handler, "GetCommunityInvites")
 	}
 
 	response, err := handler(ctx, request)
@@ -1013,8 +4820,8 @@ func (sh *strictHandler) RefreshAllCards(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RefreshAllCardsResponseObject); ok {
-		if err := validResponse.VisitRefreshAllCardsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetCommunityInvitesResponseObject); ok {
+		if err := validResponse.VisitGetCommunityInvitesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1022,17 +4829,25 @@ func (sh *strictHandler) RefreshAllCards(ctx *gin.Context) {
 	}
 }
 
-// RemoveCardFromDeck operation middleware
-func (sh *strictHandler) RemoveCardFromDeck(ctx *gin.Context, githubId string) {
-	var request RemoveCardFromDeckRequestObject
+// CreateCommunityInvite operation middleware
+func (sh *strictHandler) CreateCommunityInvite(ctx *gin.Context, id string) {
+	var request CreateCommunityInviteRequestObject
 
-	request.GithubId = githubId
+	request.Id = id
+
+	var body CreateCommunityInviteJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveCardFromDeck(ctx, request.(RemoveCardFromDeckRequestObject))
+		return sh.ssi.CreateCommunityInvite(ctx, request.(CreateCommunityInviteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveCardFromDeck")
+		handler = middleware(handler, "CreateCommunityInvite")
 	}
 
 	response, err := handler(ctx, request)
@@ -1040,8 +4855,8 @@ func (sh *strictHandler) RemoveCardFromDeck(ctx *gin.Context, githubId string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RemoveCardFromDeckResponseObject); ok {
-		if err := validResponse.VisitRemoveCardFromDeckResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateCommunityInviteResponseObject); ok {
+		if err := validResponse.VisitCreateCommunityInviteResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1049,17 +4864,18 @@ func (sh *strictHandler) RemoveCardFromDeck(ctx *gin.Context, githubId string) {
 	}
 }
 
-// GetCard operation middleware
-func (sh *strictHandler) GetCard(ctx *gin.Context, githubId string) {
-	var request GetCardRequestObject
+// RevokeCommunityInvite operation middleware
+func (sh *strictHandler) RevokeCommunityInvite(ctx *gin.Context, id string, code string) {
+	var request RevokeCommunityInviteRequestObject
 
-	request.GithubId = githubId
+	request.Id = id
+	request.Code = code
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCard(ctx, request.(GetCardRequestObject))
+		return sh.ssi.RevokeCommunityInvite(ctx, request.(RevokeCommunityInviteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCard")
+		handler = middleware(handler, "RevokeCommunityInvite")
 	}
 
 	response, err := handler(ctx, request)
@@ -1067,8 +4883,8 @@ func (sh *strictHandler) GetCard(ctx *gin.Context, githubId string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetCardResponseObject); ok {
-		if err := validResponse.VisitGetCardResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(RevokeCommunityInviteResponseObject); ok {
+		if err := validResponse.VisitRevokeCommunityInviteResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1076,15 +4892,26 @@ func (sh *strictHandler) GetCard(ctx *gin.Context, githubId string) {
 	}
 }
 
-// GetCommunities operation middleware
-func (sh *strictHandler) GetCommunities(ctx *gin.Context) {
-	var request GetCommunitiesRequestObject
+// UpdateCommunityMemberRole operation middleware
+func (sh *strictHandler) UpdateCommunityMemberRole(ctx *gin.Context, id string, githubId string) {
+	var request UpdateCommunityMemberRoleRequestObject
+
+	request.Id = id
+	request.GithubId = githubId
+
+	var body UpdateCommunityMemberRoleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCommunities(ctx, request.(GetCommunitiesRequestObject))
+		return sh.ssi.UpdateCommunityMemberRole(ctx, request.(UpdateCommunityMemberRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCommunities")
+		handler = middleware(handler, "UpdateCommunityMemberRole")
 	}
 
 	response, err := handler(ctx, request)
@@ -1092,8 +4919,8 @@ func (sh *strictHandler) GetCommunities(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetCommunitiesResponseObject); ok {
-		if err := validResponse.VisitGetCommunitiesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateCommunityMemberRoleResponseObject); ok {
+		if err := validResponse.VisitUpdateCommunityMemberRoleResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1101,11 +4928,13 @@ func (sh *strictHandler) GetCommunities(ctx *gin.Context) {
 	}
 }
 
-// CreateCommunity operation middleware
-func (sh *strictHandler) CreateCommunity(ctx *gin.Context) {
-	var request CreateCommunityRequestObject
+// TransferCommunityOwnership operation middleware
+func (sh *strictHandler) TransferCommunityOwnership(ctx *gin.Context, id string) {
+	var request TransferCommunityOwnershipRequestObject
 
-	var body CreateCommunityJSONRequestBody
+	request.Id = id
+
+	var body TransferCommunityOwnershipJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1114,10 +4943,10 @@ func (sh *strictHandler) CreateCommunity(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCommunity(ctx, request.(CreateCommunityRequestObject))
+		return sh.ssi.TransferCommunityOwnership(ctx, request.(TransferCommunityOwnershipRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCommunity")
+		handler = middleware(handler, "TransferCommunityOwnership")
 	}
 
 	response, err := handler(ctx, request)
@@ -1125,8 +4954,8 @@ func (sh *strictHandler) CreateCommunity(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreateCommunityResponseObject); ok {
-		if err := validResponse.VisitCreateCommunityResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(TransferCommunityOwnershipResponseObject); ok {
+		if err := validResponse.VisitTransferCommunityOwnershipResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1134,17 +4963,17 @@ func (sh *strictHandler) CreateCommunity(ctx *gin.Context) {
 	}
 }
 
-// DeleteCommunity operation middleware
-func (sh *strictHandler) DeleteCommunity(ctx *gin.Context, id string) {
-	var request DeleteCommunityRequestObject
+// RefreshCommunity operation middleware
+func (sh *strictHandler) RefreshCommunity(ctx *gin.Context, id string) {
+	var request RefreshCommunityRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCommunity(ctx, request.(DeleteCommunityRequestObject))
+		return sh.ssi.RefreshCommunity(ctx, request.(RefreshCommunityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCommunity")
+		handler = middleware(handler, "RefreshCommunity")
 	}
 
 	response, err := handler(ctx, request)
@@ -1152,8 +4981,8 @@ func (sh *strictHandler) DeleteCommunity(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteCommunityResponseObject); ok {
-		if err := validResponse.VisitDeleteCommunityResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(RefreshCommunityResponseObject); ok {
+		if err := validResponse.VisitRefreshCommunityResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1161,17 +4990,17 @@ func (sh *strictHandler) DeleteCommunity(ctx *gin.Context, id string) {
 	}
 }
 
-// GetCommunity operation middleware
-func (sh *strictHandler) GetCommunity(ctx *gin.Context, id string) {
-	var request GetCommunityRequestObject
+// RestoreCommunity operation middleware
+func (sh *strictHandler) RestoreCommunity(ctx *gin.Context, id string) {
+	var request RestoreCommunityRequestObject
 
 	request.Id = id
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCommunity(ctx, request.(GetCommunityRequestObject))
+		return sh.ssi.RestoreCommunity(ctx, request.(RestoreCommunityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCommunity")
+		handler = middleware(handler, "RestoreCommunity")
 	}
 
 	response, err := handler(ctx, request)
@@ -1179,8 +5008,8 @@ func (sh *strictHandler) GetCommunity(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetCommunityResponseObject); ok {
-		if err := validResponse.VisitGetCommunityResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(RestoreCommunityResponseObject); ok {
+		if err := validResponse.VisitRestoreCommunityResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1188,17 +5017,15 @@ func (sh *strictHandler) GetCommunity(ctx *gin.Context, id string) {
 	}
 }
 
-// RemoveCardFromCommunity operation middleware
-func (sh *strictHandler) RemoveCardFromCommunity(ctx *gin.Context, id string) {
-	var request RemoveCardFromCommunityRequestObject
-
-	request.Id = id
+// GetExchanges operation middleware
+func (sh *strictHandler) GetExchanges(ctx *gin.Context) {
+	var request GetExchangesRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RemoveCardFromCommunity(ctx, request.(RemoveCardFromCommunityRequestObject))
+		return sh.ssi.GetExchanges(ctx, request.(GetExchangesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RemoveCardFromCommunity")
+		handler = middleware(handler, "GetExchanges")
 	}
 
 	response, err := handler(ctx, request)
@@ -1206,8 +5033,8 @@ func (sh *strictHandler) RemoveCardFromCommunity(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RemoveCardFromCommunityResponseObject); ok {
-		if err := validResponse.VisitRemoveCardFromCommunityResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetExchangesResponseObject); ok {
+		if err := validResponse.VisitGetExchangesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1215,17 +5042,23 @@ func (sh *strictHandler) RemoveCardFromCommunity(ctx *gin.Context, id string) {
 	}
 }
 
-// GetCommunityCards operation middleware
-func (sh *strictHandler) GetCommunityCards(ctx *gin.Context, id string) {
-	var request GetCommunityCardsRequestObject
+// CreateExchange operation middleware
+func (sh *strictHandler) CreateExchange(ctx *gin.Context) {
+	var request CreateExchangeRequestObject
 
-	request.Id = id
+	var body CreateExchangeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCommunityCards(ctx, request.(GetCommunityCardsRequestObject))
+		return sh.ssi.CreateExchange(ctx, request.(CreateExchangeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCommunityCards")
+		handler = middleware(handler, "CreateExchange")
 	}
 
 	response, err := handler(ctx, request)
@@ -1233,8 +5066,8 @@ func (sh *strictHandler) GetCommunityCards(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetCommunityCardsResponseObject); ok {
-		if err := validResponse.VisitGetCommunityCardsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateExchangeResponseObject); ok {
+		if err := validResponse.VisitCreateExchangeResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1242,17 +5075,17 @@ func (sh *strictHandler) GetCommunityCards(ctx *gin.Context, id string) {
 	}
 }
 
-// AddCardToCommunity operation middleware
-func (sh *strictHandler) AddCardToCommunity(ctx *gin.Context, id string) {
-	var request AddCardToCommunityRequestObject
+// GetExchange operation middleware
+func (sh *strictHandler) GetExchange(ctx *gin.Context, token string) {
+	var request GetExchangeRequestObject
 
-	request.Id = id
+	request.Token = token
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AddCardToCommunity(ctx, request.(AddCardToCommunityRequestObject))
+		return sh.ssi.GetExchange(ctx, request.(GetExchangeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AddCardToCommunity")
+		handler = middleware(handler, "GetExchange")
 	}
 
 	response, err := handler(ctx, request)
@@ -1260,8 +5093,8 @@ func (sh *strictHandler) AddCardToCommunity(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AddCardToCommunityResponseObject); ok {
-		if err := validResponse.VisitAddCardToCommunityResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetExchangeResponseObject); ok {
+		if err := validResponse.VisitGetExchangeResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1269,17 +5102,25 @@ func (sh *strictHandler) AddCardToCommunity(ctx *gin.Context, id string) {
 	}
 }
 
-// RefreshCommunity operation middleware
-func (sh *strictHandler) RefreshCommunity(ctx *gin.Context, id string) {
-	var request RefreshCommunityRequestObject
+// AcceptExchange operation middleware
+func (sh *strictHandler) AcceptExchange(ctx *gin.Context, token string) {
+	var request AcceptExchangeRequestObject
 
-	request.Id = id
+	request.Token = token
+
+	var body AcceptExchangeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RefreshCommunity(ctx, request.(RefreshCommunityRequestObject))
+		return sh.ssi.AcceptExchange(ctx, request.(AcceptExchangeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RefreshCommunity")
+		handler = middleware(handler, "AcceptExchange")
 	}
 
 	response, err := handler(ctx, request)
@@ -1287,8 +5128,8 @@ func (sh *strictHandler) RefreshCommunity(ctx *gin.Context, id string) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RefreshCommunityResponseObject); ok {
-		if err := validResponse.VisitRefreshCommunityResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(AcceptExchangeResponseObject); ok {
+		if err := validResponse.VisitAcceptExchangeResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1297,9 +5138,11 @@ func (sh *strictHandler) RefreshCommunity(ctx *gin.Context, id string) {
 }
 
 // GetMyStats operation middleware
-func (sh *strictHandler) GetMyStats(ctx *gin.Context) {
+func (sh *strictHandler) GetMyStats(ctx *gin.Context, params GetMyStatsParams) {
 	var request GetMyStatsRequestObject
 
+	request.Params = params
+
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetMyStats(ctx, request.(GetMyStatsRequestObject))
 	}
@@ -1321,11 +5164,39 @@ func (sh *strictHandler) GetMyStats(ctx *gin.Context) {
 	}
 }
 
+// GetMyStatsHistory operation middleware
+func (sh *strictHandler) GetMyStatsHistory(ctx *gin.Context, params GetMyStatsHistoryParams) {
+	var request GetMyStatsHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMyStatsHistory(ctx, request.(GetMyStatsHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMyStatsHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMyStatsHistoryResponseObject); ok {
+		if err := validResponse.VisitGetMyStatsHistoryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetUserStats operation middleware
-func (sh *strictHandler) GetUserStats(ctx *gin.Context, githubId string) {
+func (sh *strictHandler) GetUserStats(ctx *gin.Context, githubId string, params GetUserStatsParams) {
 	var request GetUserStatsRequestObject
 
 	request.GithubId = githubId
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetUserStats(ctx, request.(GetUserStatsRequestObject))
@@ -1347,3 +5218,31 @@ func (sh *strictHandler) GetUserStats(ctx *gin.Context, githubId string) {
 		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// GetUserStatsHistory operation middleware
+func (sh *strictHandler) GetUserStatsHistory(ctx *gin.Context, githubId string, params GetUserStatsHistoryParams) {
+	var request GetUserStatsHistoryRequestObject
+
+	request.GithubId = githubId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserStatsHistory(ctx, request.(GetUserStatsHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserStatsHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUserStatsHistoryResponseObject); ok {
+		if err := validResponse.VisitGetUserStatsHistoryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -0,0 +1,64 @@
+package cardimage
+
+import (
+	"context"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	// avatarHost はアバター画像を取得してよいホスト
+	// カードに保存されたURLをそのまま取得するため、GitHubのアバター以外にはアクセスしない
+	avatarHost = "avatars.githubusercontent.com"
+	// maxAvatarBytes はアバター画像として読み込む最大のサイズ
+	maxAvatarBytes = 1 << 20
+	avatarTimeout  = 3 * time.Second
+)
+
+// HTTPAvatarFetcher はGitHubのアバター画像をHTTPで取得する実装
+type HTTPAvatarFetcher struct {
+	client *http.Client
+}
+
+func NewHTTPAvatarFetcher() *HTTPAvatarFetcher {
+	return &HTTPAvatarFetcher{client: &http.Client{Timeout: avatarTimeout}}
+}
+
+// Fetch はアバター画像を取得してデコードする
+// 取得やデコードに失敗した場合は画像なしでカードを描けるようにnilを返す
+func (f *HTTPAvatarFetcher) Fetch(ctx context.Context, rawURL string) image.Image {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() != avatarHost {
+		return nil
+	}
+
+	// 描画する大きさの2倍程度の解像度があれば十分
+	q := u.Query()
+	q.Set("s", "144")
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	img, _, err := image.Decode(io.LimitReader(resp.Body, maxAvatarBytes))
+	if err != nil {
+		return nil
+	}
+	return img
+}
@@ -0,0 +1,30 @@
+package cardimage
+
+import (
+	"context"
+	"image"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+type MockRenderer struct {
+	RenderFunc func(ctx context.Context, content domain.CardImageContent, format domain.ImageFormat) ([]byte, error)
+}
+
+func (r *MockRenderer) Render(ctx context.Context, content domain.CardImageContent, format domain.ImageFormat) ([]byte, error) {
+	if r.RenderFunc != nil {
+		return r.RenderFunc(ctx, content, format)
+	}
+	return []byte("card"), nil
+}
+
+type MockAvatarFetcher struct {
+	FetchFunc func(ctx context.Context, url string) image.Image
+}
+
+func (f *MockAvatarFetcher) Fetch(ctx context.Context, url string) image.Image {
+	if f.FetchFunc != nil {
+		return f.FetchFunc(ctx, url)
+	}
+	return nil
+}
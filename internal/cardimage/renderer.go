@@ -0,0 +1,444 @@
+package cardimage
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"image"
+	"image/color"
+	"image/png"
+	"strconv"
+	"strings"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/identicon"
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// カードの画像のレイアウト（px）
+const (
+	cardWidth      = 400
+	cardHeight     = 560
+	cardRadius     = 24
+	cardBorder     = 8
+	identiconSize  = 240
+	identiconTop   = 48
+	avatarSize     = 72
+	avatarTop      = 328
+	textLeft       = 124
+	contentLeft    = 32
+	contentRight   = cardWidth - 32
+	languageTop    = 448
+	languageBarTop = 460
+	languageBarH   = 8
+	contributesTop = 496
+)
+
+// カードの画像の配色
+const (
+	backgroundColor    = "#0d1117"
+	panelColor         = "#ffffff"
+	textColor          = "#f0f6fc"
+	subTextColor       = "#8b949e"
+	placeholderColor   = "#30363d"
+	unknownLanguageHex = "#8b949e"
+)
+
+// 文字の大きさ（pt）
+const (
+	loginFontSize    = 26
+	nameFontSize     = 17
+	languageFontSize = 16
+	footerFontSize   = 12
+)
+
+// Renderer はカード全体をSVG・PNGの画像として描画する実装
+// README やSNSに貼り付けられるように、アバターも画像の中に埋め込む
+type Renderer struct {
+	identicon *identicon.Renderer
+	avatars   AvatarFetcher
+	bold      *opentype.Font
+	regular   *opentype.Font
+}
+
+// AvatarFetcher はアバター画像を取得する
+// 取得できない場合はnilを返し、カードには代わりの円を描く
+type AvatarFetcher interface {
+	Fetch(ctx context.Context, url string) image.Image
+}
+
+func NewRenderer(avatars AvatarFetcher) (*Renderer, error) {
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse bold font: %w", err)
+	}
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse regular font: %w", err)
+	}
+
+	return &Renderer{
+		identicon: identicon.NewRenderer(),
+		avatars:   avatars,
+		bold:      bold,
+		regular:   regular,
+	}, nil
+}
+
+// Render はカードの画像を描画する
+func (r *Renderer) Render(ctx context.Context, content domain.CardImageContent, format domain.ImageFormat) ([]byte, error) {
+	l, err := r.layout(ctx, content)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case domain.ImageFormatSVG:
+		return r.renderSVG(l)
+	case domain.ImageFormatPNG:
+		return r.renderPNG(l)
+	default:
+		return nil, fmt.Errorf("%w: unsupported image format: %s", domain.ErrInvalidArgument, format)
+	}
+}
+
+// cardLayout は描画する内容を形式によらない形にまとめたもの
+type cardLayout struct {
+	card          domain.Card
+	accent        domain.Color
+	languageColor domain.Color
+	login         string
+	name          string
+	language      string
+	languageBar   []languageSegment
+	contributions string
+	avatar        image.Image
+}
+
+// languageSegment は言語のバーの1つの言語の区間
+type languageSegment struct {
+	x     int
+	width int
+	color domain.Color
+}
+
+func (r *Renderer) layout(ctx context.Context, content domain.CardImageContent) (*cardLayout, error) {
+	card := content.Card
+	accent, err := domain.ParseColor(string(card.Color))
+	if err != nil {
+		return nil, err
+	}
+
+	languageColor, err := domain.ParseColor(card.MostUsedLanguage.Color)
+	if err != nil {
+		languageColor = unknownLanguageHex
+	}
+	language := card.MostUsedLanguage.LanguageName
+	if language == "" {
+		language = "Unknown"
+	}
+
+	l := &cardLayout{
+		card:          card,
+		accent:        accent,
+		languageColor: languageColor,
+		login:         r.truncate(r.bold, loginFontSize, card.UserName, contentRight-textLeft),
+		name:          r.truncate(r.regular, nameFontSize, card.FullName, contentRight-textLeft),
+		language:      r.truncate(r.regular, languageFontSize, language, contentRight-contentLeft-24),
+		languageBar:   languageSegments(card.TopLanguages, contentLeft, contentRight-contentLeft),
+	}
+	if content.TotalContribution != nil {
+		l.contributions = formatCount(*content.TotalContribution) + " contributions"
+	}
+	if r.avatars != nil && card.IconUrl != "" {
+		l.avatar = r.avatars.Fetch(ctx, card.IconUrl)
+	}
+
+	return l, nil
+}
+
+// languageSegments は言語の内訳を、x から幅 width のバーの区間に分ける
+// 上位の言語だけで割合の合計が100にならない場合もバーの幅いっぱいに描くように、表示する言語の合計で割る
+func languageSegments(breakdown []domain.LanguageBreakdown, x, width int) []languageSegment {
+	total := 0.0
+	for _, b := range breakdown {
+		total += b.Percentage
+	}
+	if total <= 0 {
+		return nil
+	}
+
+	segments := make([]languageSegment, 0, len(breakdown))
+	sum := 0.0
+	for _, b := range breakdown {
+		start := x + int(sum/total*float64(width))
+		sum += b.Percentage
+		end := x + int(sum/total*float64(width))
+		if end <= start {
+			continue
+		}
+
+		c, err := domain.ParseColor(b.Color)
+		if err != nil {
+			c = unknownLanguageHex
+		}
+		segments = append(segments, languageSegment{x: start, width: end - start, color: c})
+	}
+	return segments
+}
+
+func (r *Renderer) renderSVG(l *cardLayout) ([]byte, error) {
+	identiconSVG, err := r.identicon.Render(l.card.Color, l.card.Blocks, domain.ImageFormatSVG, identiconOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to render identicon: %w", err)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`, cardWidth, cardHeight, cardWidth, cardHeight)
+	buf.WriteString(`<style>text{font-family:-apple-system,BlinkMacSystemFont,"Segoe UI",Helvetica,Arial,sans-serif}</style>`)
+	fmt.Fprintf(&buf, `<defs><clipPath id="avatar"><circle cx="%d" cy="%d" r="%d"/></clipPath>`, contentLeft+avatarSize/2, avatarTop+avatarSize/2, avatarSize/2)
+	fmt.Fprintf(&buf, `<clipPath id="language-bar"><rect x="%d" y="%d" width="%d" height="%d" rx="%d"/></clipPath></defs>`, contentLeft, languageBarTop, contentRight-contentLeft, languageBarH, languageBarH/2)
+
+	// 枠と背景
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" rx="%d" fill="%s"/>`, cardWidth, cardHeight, cardRadius, l.accent)
+	fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="%d" rx="%d" fill="%s"/>`, cardBorder, cardBorder, cardWidth-2*cardBorder, cardHeight-2*cardBorder, cardRadius-cardBorder/2, backgroundColor)
+
+	// Identicon
+	identiconLeft := (cardWidth - identiconSize) / 2
+	fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="%d" rx="16" fill="%s"/>`, identiconLeft, identiconTop, identiconSize, identiconSize, panelColor)
+	fmt.Fprintf(&buf, `<g transform="translate(%d %d)">%s</g>`, identiconLeft, identiconTop, identiconSVG)
+
+	// アバター
+	if l.avatar != nil {
+		var avatarPNG bytes.Buffer
+		if err := png.Encode(&avatarPNG, l.avatar); err != nil {
+			return nil, fmt.Errorf("failed to encode avatar: %w", err)
+		}
+		fmt.Fprintf(&buf, `<image x="%d" y="%d" width="%d" height="%d" clip-path="url(#avatar)" href="data:image/png;base64,%s"/>`,
+			contentLeft, avatarTop, avatarSize, avatarSize, base64.StdEncoding.EncodeToString(avatarPNG.Bytes()))
+	} else {
+		fmt.Fprintf(&buf, `<circle cx="%d" cy="%d" r="%d" fill="%s"/>`, contentLeft+avatarSize/2, avatarTop+avatarSize/2, avatarSize/2, placeholderColor)
+	}
+
+	// ユーザー名・名前
+	fmt.Fprintf(&buf, `<text x="%d" y="%d" font-size="%d" font-weight="bold" fill="%s">%s</text>`, textLeft, avatarTop+32, loginFontSize, textColor, html.EscapeString(l.login))
+	fmt.Fprintf(&buf, `<text x="%d" y="%d" font-size="%d" fill="%s">%s</text>`, textLeft, avatarTop+60, nameFontSize, subTextColor, html.EscapeString(l.name))
+
+	// 言語
+	fmt.Fprintf(&buf, `<circle cx="%d" cy="%d" r="8" fill="%s"/>`, contentLeft+8, languageTop-6, l.languageColor)
+	fmt.Fprintf(&buf, `<text x="%d" y="%d" font-size="%d" fill="%s">%s</text>`, contentLeft+24, languageTop, languageFontSize, textColor, html.EscapeString(l.language))
+	if len(l.languageBar) > 0 {
+		buf.WriteString(`<g clip-path="url(#language-bar)">`)
+		for _, segment := range l.languageBar {
+			fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s"/>`, segment.x, languageBarTop, segment.width, languageBarH, segment.color)
+		}
+		buf.WriteString(`</g>`)
+	}
+
+	// コントリビュート数
+	if l.contributions != "" {
+		fmt.Fprintf(&buf, `<text x="%d" y="%d" font-size="%d" fill="%s">%s</text>`, contentLeft, contributesTop, languageFontSize, textColor, html.EscapeString(l.contributions))
+	}
+
+	fmt.Fprintf(&buf, `<text x="%d" y="%d" font-size="%d" text-anchor="end" fill="%s">OctoDeck</text>`, contentRight, cardHeight-28, footerFontSize, subTextColor)
+	buf.WriteString(`</svg>`)
+
+	return buf.Bytes(), nil
+}
+
+func (r *Renderer) renderPNG(l *cardLayout) ([]byte, error) {
+	img := image.NewNRGBA(image.Rect(0, 0, cardWidth, cardHeight))
+
+	// 枠と背景
+	fillShape(img, img.Bounds(), mustColor(l.accent), roundedRect(img.Bounds(), cardRadius))
+	inner := image.Rect(cardBorder, cardBorder, cardWidth-cardBorder, cardHeight-cardBorder)
+	fillShape(img, inner, mustColor(backgroundColor), roundedRect(inner, cardRadius-cardBorder/2))
+
+	// Identicon
+	identiconLeft := (cardWidth - identiconSize) / 2
+	panel := image.Rect(identiconLeft, identiconTop, identiconLeft+identiconSize, identiconTop+identiconSize)
+	fillShape(img, panel, mustColor(panelColor), roundedRect(panel, 16))
+	identiconPNG, err := r.identicon.Render(l.card.Color, l.card.Blocks, domain.ImageFormatPNG, identiconOptions())
+	if err != nil {
+		return nil, fmt.Errorf("failed to render identicon: %w", err)
+	}
+	identiconImg, err := png.Decode(bytes.NewReader(identiconPNG))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode identicon: %w", err)
+	}
+	draw.Draw(img, panel, identiconImg, image.Point{}, draw.Over)
+
+	// アバター
+	avatarRect := image.Rect(contentLeft, avatarTop, contentLeft+avatarSize, avatarTop+avatarSize)
+	if l.avatar != nil {
+		scaled := image.NewNRGBA(image.Rect(0, 0, avatarSize, avatarSize))
+		draw.CatmullRom.Scale(scaled, scaled.Bounds(), l.avatar, l.avatar.Bounds(), draw.Src, nil)
+		draw.DrawMask(img, avatarRect, scaled, image.Point{}, circle(avatarRect), avatarRect.Min, draw.Over)
+	} else {
+		fillShape(img, avatarRect, mustColor(placeholderColor), circle(avatarRect))
+	}
+
+	// ユーザー名・名前
+	if err := r.drawText(img, r.bold, loginFontSize, textColor, textLeft, avatarTop+32, l.login); err != nil {
+		return nil, err
+	}
+	if err := r.drawText(img, r.regular, nameFontSize, subTextColor, textLeft, avatarTop+60, l.name); err != nil {
+		return nil, err
+	}
+
+	// 言語
+	dot := image.Rect(contentLeft, languageTop-14, contentLeft+16, languageTop+2)
+	fillShape(img, dot, mustColor(l.languageColor), circle(dot))
+	if err := r.drawText(img, r.regular, languageFontSize, textColor, contentLeft+24, languageTop, l.language); err != nil {
+		return nil, err
+	}
+	bar := image.Rect(contentLeft, languageBarTop, contentRight, languageBarTop+languageBarH)
+	barShape := roundedRect(bar, languageBarH/2)
+	for _, segment := range l.languageBar {
+		rect := image.Rect(segment.x, languageBarTop, segment.x+segment.width, languageBarTop+languageBarH)
+		fillShape(img, rect, mustColor(segment.color), shape{bounds: rect, inside: barShape.inside})
+	}
+
+	// コントリビュート数
+	if l.contributions != "" {
+		if err := r.drawText(img, r.regular, languageFontSize, textColor, contentLeft, contributesTop, l.contributions); err != nil {
+			return nil, err
+		}
+	}
+
+	footerFace, err := r.face(r.regular, footerFontSize)
+	if err != nil {
+		return nil, err
+	}
+	footerWidth := font.MeasureString(footerFace, "OctoDeck").Ceil()
+	if err := r.drawText(img, r.regular, footerFontSize, subTextColor, contentRight-footerWidth, cardHeight-28, "OctoDeck"); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// identiconOptions はカードの中に描くIdenticonの設定
+func identiconOptions() domain.ImageOptions {
+	padding := identiconSize / 12
+	return domain.ImageOptions{Size: identiconSize, Padding: &padding}
+}
+
+func (r *Renderer) face(f *opentype.Font, size float64) (font.Face, error) {
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create font face: %w", err)
+	}
+	return face, nil
+}
+
+func (r *Renderer) drawText(img draw.Image, f *opentype.Font, size float64, hex domain.Color, x, y int, text string) error {
+	face, err := r.face(f, size)
+	if err != nil {
+		return err
+	}
+	defer face.Close()
+
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(mustColor(hex)),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(text)
+	return nil
+}
+
+// truncate は文字列が maxWidth（px）に収まらない場合に末尾を「…」で切り詰める
+// SVGでも同じ幅で切り詰めるため、Goフォントの幅を目安にする
+func (r *Renderer) truncate(f *opentype.Font, size float64, text string, maxWidth int) string {
+	face, err := r.face(f, size)
+	if err != nil {
+		return text
+	}
+	defer face.Close()
+
+	if font.MeasureString(face, text).Ceil() <= maxWidth {
+		return text
+	}
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		candidate := strings.TrimSpace(string(runes)) + "…"
+		if font.MeasureString(face, candidate).Ceil() <= maxWidth {
+			return candidate
+		}
+	}
+	return "…"
+}
+
+// formatCount は数値を3桁区切りの文字列にする
+func formatCount(n int) string {
+	s := strconv.Itoa(n)
+	sign := ""
+	if n < 0 {
+		sign, s = "-", s[1:]
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return sign + s
+}
+
+// mustColor は検証済みの "#rrggbb" 形式の色を描画用の色に変換する
+func mustColor(c domain.Color) color.NRGBA {
+	v, err := strconv.ParseUint(strings.TrimPrefix(string(c), "#"), 16, 32)
+	if err != nil {
+		return color.NRGBA{A: 0xff}
+	}
+	return color.NRGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}
+}
+
+// shape は図形の内側かどうかで透明度を返すマスク
+type shape struct {
+	bounds image.Rectangle
+	inside func(x, y int) bool
+}
+
+func (s shape) ColorModel() color.Model { return color.AlphaModel }
+func (s shape) Bounds() image.Rectangle { return s.bounds }
+func (s shape) At(x, y int) color.Color {
+	if s.inside(x, y) {
+		return color.Alpha{A: 0xff}
+	}
+	return color.Alpha{}
+}
+
+func fillShape(img draw.Image, r image.Rectangle, c color.Color, mask shape) {
+	draw.DrawMask(img, r, image.NewUniform(c), image.Point{}, mask, r.Min, draw.Over)
+}
+
+func circle(r image.Rectangle) shape {
+	cx, cy := float64(r.Min.X+r.Max.X)/2, float64(r.Min.Y+r.Max.Y)/2
+	radius := float64(r.Dx()) / 2
+	return shape{bounds: r, inside: func(x, y int) bool {
+		dx, dy := float64(x)+0.5-cx, float64(y)+0.5-cy
+		return dx*dx+dy*dy <= radius*radius
+	}}
+}
+
+func roundedRect(r image.Rectangle, radius int) shape {
+	return shape{bounds: r, inside: func(x, y int) bool {
+		// 角の円の中心からの距離で判定する
+		cx := min(max(x, r.Min.X+radius), r.Max.X-radius-1)
+		cy := min(max(y, r.Min.Y+radius), r.Max.Y-radius-1)
+		dx, dy := x-cx, y-cy
+		return dx*dx+dy*dy <= radius*radius
+	}}
+}
@@ -0,0 +1,217 @@
+package cardimage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+func newTestContent(totalContribution *int) domain.CardImageContent {
+	return domain.CardImageContent{
+		Card: domain.Card{
+			GithubID: "12345",
+			UserName: "octocat",
+			FullName: "The <Octocat> & Friends",
+			IconUrl:  "https://avatars.githubusercontent.com/u/12345",
+			Color:    "#8058d7",
+			Blocks:   domain.Blocks{{true}, {false, true}},
+			MostUsedLanguage: domain.Language{
+				LanguageName: "Go",
+				Color:        "#00ADD8",
+			},
+		},
+		TotalContribution: totalContribution,
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func newTestRenderer(t *testing.T, avatar image.Image) *Renderer {
+	t.Helper()
+	r, err := NewRenderer(&MockAvatarFetcher{
+		FetchFunc: func(ctx context.Context, url string) image.Image {
+			return avatar
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewRenderer() error = %v", err)
+	}
+	return r
+}
+
+func TestRenderer_RenderSVG(t *testing.T) {
+	avatar := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+
+	tests := []struct {
+		name         string
+		content      domain.CardImageContent
+		avatar       image.Image
+		wantContains []string
+		wantMissing  []string
+	}{
+		{
+			name:    "カードの内容を描画できる",
+			content: newTestContent(intPtr(1234567)),
+			avatar:  avatar,
+			wantContains: []string{
+				`width="400" height="560"`,
+				`<rect width="400" height="560" rx="24" fill="#8058d7"/>`,
+				`>octocat</text>`,
+				`>The &lt;Octocat&gt; &amp; Friends</text>`,
+				`fill="#00add8"`,
+				`>Go</text>`,
+				`>1,234,567 contributions</text>`,
+				`href="data:image/png;base64,`,
+			},
+		},
+		{
+			name:         "コントリビュート数がない場合は描かない",
+			content:      newTestContent(nil),
+			avatar:       avatar,
+			wantMissing:  []string{`contributions`},
+			wantContains: []string{`>octocat</text>`},
+		},
+		{
+			name: "言語の内訳がある場合は言語のバーを描く",
+			content: func() domain.CardImageContent {
+				content := newTestContent(nil)
+				content.Card.TopLanguages = []domain.LanguageBreakdown{
+					{LanguageName: "Go", Percentage: 60, Color: "#00ADD8"},
+					{LanguageName: "Shell", Percentage: 20, Color: "#89e051"},
+				}
+				return content
+			}(),
+			wantContains: []string{
+				`<g clip-path="url(#language-bar)">`,
+				`<rect x="32" y="460" width="252" height="8" fill="#00add8"/>`,
+				`<rect x="284" y="460" width="84" height="8" fill="#89e051"/>`,
+			},
+		},
+		{
+			name:         "言語の内訳がない場合は言語のバーを描かない",
+			content:      newTestContent(nil),
+			wantMissing:  []string{`url(#language-bar)`},
+			wantContains: []string{`>Go</text>`},
+		},
+		{
+			name:         "アバターを取得できない場合は代わりの円を描く",
+			content:      newTestContent(nil),
+			wantContains: []string{`<circle cx="68" cy="364" r="36" fill="#30363d"/>`},
+			wantMissing:  []string{`<image`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := newTestRenderer(t, tt.avatar).Render(context.Background(), tt.content, domain.ImageFormatSVG)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			svg := string(data)
+			for _, want := range tt.wantContains {
+				if !strings.Contains(svg, want) {
+					t.Errorf("SVGに %s が含まれていない: %s", want, svg)
+				}
+			}
+			for _, missing := range tt.wantMissing {
+				if strings.Contains(svg, missing) {
+					t.Errorf("SVGに %s が含まれている: %s", missing, svg)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderer_RenderPNG(t *testing.T) {
+	data, err := newTestRenderer(t, nil).Render(context.Background(), newTestContent(intPtr(10)), domain.ImageFormatPNG)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("PNGのデコードに失敗しました: %v", err)
+	}
+	if got := img.Bounds(); got != image.Rect(0, 0, cardWidth, cardHeight) {
+		t.Errorf("画像の大きさが違う: %v", got)
+	}
+
+	// 枠はカードの色、角は透明になる
+	if got := color.NRGBAModel.Convert(img.At(cardWidth/2, 2)).(color.NRGBA); got != (color.NRGBA{R: 0x80, G: 0x58, B: 0xd7, A: 0xff}) {
+		t.Errorf("枠の色が違う: %v", got)
+	}
+	if got := color.NRGBAModel.Convert(img.At(0, 0)).(color.NRGBA); got.A != 0 {
+		t.Errorf("角が透明になっていない: %v", got)
+	}
+}
+
+func TestRenderer_RenderInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content domain.CardImageContent
+		format  domain.ImageFormat
+	}{
+		{
+			name:    "未対応の形式はエラー",
+			content: newTestContent(nil),
+			format:  "gif",
+		},
+		{
+			name:    "カードの色が不正な場合はエラー",
+			content: domain.CardImageContent{Card: domain.Card{Color: "purple"}},
+			format:  domain.ImageFormatSVG,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTestRenderer(t, nil).Render(context.Background(), tt.content, tt.format)
+			if !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("ErrInvalidArgumentではない: %v", err)
+			}
+		})
+	}
+}
+
+func TestRenderer_Truncate(t *testing.T) {
+	r := newTestRenderer(t, nil)
+
+	if got := r.truncate(r.regular, nameFontSize, "short", 200); got != "short" {
+		t.Errorf("短い文字列が切り詰められた: %s", got)
+	}
+	got := r.truncate(r.regular, nameFontSize, strings.Repeat("long name ", 20), 200)
+	if !strings.HasSuffix(got, "…") || len([]rune(got)) >= 200 {
+		t.Errorf("長い文字列が切り詰められていない: %s", got)
+	}
+}
+
+func TestFormatCount(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{n: 0, want: "0"},
+		{n: 999, want: "999"},
+		{n: 1000, want: "1,000"},
+		{n: 1234567, want: "1,234,567"},
+		{n: -1234, want: "-1,234"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := formatCount(tt.n); got != tt.want {
+				t.Errorf("formatCount(%d) = %s, want %s", tt.n, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+// 環境変数を整数として読み込めることをテスト
+func TestInt(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{name: "設定されていない場合は既定値", value: "", want: 10},
+		{name: "数値を読み込める", value: "20", want: 20},
+		{name: "数値でない場合はエラー", value: "many", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_CONFIG_INT", tt.value)
+
+			got, err := Int("TEST_CONFIG_INT", 10)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Int() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Int() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// 環境変数を64ビットの整数として読み込めることをテスト
+func TestInt64(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{name: "設定されていない場合は既定値", value: "", want: 10},
+		{name: "intに収まらない数値を読み込める", value: "9007199254740993", want: 9007199254740993},
+		{name: "数値でない場合はエラー", value: "many", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_CONFIG_INT64", tt.value)
+
+			got, err := Int64("TEST_CONFIG_INT64", 10)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Int64() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Int64() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// 環境変数を時間として読み込めることをテスト
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "設定されていない場合は既定値", value: "", want: time.Minute},
+		{name: "時間を読み込める", value: "30m", want: 30 * time.Minute},
+		{name: "時間の形式でない場合はエラー", value: "30", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_CONFIG_DURATION", tt.value)
+
+			got, err := Duration("TEST_CONFIG_DURATION", time.Minute)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Duration() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Duration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -10,17 +10,22 @@ import (
 )
 
 type Card struct {
-	ID                    uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	GithubID              string          `gorm:"not null"`
-	NodeID                string          `gorm:"not null"`
-	CreatedAt             time.Time       `gorm:"autoCreateTime"`
-	Color                 string          `gorm:"not null"`
-	BlocksData            json.RawMessage `gorm:"type:jsonb;not null"`
-	UserName              string          `gorm:"default:''"`
-	FullName              string          `gorm:"default:''"`
-	IconUrl               string          `gorm:"default:''"`
-	MostUsedLanguageName  string          `gorm:"default:''"`
-	MostUsedLanguageColor string          `gorm:"default:''"`
+	ID                          uuid.UUID       `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	GithubID                    string          `gorm:"not null"`
+	NodeID                      string          `gorm:"not null"`
+	CreatedAt                   time.Time       `gorm:"autoCreateTime"`
+	Color                       string          `gorm:"not null"`
+	BlocksData                  json.RawMessage `gorm:"type:jsonb;not null"`
+	IdenticonVersion            int             `gorm:"not null;default:1"`
+	UserName                    string          `gorm:"default:''"`
+	FullName                    string          `gorm:"default:''"`
+	IconUrl                     string          `gorm:"default:''"`
+	MostUsedLanguageName        string          `gorm:"default:''"`
+	MostUsedLanguageColor       string          `gorm:"default:''"`
+	TopLanguages                json.RawMessage `gorm:"type:jsonb;not null;default:'[]'"`
+	IncludePrivateRepositories  bool            `gorm:"not null;default:false"`
+	RefreshedAt                 *time.Time
+	PrivateLanguagesRefreshedAt *time.Time
 }
 
 func (c *Card) BeforeCreate(tx *gorm.DB) error {
@@ -34,35 +39,57 @@ func (c *Card) ToDomain() *domain.Card {
 	var blocks domain.Blocks
 	_ = json.Unmarshal(c.BlocksData, &blocks)
 
+	topLanguages := []domain.LanguageBreakdown{}
+	_ = json.Unmarshal(c.TopLanguages, &topLanguages)
+
 	return &domain.Card{
-		ID:       domain.CardID(c.ID),
-		GithubID: c.GithubID,
-		NodeID:   c.NodeID,
-		Color:    domain.Color(c.Color),
-		Blocks:   blocks,
-		UserName: c.UserName,
-		FullName: c.FullName,
-		IconUrl:  c.IconUrl,
+		ID:               domain.CardID(c.ID),
+		GithubID:         c.GithubID,
+		NodeID:           c.NodeID,
+		Color:            domain.Color(c.Color),
+		Blocks:           blocks,
+		IdenticonVersion: domain.IdenticonVersion(c.IdenticonVersion),
+		UserName:         c.UserName,
+		FullName:         c.FullName,
+		IconUrl:          c.IconUrl,
 		MostUsedLanguage: domain.Language{
 			LanguageName: c.MostUsedLanguageName,
 			Color:        c.MostUsedLanguageColor,
 		},
+		TopLanguages: topLanguages,
+		Settings: domain.CardSettings{
+			IncludePrivateRepositories: c.IncludePrivateRepositories,
+		},
+		RefreshedAt:                 c.RefreshedAt,
+		PrivateLanguagesRefreshedAt: c.PrivateLanguagesRefreshedAt,
 	}
 }
 
 func CardFromDomain(card *domain.Card) *Card {
 	blocksData, _ := json.Marshal(card.Blocks)
 
+	// 内訳がない場合もNULLではなく空の配列を保存する
+	topLanguages := card.TopLanguages
+	if topLanguages == nil {
+		topLanguages = []domain.LanguageBreakdown{}
+	}
+	topLanguagesData, _ := json.Marshal(topLanguages)
+
 	return &Card{
-		ID:                    uuid.UUID(card.ID),
-		GithubID:              card.GithubID,
-		NodeID:                card.NodeID,
-		Color:                 string(card.Color),
-		BlocksData:            blocksData,
-		UserName:              card.UserName,
-		FullName:              card.FullName,
-		IconUrl:               card.IconUrl,
-		MostUsedLanguageName:  card.MostUsedLanguage.LanguageName,
-		MostUsedLanguageColor: card.MostUsedLanguage.Color,
+		ID:                          uuid.UUID(card.ID),
+		GithubID:                    card.GithubID,
+		NodeID:                      card.NodeID,
+		Color:                       string(card.Color),
+		BlocksData:                  blocksData,
+		IdenticonVersion:            int(card.IdenticonVersion),
+		UserName:                    card.UserName,
+		FullName:                    card.FullName,
+		IconUrl:                     card.IconUrl,
+		MostUsedLanguageName:        card.MostUsedLanguage.LanguageName,
+		MostUsedLanguageColor:       card.MostUsedLanguage.Color,
+		TopLanguages:                topLanguagesData,
+		IncludePrivateRepositories:  card.Settings.IncludePrivateRepositories,
+		RefreshedAt:                 card.RefreshedAt,
+		PrivateLanguagesRefreshedAt: card.PrivateLanguagesRefreshedAt,
 	}
 }
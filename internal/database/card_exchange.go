@@ -0,0 +1,76 @@
+package database
+
+import (
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CardExchange struct {
+	ID                 uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Token              string     `gorm:"not null;uniqueIndex"`
+	OfferedByGithubID  string     `gorm:"not null;index"`
+	OfferedCardID      uuid.UUID  `gorm:"type:uuid;not null"`
+	Status             string     `gorm:"not null;default:'pending'"`
+	AcceptedByGithubID *string    `gorm:"index"`
+	AcceptedCardID     *uuid.UUID `gorm:"type:uuid"`
+	ExpiresAt          time.Time  `gorm:"not null"`
+	AcceptedAt         *time.Time
+	CreatedAt          time.Time `gorm:"autoCreateTime"`
+
+	OfferedCard  Card  `gorm:"foreignKey:OfferedCardID"`
+	AcceptedCard *Card `gorm:"foreignKey:AcceptedCardID"`
+}
+
+func (ce *CardExchange) BeforeCreate(tx *gorm.DB) error {
+	if ce.ID == uuid.Nil {
+		ce.ID = uuid.New()
+	}
+	return nil
+}
+
+func (ce *CardExchange) ToDomain() *domain.CardExchange {
+	exchange := &domain.CardExchange{
+		ID:                 domain.CardExchangeID(ce.ID),
+		Token:              ce.Token,
+		OfferedByGithubID:  ce.OfferedByGithubID,
+		OfferedCardID:      domain.CardID(ce.OfferedCardID),
+		Status:             domain.CardExchangeStatus(ce.Status),
+		AcceptedByGithubID: ce.AcceptedByGithubID,
+		ExpiresAt:          ce.ExpiresAt,
+		AcceptedAt:         ce.AcceptedAt,
+		CreatedAt:          ce.CreatedAt,
+	}
+	if ce.AcceptedCardID != nil {
+		acceptedCardID := domain.CardID(*ce.AcceptedCardID)
+		exchange.AcceptedCardID = &acceptedCardID
+	}
+	if ce.OfferedCard.ID != uuid.Nil {
+		exchange.OfferedCard = ce.OfferedCard.ToDomain()
+	}
+	if ce.AcceptedCard != nil {
+		exchange.AcceptedCard = ce.AcceptedCard.ToDomain()
+	}
+	return exchange
+}
+
+func CardExchangeFromDomain(exchange *domain.CardExchange) *CardExchange {
+	ce := &CardExchange{
+		ID:                 uuid.UUID(exchange.ID),
+		Token:              exchange.Token,
+		OfferedByGithubID:  exchange.OfferedByGithubID,
+		OfferedCardID:      uuid.UUID(exchange.OfferedCardID),
+		Status:             string(exchange.Status),
+		AcceptedByGithubID: exchange.AcceptedByGithubID,
+		ExpiresAt:          exchange.ExpiresAt,
+		AcceptedAt:         exchange.AcceptedAt,
+		CreatedAt:          exchange.CreatedAt,
+	}
+	if exchange.AcceptedCardID != nil {
+		acceptedCardID := uuid.UUID(*exchange.AcceptedCardID)
+		ce.AcceptedCardID = &acceptedCardID
+	}
+	return ce
+}
@@ -9,10 +9,13 @@ import (
 )
 
 type CollectedCard struct {
-	ID                uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	CollectorGithubID string    `gorm:"not null"`
-	CardID            uuid.UUID `gorm:"type:uuid;not null"`
-	CollectedAt       time.Time `gorm:"autoCreateTime"`
+	ID                uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	CollectorGithubID string     `gorm:"not null;uniqueIndex:idx_collected_cards_collector_card"`
+	CardID            uuid.UUID  `gorm:"type:uuid;not null;uniqueIndex:idx_collected_cards_collector_card"`
+	CollectedAt       time.Time  `gorm:"autoCreateTime"`
+	Source            string     `gorm:"not null;default:'manual'"`
+	CommunityID       *uuid.UUID `gorm:"type:uuid"`
+	Note              string     `gorm:"not null;default:''"`
 
 	Card Card `gorm:"foreignKey:CardID"`
 }
@@ -25,9 +28,39 @@ func (cc *CollectedCard) BeforeCreate(tx *gorm.DB) error {
 }
 
 func (cc *CollectedCard) ToDomain() *domain.CollectedCard {
-	return &domain.CollectedCard{
+	collectedCard := &domain.CollectedCard{
 		ID:                domain.CollectedCardID(cc.ID),
 		CollectorGithubID: cc.CollectorGithubID,
 		CardID:            domain.CardID(cc.CardID),
+		CollectedAt:       cc.CollectedAt,
+		CollectDetail: domain.CollectDetail{
+			Source: domain.CollectSource(cc.Source),
+			Note:   cc.Note,
+		},
 	}
+	if cc.CommunityID != nil {
+		communityID := domain.CommunityID(*cc.CommunityID)
+		collectedCard.CommunityID = &communityID
+	}
+	if cc.Card.ID != uuid.Nil {
+		collectedCard.Card = cc.Card.ToDomain()
+	}
+	return collectedCard
+}
+
+// CollectedCardFromDomain はドメインモデルからデータベースモデルに変換する
+func CollectedCardFromDomain(cc *domain.CollectedCard) *CollectedCard {
+	collectedCard := &CollectedCard{
+		ID:                uuid.UUID(cc.ID),
+		CollectorGithubID: cc.CollectorGithubID,
+		CardID:            uuid.UUID(cc.CardID),
+		CollectedAt:       cc.CollectedAt,
+		Source:            string(cc.Source),
+		Note:              cc.Note,
+	}
+	if cc.CommunityID != nil {
+		communityID := uuid.UUID(*cc.CommunityID)
+		collectedCard.CommunityID = &communityID
+	}
+	return collectedCard
 }
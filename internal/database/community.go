@@ -9,16 +9,20 @@ import (
 )
 
 type Community struct {
-	ID                      uuid.UUID  `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	Name                    string     `gorm:"not null"`
-	StartedAt               time.Time  `gorm:"not null"`
-	EndedAt                 time.Time  `gorm:"not null"`
-	CreatedAt               time.Time  `gorm:"autoCreateTime"`
-	BestContributorCardID   *uuid.UUID `gorm:"type:uuid"`
-	BestCommitterCardID     *uuid.UUID `gorm:"type:uuid"`
-	BestIssuerCardID        *uuid.UUID `gorm:"type:uuid"`
-	BestPullRequesterCardID *uuid.UUID `gorm:"type:uuid"`
-	BestReviewerCardID      *uuid.UUID `gorm:"type:uuid"`
+	ID                      uuid.UUID      `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name                    string         `gorm:"not null"`
+	OwnerGithubID           string         `gorm:"not null;default:''"`
+	StartedAt               time.Time      `gorm:"not null"`
+	EndedAt                 time.Time      `gorm:"not null"`
+	CreatedAt               time.Time      `gorm:"autoCreateTime"`
+	DeletedAt               gorm.DeletedAt `gorm:"index"`
+	BestContributorCardID   *uuid.UUID     `gorm:"type:uuid"`
+	BestCommitterCardID     *uuid.UUID     `gorm:"type:uuid"`
+	BestIssuerCardID        *uuid.UUID     `gorm:"type:uuid"`
+	BestPullRequesterCardID *uuid.UUID     `gorm:"type:uuid"`
+	BestReviewerCardID      *uuid.UUID     `gorm:"type:uuid"`
+	// HighlightedCardRefreshedAt はHighlightedCardを最後に計算した日時
+	HighlightedCardRefreshedAt *time.Time
 	// リレーション
 	BestContributorCard   *Card `gorm:"foreignKey:BestContributorCardID"`
 	BestCommitterCard     *Card `gorm:"foreignKey:BestCommitterCardID"`
@@ -36,10 +40,16 @@ func (c *Community) BeforeCreate(tx *gorm.DB) error {
 
 func (c *Community) ToDomain() *domain.Community {
 	community := &domain.Community{
-		ID:        domain.CommunityID(c.ID),
-		Name:      c.Name,
-		StartedAt: c.StartedAt,
-		EndedAt:   c.EndedAt,
+		ID:            domain.CommunityID(c.ID),
+		Name:          c.Name,
+		OwnerGithubID: c.OwnerGithubID,
+		StartedAt:     c.StartedAt,
+		EndedAt:       c.EndedAt,
+	}
+
+	if c.DeletedAt.Valid {
+		deletedAt := c.DeletedAt.Time
+		community.DeletedAt = &deletedAt
 	}
 
 	// HighlightedCardを構築
@@ -10,10 +10,11 @@ import (
 
 type CommunityCard struct {
 	ID                uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	CommunityID       uuid.UUID `gorm:"type:uuid;not null"`
-	CardID            uuid.UUID `gorm:"type:uuid;not null"`
+	CommunityID       uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_community_cards_community_card"`
+	CardID            uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_community_cards_community_card"`
 	JoinedAt          time.Time `gorm:"autoCreateTime"`
 	TotalContribution int       `gorm:"default:0"`
+	Role              string    `gorm:"not null;default:'member'"`
 
 	Card      Card      `gorm:"foreignKey:CardID"`
 	Community Community `gorm:"foreignKey:CommunityID"`
@@ -33,5 +34,6 @@ func (cc *CommunityCard) ToDomain() *domain.CommunityCard {
 		CardID:            domain.CardID(cc.CardID),
 		JoinedAt:          cc.JoinedAt,
 		TotalContribution: cc.TotalContribution,
+		Role:              domain.CommunityRole(cc.Role),
 	}
 }
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CommunityInvite struct {
+	ID                uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	CommunityID       uuid.UUID `gorm:"type:uuid;not null;index"`
+	Code              string    `gorm:"not null;uniqueIndex"`
+	CreatedByGithubID string    `gorm:"not null"`
+	SingleUse         bool      `gorm:"not null;default:false"`
+	UsedCount         int       `gorm:"not null;default:0"`
+	ExpiresAt         time.Time `gorm:"not null"`
+	RevokedAt         *time.Time
+	CreatedAt         time.Time `gorm:"autoCreateTime"`
+
+	Community Community `gorm:"foreignKey:CommunityID;constraint:OnDelete:CASCADE"`
+}
+
+func (ci *CommunityInvite) BeforeCreate(tx *gorm.DB) error {
+	if ci.ID == uuid.Nil {
+		ci.ID = uuid.New()
+	}
+	return nil
+}
+
+func (ci *CommunityInvite) ToDomain() *domain.CommunityInvite {
+	return &domain.CommunityInvite{
+		ID:                domain.CommunityInviteID(ci.ID),
+		CommunityID:       domain.CommunityID(ci.CommunityID),
+		Code:              ci.Code,
+		CreatedByGithubID: ci.CreatedByGithubID,
+		SingleUse:         ci.SingleUse,
+		UsedCount:         ci.UsedCount,
+		ExpiresAt:         ci.ExpiresAt,
+		RevokedAt:         ci.RevokedAt,
+		CreatedAt:         ci.CreatedAt,
+	}
+}
+
+func CommunityInviteFromDomain(invite *domain.CommunityInvite) *CommunityInvite {
+	return &CommunityInvite{
+		ID:                uuid.UUID(invite.ID),
+		CommunityID:       uuid.UUID(invite.CommunityID),
+		Code:              invite.Code,
+		CreatedByGithubID: invite.CreatedByGithubID,
+		SingleUse:         invite.SingleUse,
+		UsedCount:         invite.UsedCount,
+		ExpiresAt:         invite.ExpiresAt,
+		RevokedAt:         invite.RevokedAt,
+		CreatedAt:         invite.CreatedAt,
+	}
+}
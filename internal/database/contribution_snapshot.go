@@ -0,0 +1,60 @@
+package database
+
+import (
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+type ContributionSnapshot struct {
+	GithubID          string    `gorm:"primaryKey"`
+	Date              time.Time `gorm:"type:date;primaryKey"`
+	ContributionCount int       `gorm:"not null;default:0"`
+	FetchedAt         time.Time `gorm:"not null"`
+}
+
+func (s *ContributionSnapshot) ToDomain() *domain.Contribution {
+	return domain.NewContribution(s.Date, s.ContributionCount)
+}
+
+type ContributionDetailSnapshot struct {
+	GithubID          string    `gorm:"primaryKey"`
+	PeriodFrom        time.Time `gorm:"primaryKey"`
+	PeriodTo          time.Time `gorm:"primaryKey"`
+	TotalContribution int       `gorm:"not null;default:0"`
+	CommitCount       int       `gorm:"not null;default:0"`
+	IssueCount        int       `gorm:"not null;default:0"`
+	PullRequestCount  int       `gorm:"not null;default:0"`
+	ReviewCount       int       `gorm:"not null;default:0"`
+	FetchedAt         time.Time `gorm:"not null"`
+}
+
+func (s *ContributionDetailSnapshot) ToDomain() *domain.ContributionDetailSnapshot {
+	return &domain.ContributionDetailSnapshot{
+		GithubID:          s.GithubID,
+		From:              s.PeriodFrom,
+		To:                s.PeriodTo,
+		TotalContribution: s.TotalContribution,
+		ContributionDetail: *domain.NewContributionDetail(
+			s.ReviewCount,
+			s.CommitCount,
+			s.PullRequestCount,
+			s.IssueCount,
+		),
+		FetchedAt: s.FetchedAt,
+	}
+}
+
+func ContributionDetailSnapshotFromDomain(snapshot *domain.ContributionDetailSnapshot) *ContributionDetailSnapshot {
+	return &ContributionDetailSnapshot{
+		GithubID:          snapshot.GithubID,
+		PeriodFrom:        snapshot.From,
+		PeriodTo:          snapshot.To,
+		TotalContribution: snapshot.TotalContribution,
+		CommitCount:       snapshot.ContributionDetail.CommitCount,
+		IssueCount:        snapshot.ContributionDetail.IssueCount,
+		PullRequestCount:  snapshot.ContributionDetail.PullRequestCount,
+		ReviewCount:       snapshot.ContributionDetail.ReviewCount,
+		FetchedAt:         snapshot.FetchedAt,
+	}
+}
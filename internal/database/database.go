@@ -4,9 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
 	"net"
-	"os"
 
 	"cloud.google.com/go/cloudsqlconn"
 	"github.com/jackc/pgx/v5"
@@ -15,21 +13,57 @@ import (
 	"gorm.io/gorm"
 )
 
-func ConnectWithConnectorIAMAuthN() (*gorm.DB, error) {
-	mustGetenv := func(k string) string {
-		v := os.Getenv(k)
-		if v == "" {
-			log.Fatalf("Warning: %s environment variable not set.", k)
-		}
-		return v
+// Connect は設定に応じた方法でデータベースに接続し、コネクションプールを設定する
+func Connect(cfg Config) (*gorm.DB, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
 	}
 
 	var (
-		dbUser                 = mustGetenv("DB_IAM_USER")              // e.g. 'service-account-name@project-id.iam'
-		dbName                 = mustGetenv("DB_NAME")                  // e.g. 'my-database'
-		instanceConnectionName = mustGetenv("INSTANCE_CONNECTION_NAME") // e.g. 'project:region:instance'
+		db  *gorm.DB
+		err error
 	)
+	if cfg.usesIAMAuthN() {
+		db, err = ConnectWithConnectorIAMAuthN(cfg)
+	} else {
+		db, err = ConnectWithURL(cfg.URL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database instance: %w", err)
+	}
+	if cfg.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	}
+	if cfg.ConnMaxIdleTime > 0 {
+		sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+	}
+
+	return db, nil
+}
+
+// ConnectWithURL はDSNを使ってPostgreSQLに直接接続する
+// ローカル開発やテスト用のデータベースに接続するときに使う
+func ConnectWithURL(dsn string) (*gorm.DB, error) {
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("gorm.Open: %w", err)
+	}
+	return db, nil
+}
 
+// ConnectWithConnectorIAMAuthN は Cloud SQL Go Connector を使って IAM 認証で接続する
+func ConnectWithConnectorIAMAuthN(cfg Config) (*gorm.DB, error) {
 	d, err := cloudsqlconn.NewDialer(
 		context.Background(),
 		cloudsqlconn.WithIAMAuthN(),
@@ -39,14 +73,14 @@ func ConnectWithConnectorIAMAuthN() (*gorm.DB, error) {
 		return nil, fmt.Errorf("cloudsqlconn.NewDialer: %w", err)
 	}
 	var opts []cloudsqlconn.DialOption
-	dsn := fmt.Sprintf("user=%s database=%s", dbUser, dbName)
+	dsn := fmt.Sprintf("user=%s database=%s", cfg.IAMUser, cfg.Name)
 	config, err := pgx.ParseConfig(dsn)
 	if err != nil {
 		return nil, err
 	}
 
 	config.DialFunc = func(ctx context.Context, network, instance string) (net.Conn, error) {
-		return d.Dial(ctx, instanceConnectionName, opts...)
+		return d.Dial(ctx, cfg.InstanceConnectionName, opts...)
 	}
 	dbURI := stdlib.RegisterConnConfig(config)
 	sqlDB, err := sql.Open("pgx", dbURI)
@@ -0,0 +1,37 @@
+package database
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+type GitHubCacheEntry struct {
+	Key       string          `gorm:"primaryKey"`
+	Value     json.RawMessage `gorm:"type:jsonb;not null"`
+	FetchedAt time.Time       `gorm:"not null"`
+	ExpiresAt time.Time       `gorm:"not null;index"`
+}
+
+func (GitHubCacheEntry) TableName() string {
+	return "github_cache_entries"
+}
+
+func (e *GitHubCacheEntry) ToDomain() *domain.CacheEntry {
+	return &domain.CacheEntry{
+		Key:       e.Key,
+		Value:     e.Value,
+		FetchedAt: e.FetchedAt,
+		ExpiresAt: e.ExpiresAt,
+	}
+}
+
+func GitHubCacheEntryFromDomain(entry *domain.CacheEntry) *GitHubCacheEntry {
+	return &GitHubCacheEntry{
+		Key:       entry.Key,
+		Value:     entry.Value,
+		FetchedAt: entry.FetchedAt,
+		ExpiresAt: entry.ExpiresAt,
+	}
+}
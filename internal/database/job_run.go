@@ -0,0 +1,51 @@
+package database
+
+import (
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/google/uuid"
+)
+
+type JobLock struct {
+	Name        string    `gorm:"primaryKey"`
+	LockedBy    string    `gorm:"not null"`
+	LockedUntil time.Time `gorm:"not null"`
+}
+
+type JobRun struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	JobName        string    `gorm:"not null"`
+	InstanceID     string    `gorm:"not null"`
+	Status         string    `gorm:"not null"`
+	StartedAt      time.Time `gorm:"not null"`
+	FinishedAt     *time.Time
+	ProcessedCount int    `gorm:"not null;default:0"`
+	Error          string `gorm:"not null;default:''"`
+}
+
+func (r *JobRun) ToDomain() *domain.JobRun {
+	return &domain.JobRun{
+		ID:             r.ID,
+		JobName:        r.JobName,
+		InstanceID:     r.InstanceID,
+		Status:         domain.JobStatus(r.Status),
+		StartedAt:      r.StartedAt,
+		FinishedAt:     r.FinishedAt,
+		ProcessedCount: r.ProcessedCount,
+		Error:          r.Error,
+	}
+}
+
+func JobRunFromDomain(run *domain.JobRun) *JobRun {
+	return &JobRun{
+		ID:             run.ID,
+		JobName:        run.JobName,
+		InstanceID:     run.InstanceID,
+		Status:         string(run.Status),
+		StartedAt:      run.StartedAt,
+		FinishedAt:     run.FinishedAt,
+		ProcessedCount: run.ProcessedCount,
+		Error:          run.Error,
+	}
+}
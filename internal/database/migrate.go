@@ -1,12 +1,264 @@
 package database
 
-import "gorm.io/gorm"
-
-func AutoMigrate(db *gorm.DB) error {
-	return db.AutoMigrate(
-		&Card{},
-		&CollectedCard{},
-		&Community{},
-		&CommunityCard{},
-	)
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// migrations/ 以下のSQLファイルをバイナリに埋め込む
+// ファイル名は <バージョン>_<名前>.up.sql / <バージョン>_<名前>.down.sql の形式にする
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// 複数のプロセスが同時にマイグレーションを実行しないためのアドバイザリーロックのキー
+const migrationLockKey = 7_231_120_001
+
+// ErrSchemaBehind はデータベースのスキーマが最新のマイグレーションより古いことを表す
+var ErrSchemaBehind = errors.New("database schema is behind")
+
+// Migration はバージョン管理されたスキーマ変更
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// SchemaMigration は適用済みのマイグレーションを記録するテーブル
+type SchemaMigration struct {
+	Version   int `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// LoadMigrations は埋め込まれたSQLファイルを読み込み、バージョン順に並べて返す
+func LoadMigrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationFiles, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+		base, direction, ok := cutDirection(fileName)
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name: %s", fileName)
+		}
+
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name: %s", fileName)
+		}
+		version, err := strconv.Atoi(versionStr)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", fileName)
+		}
+
+		content, err := migrationFiles.ReadFile(path.Join("migrations", fileName))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", fileName, err)
+		}
+
+		m, exists := byVersion[version]
+		if !exists {
+			m = &Migration{Version: version, Name: name}
+			byVersion[version] = m
+		}
+		if m.Name != name {
+			return nil, fmt.Errorf("migration version %d has different names: %s, %s", version, m.Name, name)
+		}
+
+		if direction == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" || m.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// cutDirection はファイル名から .up.sql / .down.sql を取り除き、向きを返す
+func cutDirection(fileName string) (string, string, bool) {
+	if base, ok := strings.CutSuffix(fileName, ".up.sql"); ok {
+		return base, "up", true
+	}
+	if base, ok := strings.CutSuffix(fileName, ".down.sql"); ok {
+		return base, "down", true
+	}
+	return "", "", false
+}
+
+// LatestVersion は埋め込まれているマイグレーションの最新バージョンを返す
+func LatestVersion() (int, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return 0, err
+	}
+	if len(migrations) == 0 {
+		return 0, nil
+	}
+	return migrations[len(migrations)-1].Version, nil
+}
+
+// CurrentVersion はデータベースに適用済みの最新バージョンを返す
+// schema_migrations テーブルがない場合は0を返す
+func CurrentVersion(db *gorm.DB) (int, error) {
+	if !db.Migrator().HasTable(&SchemaMigration{}) {
+		return 0, nil
+	}
+
+	var version int
+	if err := db.Model(&SchemaMigration{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+		return 0, fmt.Errorf("failed to get current schema version: %w", err)
+	}
+	return version, nil
+}
+
+// MigrateUp は未適用のマイグレーションを古い順にすべて適用し、適用したマイグレーションを返す
+// 各マイグレーションはschema_migrationsへの記録と合わせて1つのトランザクションで実行する
+func MigrateUp(db *gorm.DB) ([]Migration, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := createSchemaMigrationsTable(db); err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, m := range migrations {
+		ok, err := applyMigration(db, m, func(tx *gorm.DB, isApplied bool) (bool, error) {
+			if isApplied {
+				return false, nil
+			}
+			if err := tx.Exec(m.Up).Error; err != nil {
+				return false, err
+			}
+			return true, tx.Create(&SchemaMigration{Version: m.Version, Name: m.Name}).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("failed to apply migration %d_%s: %w", m.Version, m.Name, err)
+		}
+		if ok {
+			applied = append(applied, m)
+		}
+	}
+
+	return applied, nil
+}
+
+// MigrateDown は適用済みのマイグレーションを新しい順に steps 個だけ取り消し、取り消したマイグレーションを返す
+func MigrateDown(db *gorm.DB, steps int) ([]Migration, error) {
+	if steps <= 0 {
+		return nil, fmt.Errorf("steps must be positive: %d", steps)
+	}
+
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := createSchemaMigrationsTable(db); err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := len(migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+		m := migrations[i]
+		ok, err := applyMigration(db, m, func(tx *gorm.DB, isApplied bool) (bool, error) {
+			if !isApplied {
+				return false, nil
+			}
+			if err := tx.Exec(m.Down).Error; err != nil {
+				return false, err
+			}
+			return true, tx.Delete(&SchemaMigration{}, "version = ?", m.Version).Error
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("failed to revert migration %d_%s: %w", m.Version, m.Name, err)
+		}
+		if ok {
+			reverted = append(reverted, m)
+		}
+	}
+
+	return reverted, nil
+}
+
+// createSchemaMigrationsTable は適用済みのマイグレーションを記録するテーブルを作成する
+func createSchemaMigrationsTable(db *gorm.DB) error {
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version bigint PRIMARY KEY,
+			name text NOT NULL,
+			applied_at timestamptz NOT NULL DEFAULT now()
+		)
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+	return nil
+}
+
+// applyMigration はアドバイザリーロックを取ったトランザクションの中で、適用済みかどうかを確認してから fn を実行する
+func applyMigration(db *gorm.DB, m Migration, fn func(tx *gorm.DB, isApplied bool) (bool, error)) (bool, error) {
+	var changed bool
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", migrationLockKey).Error; err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&SchemaMigration{}).Where("version = ?", m.Version).Count(&count).Error; err != nil {
+			return err
+		}
+
+		var err error
+		changed, err = fn(tx, count > 0)
+		return err
+	})
+	return changed, err
+}
+
+// CheckSchema はデータベースのスキーマが最新のマイグレーションまで適用されているかを確認する
+// 古い場合は ErrSchemaBehind を返す
+func CheckSchema(db *gorm.DB) error {
+	latest, err := LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	current, err := CurrentVersion(db)
+	if err != nil {
+		return err
+	}
+
+	if current < latest {
+		return fmt.Errorf("%w: current=%d, latest=%d", ErrSchemaBehind, current, latest)
+	}
+
+	return nil
 }
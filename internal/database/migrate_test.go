@@ -0,0 +1,35 @@
+package database
+
+import "testing"
+
+// 埋め込まれたマイグレーションが正しく読み込めることをテスト
+func TestLoadMigrations(t *testing.T) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		t.Fatalf("LoadMigrations() error = %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("マイグレーションが1つもありません")
+	}
+
+	for i, m := range migrations {
+		// バージョンは1から連番になっている
+		if m.Version != i+1 {
+			t.Errorf("バージョンが連番になっていません: 期待=%d, 実際=%d (%s)", i+1, m.Version, m.Name)
+		}
+		if m.Name == "" {
+			t.Errorf("バージョン%dの名前が空です", m.Version)
+		}
+		if m.Up == "" || m.Down == "" {
+			t.Errorf("バージョン%dのup/downが空です", m.Version)
+		}
+	}
+
+	latest, err := LatestVersion()
+	if err != nil {
+		t.Fatalf("LatestVersion() error = %v", err)
+	}
+	if latest != migrations[len(migrations)-1].Version {
+		t.Errorf("LatestVersion() = %d, want %d", latest, migrations[len(migrations)-1].Version)
+	}
+}
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS community_cards;
+DROP TABLE IF EXISTS communities;
+DROP TABLE IF EXISTS collected_cards;
+DROP TABLE IF EXISTS cards;
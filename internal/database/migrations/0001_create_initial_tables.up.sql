@@ -0,0 +1,43 @@
+-- AutoMigrate で作成されていた初期スキーマ
+-- 既存のデータベースでもそのまま適用できるように IF NOT EXISTS を付けている
+CREATE TABLE IF NOT EXISTS cards (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    github_id text NOT NULL,
+    node_id text NOT NULL,
+    created_at timestamptz,
+    color text NOT NULL,
+    blocks_data jsonb NOT NULL,
+    user_name text DEFAULT '',
+    full_name text DEFAULT '',
+    icon_url text DEFAULT '',
+    most_used_language_name text DEFAULT '',
+    most_used_language_color text DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS collected_cards (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    collector_github_id text NOT NULL,
+    card_id uuid NOT NULL,
+    collected_at timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS communities (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    name text NOT NULL,
+    started_at timestamptz NOT NULL,
+    ended_at timestamptz NOT NULL,
+    created_at timestamptz,
+    best_contributor_card_id uuid,
+    best_committer_card_id uuid,
+    best_issuer_card_id uuid,
+    best_pull_requester_card_id uuid,
+    best_reviewer_card_id uuid
+);
+
+CREATE TABLE IF NOT EXISTS community_cards (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    community_id uuid NOT NULL,
+    card_id uuid NOT NULL,
+    joined_at timestamptz,
+    total_contribution bigint DEFAULT 0
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_community_cards_community_card;
+DROP INDEX IF EXISTS idx_collected_cards_collector_card;
@@ -0,0 +1,16 @@
+-- 同じユーザーが同じカードを複数回集めている行を、最も古いものだけ残して削除する
+DELETE FROM collected_cards a
+USING collected_cards b
+WHERE a.collector_github_id = b.collector_github_id
+    AND a.card_id = b.card_id
+    AND (a.collected_at, a.id) > (b.collected_at, b.id);
+
+-- 同じカードが同じコミュニティに複数回参加している行を、最も古いものだけ残して削除する
+DELETE FROM community_cards a
+USING community_cards b
+WHERE a.community_id = b.community_id
+    AND a.card_id = b.card_id
+    AND (a.joined_at, a.id) > (b.joined_at, b.id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_collected_cards_collector_card ON collected_cards (collector_github_id, card_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_community_cards_community_card ON community_cards (community_id, card_id);
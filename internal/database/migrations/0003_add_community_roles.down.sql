@@ -0,0 +1,2 @@
+ALTER TABLE community_cards DROP COLUMN IF EXISTS role;
+ALTER TABLE communities DROP COLUMN IF EXISTS owner_github_id;
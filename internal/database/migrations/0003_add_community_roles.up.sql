@@ -0,0 +1,23 @@
+ALTER TABLE communities ADD COLUMN IF NOT EXISTS owner_github_id text NOT NULL DEFAULT '';
+ALTER TABLE community_cards ADD COLUMN IF NOT EXISTS role text NOT NULL DEFAULT 'member';
+
+-- オーナーが設定されていない既存のコミュニティについて、最初に参加したメンバーをオーナーにする
+UPDATE community_cards cc
+SET role = 'owner'
+FROM communities c
+WHERE cc.community_id = c.id
+    AND c.owner_github_id = ''
+    AND cc.id = (
+        SELECT first.id FROM community_cards first
+        WHERE first.community_id = c.id
+        ORDER BY first.joined_at, first.id
+        LIMIT 1
+    );
+
+UPDATE communities c
+SET owner_github_id = cards.github_id
+FROM community_cards cc
+JOIN cards ON cards.id = cc.card_id
+WHERE cc.community_id = c.id
+    AND cc.role = 'owner'
+    AND c.owner_github_id = '';
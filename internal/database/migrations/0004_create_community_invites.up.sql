@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS community_invites (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    community_id uuid NOT NULL,
+    code text NOT NULL,
+    created_by_github_id text NOT NULL,
+    single_use boolean NOT NULL DEFAULT false,
+    used_count bigint NOT NULL DEFAULT 0,
+    expires_at timestamptz NOT NULL,
+    revoked_at timestamptz,
+    created_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_community_invites_code ON community_invites (code);
+CREATE INDEX IF NOT EXISTS idx_community_invites_community_id ON community_invites (community_id);
@@ -0,0 +1,11 @@
+ALTER TABLE communities
+    DROP CONSTRAINT IF EXISTS fk_communities_best_reviewer_card,
+    DROP CONSTRAINT IF EXISTS fk_communities_best_pull_requester_card,
+    DROP CONSTRAINT IF EXISTS fk_communities_best_issuer_card,
+    DROP CONSTRAINT IF EXISTS fk_communities_best_committer_card,
+    DROP CONSTRAINT IF EXISTS fk_communities_best_contributor_card;
+ALTER TABLE community_invites DROP CONSTRAINT IF EXISTS fk_community_invites_community;
+ALTER TABLE community_cards
+    DROP CONSTRAINT IF EXISTS fk_community_cards_community,
+    DROP CONSTRAINT IF EXISTS fk_community_cards_card;
+ALTER TABLE collected_cards DROP CONSTRAINT IF EXISTS fk_collected_cards_card;
@@ -0,0 +1,35 @@
+-- AutoMigrate が作成した ON DELETE の指定がない外部キーを作り直す
+ALTER TABLE collected_cards DROP CONSTRAINT IF EXISTS fk_collected_cards_card;
+ALTER TABLE community_cards DROP CONSTRAINT IF EXISTS fk_community_cards_card;
+ALTER TABLE community_cards DROP CONSTRAINT IF EXISTS fk_community_cards_community;
+ALTER TABLE community_invites DROP CONSTRAINT IF EXISTS fk_community_invites_community;
+ALTER TABLE communities DROP CONSTRAINT IF EXISTS fk_communities_best_contributor_card;
+ALTER TABLE communities DROP CONSTRAINT IF EXISTS fk_communities_best_committer_card;
+ALTER TABLE communities DROP CONSTRAINT IF EXISTS fk_communities_best_issuer_card;
+ALTER TABLE communities DROP CONSTRAINT IF EXISTS fk_communities_best_pull_requester_card;
+ALTER TABLE communities DROP CONSTRAINT IF EXISTS fk_communities_best_reviewer_card;
+
+-- 参照先がなくなっている行は外部キーを追加できないので先に削除する
+DELETE FROM collected_cards WHERE card_id NOT IN (SELECT id FROM cards);
+DELETE FROM community_cards WHERE card_id NOT IN (SELECT id FROM cards);
+DELETE FROM community_cards WHERE community_id NOT IN (SELECT id FROM communities);
+DELETE FROM community_invites WHERE community_id NOT IN (SELECT id FROM communities);
+UPDATE communities SET best_contributor_card_id = NULL WHERE best_contributor_card_id NOT IN (SELECT id FROM cards);
+UPDATE communities SET best_committer_card_id = NULL WHERE best_committer_card_id NOT IN (SELECT id FROM cards);
+UPDATE communities SET best_issuer_card_id = NULL WHERE best_issuer_card_id NOT IN (SELECT id FROM cards);
+UPDATE communities SET best_pull_requester_card_id = NULL WHERE best_pull_requester_card_id NOT IN (SELECT id FROM cards);
+UPDATE communities SET best_reviewer_card_id = NULL WHERE best_reviewer_card_id NOT IN (SELECT id FROM cards);
+
+ALTER TABLE collected_cards
+    ADD CONSTRAINT fk_collected_cards_card FOREIGN KEY (card_id) REFERENCES cards (id) ON DELETE CASCADE;
+ALTER TABLE community_cards
+    ADD CONSTRAINT fk_community_cards_card FOREIGN KEY (card_id) REFERENCES cards (id) ON DELETE CASCADE,
+    ADD CONSTRAINT fk_community_cards_community FOREIGN KEY (community_id) REFERENCES communities (id) ON DELETE CASCADE;
+ALTER TABLE community_invites
+    ADD CONSTRAINT fk_community_invites_community FOREIGN KEY (community_id) REFERENCES communities (id) ON DELETE CASCADE;
+ALTER TABLE communities
+    ADD CONSTRAINT fk_communities_best_contributor_card FOREIGN KEY (best_contributor_card_id) REFERENCES cards (id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_communities_best_committer_card FOREIGN KEY (best_committer_card_id) REFERENCES cards (id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_communities_best_issuer_card FOREIGN KEY (best_issuer_card_id) REFERENCES cards (id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_communities_best_pull_requester_card FOREIGN KEY (best_pull_requester_card_id) REFERENCES cards (id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_communities_best_reviewer_card FOREIGN KEY (best_reviewer_card_id) REFERENCES cards (id) ON DELETE SET NULL;
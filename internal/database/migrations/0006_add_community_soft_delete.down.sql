@@ -0,0 +1,4 @@
+-- 論理削除されているコミュニティは復元できなくなるので物理削除する
+DELETE FROM communities WHERE deleted_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_communities_deleted_at;
+ALTER TABLE communities DROP COLUMN IF EXISTS deleted_at;
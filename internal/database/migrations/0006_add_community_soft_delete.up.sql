@@ -0,0 +1,2 @@
+ALTER TABLE communities ADD COLUMN IF NOT EXISTS deleted_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_communities_deleted_at ON communities (deleted_at);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_community_cards_card_id;
+DROP INDEX IF EXISTS idx_cards_most_used_language_name;
+DROP INDEX IF EXISTS idx_cards_full_name_trgm;
+DROP INDEX IF EXISTS idx_cards_user_name_trgm;
+-- pg_trgm は他で使われている可能性があるので削除しない
@@ -0,0 +1,7 @@
+-- ユーザー名・フルネームの部分一致検索 (ILIKE '%...%') にインデックスを使うため pg_trgm を有効にする
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_cards_user_name_trgm ON cards USING gin (user_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_cards_full_name_trgm ON cards USING gin (full_name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_cards_most_used_language_name ON cards (lower(most_used_language_name));
+CREATE INDEX IF NOT EXISTS idx_community_cards_card_id ON community_cards (card_id);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_collected_cards_community_id;
+ALTER TABLE collected_cards DROP CONSTRAINT IF EXISTS fk_collected_cards_community;
+ALTER TABLE collected_cards DROP CONSTRAINT IF EXISTS chk_collected_cards_source;
+ALTER TABLE collected_cards DROP COLUMN IF EXISTS note;
+ALTER TABLE collected_cards DROP COLUMN IF EXISTS community_id;
+ALTER TABLE collected_cards DROP COLUMN IF EXISTS source;
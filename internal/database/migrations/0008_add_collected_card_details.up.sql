@@ -0,0 +1,12 @@
+ALTER TABLE collected_cards ADD COLUMN IF NOT EXISTS source text NOT NULL DEFAULT 'manual';
+ALTER TABLE collected_cards ADD COLUMN IF NOT EXISTS community_id uuid;
+ALTER TABLE collected_cards ADD COLUMN IF NOT EXISTS note text NOT NULL DEFAULT '';
+
+ALTER TABLE collected_cards DROP CONSTRAINT IF EXISTS chk_collected_cards_source;
+ALTER TABLE collected_cards ADD CONSTRAINT chk_collected_cards_source CHECK (source IN ('qr', 'nfc', 'manual'));
+
+-- コミュニティが削除されてもカードはデッキに残す
+ALTER TABLE collected_cards DROP CONSTRAINT IF EXISTS fk_collected_cards_community;
+ALTER TABLE collected_cards ADD CONSTRAINT fk_collected_cards_community
+    FOREIGN KEY (community_id) REFERENCES communities (id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_collected_cards_community_id ON collected_cards (community_id);
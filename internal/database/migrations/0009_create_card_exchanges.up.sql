@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS card_exchanges (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    token text NOT NULL,
+    offered_by_github_id text NOT NULL,
+    offered_card_id uuid NOT NULL REFERENCES cards (id) ON DELETE CASCADE,
+    status text NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'accepted', 'expired')),
+    accepted_by_github_id text,
+    accepted_card_id uuid REFERENCES cards (id) ON DELETE SET NULL,
+    expires_at timestamptz NOT NULL,
+    accepted_at timestamptz,
+    created_at timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_card_exchanges_token ON card_exchanges (token);
+CREATE INDEX IF NOT EXISTS idx_card_exchanges_offered_by_github_id ON card_exchanges (offered_by_github_id);
+CREATE INDEX IF NOT EXISTS idx_card_exchanges_accepted_by_github_id ON card_exchanges (accepted_by_github_id);
@@ -0,0 +1,2 @@
+ALTER TABLE cards DROP CONSTRAINT IF EXISTS chk_cards_identicon_version;
+ALTER TABLE cards DROP COLUMN IF EXISTS identicon_version;
@@ -0,0 +1,5 @@
+-- 既存のカードはすべて最初の生成方法（バージョン1）で作られている
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS identicon_version integer NOT NULL DEFAULT 1;
+
+ALTER TABLE cards DROP CONSTRAINT IF EXISTS chk_cards_identicon_version;
+ALTER TABLE cards ADD CONSTRAINT chk_cards_identicon_version CHECK (identicon_version > 0);
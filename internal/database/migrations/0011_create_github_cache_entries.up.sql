@@ -0,0 +1,9 @@
+-- GitHub APIの応答のキャッシュ。複数のインスタンスで共有する
+CREATE TABLE IF NOT EXISTS github_cache_entries (
+    key text PRIMARY KEY,
+    value jsonb NOT NULL,
+    fetched_at timestamptz NOT NULL,
+    expires_at timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_github_cache_entries_expires_at ON github_cache_entries (expires_at);
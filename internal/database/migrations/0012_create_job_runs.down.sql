@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS job_runs;
+DROP TABLE IF EXISTS job_locks;
+ALTER TABLE communities DROP COLUMN IF EXISTS highlighted_card_refreshed_at;
+DROP INDEX IF EXISTS idx_cards_refreshed_at;
+ALTER TABLE cards DROP COLUMN IF EXISTS refreshed_at;
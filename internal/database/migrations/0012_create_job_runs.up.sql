@@ -0,0 +1,28 @@
+-- 定期的に更新するカードとコミュニティを選ぶために、最後に更新した日時を記録する（NULLは未更新）
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS refreshed_at timestamptz;
+CREATE INDEX IF NOT EXISTS idx_cards_refreshed_at ON cards (refreshed_at NULLS FIRST);
+
+ALTER TABLE communities ADD COLUMN IF NOT EXISTS highlighted_card_refreshed_at timestamptz;
+
+-- 複数のインスタンスで同じジョブを同時に実行しないためのロック
+-- locked_until を過ぎたロックは、実行中のインスタンスが落ちたものとして他のインスタンスが取り直せる
+CREATE TABLE IF NOT EXISTS job_locks (
+    name text PRIMARY KEY,
+    locked_by text NOT NULL,
+    locked_until timestamptz NOT NULL
+);
+
+-- ジョブの実行履歴
+CREATE TABLE IF NOT EXISTS job_runs (
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_name text NOT NULL,
+    instance_id text NOT NULL,
+    status text NOT NULL,
+    started_at timestamptz NOT NULL,
+    finished_at timestamptz,
+    processed_count integer NOT NULL DEFAULT 0,
+    error text NOT NULL DEFAULT '',
+    CONSTRAINT chk_job_runs_status CHECK (status IN ('running', 'succeeded', 'failed'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_job_runs_job_name_started_at ON job_runs (job_name, started_at DESC);
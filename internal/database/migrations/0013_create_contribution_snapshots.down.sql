@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS contribution_detail_snapshots;
+DROP TABLE IF EXISTS contribution_snapshots;
@@ -0,0 +1,25 @@
+-- ユーザーの日ごとのコントリビューション数の記録
+-- 統計情報やコミュニティを更新するたびに上書きし、GitHubを呼ばずに過去の期間の統計を求めるために使う
+CREATE TABLE IF NOT EXISTS contribution_snapshots (
+    github_id text NOT NULL,
+    date date NOT NULL,
+    contribution_count integer NOT NULL DEFAULT 0,
+    fetched_at timestamptz NOT NULL,
+    PRIMARY KEY (github_id, date)
+);
+
+-- ユーザーのある期間のコントリビューションの内訳の記録
+-- GitHubは内訳を期間ごとの合計でしか返さないため、取得した期間ごとに保存する
+CREATE TABLE IF NOT EXISTS contribution_detail_snapshots (
+    github_id text NOT NULL,
+    period_from timestamptz NOT NULL,
+    period_to timestamptz NOT NULL,
+    total_contribution integer NOT NULL DEFAULT 0,
+    commit_count integer NOT NULL DEFAULT 0,
+    issue_count integer NOT NULL DEFAULT 0,
+    pull_request_count integer NOT NULL DEFAULT 0,
+    review_count integer NOT NULL DEFAULT 0,
+    fetched_at timestamptz NOT NULL,
+    PRIMARY KEY (github_id, period_from, period_to),
+    CONSTRAINT chk_contribution_detail_snapshots_period CHECK (period_from <= period_to)
+);
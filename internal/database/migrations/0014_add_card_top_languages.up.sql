@@ -0,0 +1,2 @@
+-- 言語の内訳（多い順）。既存のカードは次の更新で埋まる
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS top_languages jsonb NOT NULL DEFAULT '[]';
@@ -0,0 +1,2 @@
+-- カードの持ち主が選ぶ設定。プライベートリポジトリを言語の内訳に含めるかどうか
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS include_private_repositories boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- プライベートリポジトリを含めて言語を最後に集計した日時。GET /cards/me で取り直すかどうかの判定に使う
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS private_languages_refreshed_at timestamptz;
@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// CacheEntry は外部APIの応答をキャッシュに保存したもの
+type CacheEntry struct {
+	Key string
+	// Value はJSONにエンコードした応答
+	Value []byte
+	// FetchedAt は外部APIから取得した日時
+	FetchedAt time.Time
+	// ExpiresAt を過ぎたエントリは古いデータとしても使わない
+	ExpiresAt time.Time
+}
+
+// IsExpired は now の時点でエントリの期限が切れているかを返す
+func (e *CacheEntry) IsExpired(now time.Time) bool {
+	return !now.Before(e.ExpiresAt)
+}
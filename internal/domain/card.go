@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -16,7 +18,21 @@ func NewCardID() CardID {
 
 type Color string
 
-type Blocks [5][5]bool
+// Blocks はIdenticonの正方形のブロックの並び。trueがブロック
+// 一辺の数はIdenticonのバージョンによって変わる（5x5 や 7x7）
+type Blocks [][]bool
+
+// MaxBlocksSize はBlocksの一辺の最大の数
+const MaxBlocksSize = 7
+
+// NewBlocks は一辺が size の空のBlocksを作る
+func NewBlocks(size int) Blocks {
+	blocks := make(Blocks, size)
+	for i := range blocks {
+		blocks[i] = make([]bool, size)
+	}
+	return blocks
+}
 
 type Card struct {
 	ID               CardID
@@ -27,16 +43,25 @@ type Card struct {
 	IconUrl          string
 	Color            Color
 	Blocks           Blocks
+	IdenticonVersion IdenticonVersion
 	MostUsedLanguage Language
+	// TopLanguages はコードの量が多い順の言語の内訳
+	TopLanguages []LanguageBreakdown
+	Settings     CardSettings
+	// RefreshedAt はGitHubの情報で最後に更新した日時（一度も更新していない場合はnil）
+	RefreshedAt *time.Time
+	// PrivateLanguagesRefreshedAt はプライベートリポジトリを含めて言語を最後に集計した日時（一度も集計していない場合はnil）
+	PrivateLanguagesRefreshedAt *time.Time
 }
 
-func NewCard(githubID string, nodeID string, color Color, blocks Blocks, mostUsedLanguage Language, userName string, fullName string, iconUrl string) *Card {
+func NewCard(githubID string, nodeID string, color Color, blocks Blocks, identiconVersion IdenticonVersion, mostUsedLanguage Language, userName string, fullName string, iconUrl string) *Card {
 	return &Card{
 		ID:               NewCardID(),
 		GithubID:         githubID,
 		NodeID:           nodeID,
 		Color:            color,
 		Blocks:           blocks,
+		IdenticonVersion: identiconVersion,
 		MostUsedLanguage: mostUsedLanguage,
 		UserName:         userName,
 		FullName:         fullName,
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CardExchangeID uuid.UUID
+
+func NewCardExchangeID() CardExchangeID {
+	return CardExchangeID(uuid.New())
+}
+
+// CardExchangeStatus はカード交換の状態
+type CardExchangeStatus string
+
+const (
+	// CardExchangeStatusPending は相手が受け取るのを待っている
+	CardExchangeStatusPending CardExchangeStatus = "pending"
+	// CardExchangeStatusAccepted は相手が受け取り、お互いのデッキにカードが追加された
+	CardExchangeStatusAccepted CardExchangeStatus = "accepted"
+	// CardExchangeStatusExpired は受け取られないまま有効期限が切れた
+	CardExchangeStatusExpired CardExchangeStatus = "expired"
+)
+
+// 交換トークンのバイト数（base64urlで22文字になる）
+const exchangeTokenBytes = 16
+
+// CardExchange はカード交換の申し出
+// 申し出た人が発行したトークンを相手が受け取ると、お互いのデッキに相手のカードが追加される
+type CardExchange struct {
+	ID                 CardExchangeID
+	Token              string
+	OfferedByGithubID  string
+	OfferedCardID      CardID
+	Status             CardExchangeStatus
+	AcceptedByGithubID *string
+	AcceptedCardID     *CardID
+	ExpiresAt          time.Time
+	AcceptedAt         *time.Time
+	CreatedAt          time.Time
+	// OfferedCard は申し出た人のカード（取得したときのみ設定される）
+	OfferedCard *Card
+	// AcceptedCard は受け取った人のカード（取得したときのみ設定される）
+	AcceptedCard *Card
+}
+
+func NewCardExchange(offeredByGithubID string, offeredCardID CardID, expiresAt time.Time) (*CardExchange, error) {
+	token, err := newExchangeToken()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CardExchange{
+		ID:                NewCardExchangeID(),
+		Token:             token,
+		OfferedByGithubID: offeredByGithubID,
+		OfferedCardID:     offeredCardID,
+		Status:            CardExchangeStatusPending,
+		ExpiresAt:         expiresAt,
+		CreatedAt:         time.Now(),
+	}, nil
+}
+
+// StatusAt は指定した時刻での状態を返す
+// 受け取られていない申し出は有効期限を過ぎると expired になる
+func (e *CardExchange) StatusAt(now time.Time) CardExchangeStatus {
+	if e.Status == CardExchangeStatusPending && !now.Before(e.ExpiresAt) {
+		return CardExchangeStatusExpired
+	}
+	return e.Status
+}
+
+// newExchangeToken は推測できないランダムな交換トークンを生成する
+func newExchangeToken() (string, error) {
+	buf := make([]byte, exchangeTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate exchange token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
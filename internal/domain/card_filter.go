@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxCardQueryLength は検索文字列の最大文字数
+const MaxCardQueryLength = 100
+
+// CardFilter はデッキのカードを絞り込む条件
+// 空の項目は条件に含めない
+type CardFilter struct {
+	// Query はユーザー名またはフルネームに含まれる文字列（大文字小文字を区別しない）
+	Query string
+	// Language は最も使われている言語名（大文字小文字を区別しない）
+	Language string
+	// CommunityID はカードの持ち主と自分がともに参加しているコミュニティのID
+	CommunityID string
+}
+
+// Normalize は前後の空白を取り除き、条件が正しいかを確認する
+func (f CardFilter) Normalize() (CardFilter, error) {
+	f.Query = strings.TrimSpace(f.Query)
+	f.Language = strings.TrimSpace(f.Language)
+	f.CommunityID = strings.TrimSpace(f.CommunityID)
+
+	if utf8.RuneCountInString(f.Query) > MaxCardQueryLength {
+		return CardFilter{}, fmt.Errorf("%w: query must be at most %d characters", ErrInvalidArgument, MaxCardQueryLength)
+	}
+
+	return f, nil
+}
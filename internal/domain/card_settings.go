@@ -0,0 +1,8 @@
+package domain
+
+// CardSettings はカードの持ち主が選ぶカードの設定
+type CardSettings struct {
+	// IncludePrivateRepositories はプライベートリポジトリも言語の内訳に含めるかどうか
+	// 持ち主のトークンでしか集計できないので、持ち主が自分のカードを取得したときだけ言語を更新する
+	IncludePrivateRepositories bool
+}
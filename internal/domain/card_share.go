@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// CardShare はQRコードやNFCで相手に渡すカードの共有情報
+// サーバーが署名した有効期限付きのペイロードとしてやり取りし、実際に会った相手だけがカードを集められるようにする
+type CardShare struct {
+	GithubID  string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	// Payload は署名付きのペイロード（発行したときのみ設定される）
+	Payload string
+}
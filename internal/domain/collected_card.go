@@ -1,6 +1,11 @@
 package domain
 
 import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
 	"github.com/google/uuid"
 )
 
@@ -10,16 +15,82 @@ func NewCollectedCardID() CollectedCardID {
 	return CollectedCardID(uuid.New())
 }
 
+// CollectSource はカードを集めた方法
+type CollectSource string
+
+const (
+	// CollectSourceQR はQRコードを読み取って集めた
+	CollectSourceQR CollectSource = "qr"
+	// CollectSourceNFC はNFCで交換して集めた
+	CollectSourceNFC CollectSource = "nfc"
+	// CollectSourceManual はユーザーを検索して集めた
+	CollectSourceManual CollectSource = "manual"
+)
+
+// MaxCollectNoteLength はカードに付けるメモの最大文字数
+const MaxCollectNoteLength = 500
+
+// ParseCollectSource は文字列をカードを集めた方法に変換する
+// 空文字の場合は CollectSourceManual として扱う
+func ParseCollectSource(s string) (CollectSource, error) {
+	switch source := CollectSource(s); source {
+	case "":
+		return CollectSourceManual, nil
+	case CollectSourceQR, CollectSourceNFC, CollectSourceManual:
+		return source, nil
+	default:
+		return "", fmt.Errorf("%w: unsupported collect source: %s", ErrInvalidArgument, s)
+	}
+}
+
+// CollectDetail はカードを集めたときの状況
+type CollectDetail struct {
+	Source CollectSource
+	// CommunityID はカードを交換したコミュニティ（コミュニティ外で交換した場合はnil）
+	CommunityID *CommunityID
+	Note        string
+}
+
+// Normalize は集めた方法を解釈し、メモの前後の空白を取り除く
+// メモが長すぎる場合は ErrInvalidArgument を返す
+func (d CollectDetail) Normalize() (CollectDetail, error) {
+	source, err := ParseCollectSource(string(d.Source))
+	if err != nil {
+		return CollectDetail{}, err
+	}
+	d.Source = source
+
+	d.Note = strings.TrimSpace(d.Note)
+	if utf8.RuneCountInString(d.Note) > MaxCollectNoteLength {
+		return CollectDetail{}, fmt.Errorf("%w: note must be at most %d characters", ErrInvalidArgument, MaxCollectNoteLength)
+	}
+
+	return d, nil
+}
+
 type CollectedCard struct {
 	ID                CollectedCardID
 	CollectorGithubID string
 	CardID            CardID
+	CollectedAt       time.Time
+	CollectDetail
+	// Card は集めたカード（一覧を取得したときのみ設定される）
+	Card *Card
 }
 
-func NewCollectedCard(collectorGithubID string, cardID CardID) *CollectedCard {
+// NewCollectedCard はデッキに追加するカードを作成する
+// Source が空の場合は CollectSourceManual として扱う
+func NewCollectedCard(collectorGithubID string, cardID CardID, detail CollectDetail) (*CollectedCard, error) {
+	detail, err := detail.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
 	return &CollectedCard{
 		ID:                NewCollectedCardID(),
 		CollectorGithubID: collectorGithubID,
 		CardID:            cardID,
-	}
+		CollectedAt:       time.Now(),
+		CollectDetail:     detail,
+	}, nil
 }
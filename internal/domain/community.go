@@ -8,6 +8,10 @@ import (
 
 type CommunityID uuid.UUID
 
+func (c CommunityID) String() string {
+	return uuid.UUID(c).String()
+}
+
 func NewCommunityID() CommunityID {
 	return CommunityID(uuid.New())
 }
@@ -15,15 +19,19 @@ func NewCommunityID() CommunityID {
 type Community struct {
 	ID              CommunityID
 	Name            string
+	OwnerGithubID   string
 	StartedAt       time.Time
 	EndedAt         time.Time
 	HighlightedCard HighlightedCard
+	// DeletedAt は論理削除された日時（削除されていない場合はnil）
+	DeletedAt *time.Time
 }
 
-func NewCommunity(name string, startedAt time.Time, endedAt time.Time, highlightedCard HighlightedCard) *Community {
+func NewCommunity(name string, ownerGithubID string, startedAt time.Time, endedAt time.Time, highlightedCard HighlightedCard) *Community {
 	return &Community{
 		ID:              NewCommunityID(),
 		Name:            name,
+		OwnerGithubID:   ownerGithubID,
 		StartedAt:       startedAt,
 		EndedAt:         endedAt,
 		HighlightedCard: highlightedCard,
@@ -18,14 +18,16 @@ type CommunityCard struct {
 	CardID            CardID
 	JoinedAt          time.Time
 	TotalContribution int
+	Role              CommunityRole
 }
 
-func NewCommunityCard(communityID CommunityID, cardID CardID) *CommunityCard {
+func NewCommunityCard(communityID CommunityID, cardID CardID, role CommunityRole) *CommunityCard {
 	return &CommunityCard{
 		ID:                NewCommunityCardID(),
 		CommunityID:       communityID,
 		CardID:            cardID,
 		JoinedAt:          time.Now(),
 		TotalContribution: 0,
+		Role:              role,
 	}
 }
@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CommunityInviteID uuid.UUID
+
+func NewCommunityInviteID() CommunityInviteID {
+	return CommunityInviteID(uuid.New())
+}
+
+// 招待コードに使う文字（読み間違えやすい 0/O, 1/I/L を除く）
+const inviteCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+// 招待コードの長さ
+const inviteCodeLength = 8
+
+// CommunityInvite はコミュニティへの招待コード
+type CommunityInvite struct {
+	ID                CommunityInviteID
+	CommunityID       CommunityID
+	Code              string
+	CreatedByGithubID string
+	SingleUse         bool
+	UsedCount         int
+	ExpiresAt         time.Time
+	RevokedAt         *time.Time
+	CreatedAt         time.Time
+}
+
+func NewCommunityInvite(communityID CommunityID, createdByGithubID string, expiresAt time.Time, singleUse bool) (*CommunityInvite, error) {
+	code, err := newInviteCode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &CommunityInvite{
+		ID:                NewCommunityInviteID(),
+		CommunityID:       communityID,
+		Code:              code,
+		CreatedByGithubID: createdByGithubID,
+		SingleUse:         singleUse,
+		UsedCount:         0,
+		ExpiresAt:         expiresAt,
+		CreatedAt:         time.Now(),
+	}, nil
+}
+
+// IsActive は招待コードが使用可能かどうかを返す
+// 取り消し済み、期限切れ、使用済みの1回限りのコードは使用できない
+func (i *CommunityInvite) IsActive(now time.Time) bool {
+	if i.RevokedAt != nil {
+		return false
+	}
+	if !now.Before(i.ExpiresAt) {
+		return false
+	}
+	if i.SingleUse && i.UsedCount > 0 {
+		return false
+	}
+	return true
+}
+
+// newInviteCode はランダムな招待コードを生成する
+func newInviteCode() (string, error) {
+	buf := make([]byte, inviteCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate invite code: %w", err)
+	}
+
+	code := make([]byte, inviteCodeLength)
+	for i, b := range buf {
+		code[i] = inviteCodeAlphabet[int(b)%len(inviteCodeAlphabet)]
+	}
+	return string(code), nil
+}
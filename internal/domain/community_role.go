@@ -0,0 +1,42 @@
+package domain
+
+// CommunityRole はコミュニティ内でのメンバーの役割
+type CommunityRole string
+
+const (
+	// CommunityRoleOwner はコミュニティの作成者。削除や権限の変更ができる
+	CommunityRoleOwner CommunityRole = "owner"
+	// CommunityRoleAdmin はコミュニティの管理者。編集や集計の更新ができる
+	CommunityRoleAdmin CommunityRole = "admin"
+	// CommunityRoleMember は一般の参加者
+	CommunityRoleMember CommunityRole = "member"
+)
+
+// IsValid は定義済みの役割かを返す
+func (r CommunityRole) IsValid() bool {
+	return r.level() > 0
+}
+
+// HasPermissionOf はrequiredで指定した役割以上の権限を持っているかを返す
+func (r CommunityRole) HasPermissionOf(required CommunityRole) bool {
+	return r.level() >= required.level()
+}
+
+func (r CommunityRole) level() int {
+	switch r {
+	case CommunityRoleOwner:
+		return 3
+	case CommunityRoleAdmin:
+		return 2
+	case CommunityRoleMember:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// CommunityMember はコミュニティに参加しているカードとその役割
+type CommunityMember struct {
+	Card Card
+	Role CommunityRole
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// ContributionDetailSnapshot はある期間のコントリビューションの内訳を保存した記録
+type ContributionDetailSnapshot struct {
+	GithubID           string
+	From               time.Time
+	To                 time.Time
+	TotalContribution  int
+	ContributionDetail ContributionDetail
+	FetchedAt          time.Time
+}
+
+// ContributionHistory は保存済みの記録から求めた、ある期間のコントリビューションの統計
+type ContributionHistory struct {
+	From              time.Time
+	To                time.Time
+	Contributions     []Contribution
+	TotalContribution int
+	// Detail は期間に含まれる内訳の記録のうち、最も長い期間のもの。記録がない場合はnil
+	Detail *ContributionDetailSnapshot
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 各レイヤーが返すエラーの種類を表すセンチネルエラー
+// Repository / Service はこれらを %w でラップして返し、Handler 層で HTTP ステータスコードに変換する
+var (
+	// ErrNotFound は対象のリソースが存在しないことを表す
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists は対象のリソースが既に存在することを表す
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrInvalidArgument はリクエストの値が不正であることを表す
+	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrUnauthorized は認証情報がない、または無効であることを表す
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden は操作する権限がないことを表す
+	ErrForbidden = errors.New("forbidden")
+	// ErrUpstreamUnavailable は GitHub API など外部サービスの呼び出しに失敗したことを表す
+	ErrUpstreamUnavailable = errors.New("upstream unavailable")
+)
+
+// ErrRateLimited は GitHub API などのレート制限に達して呼び出せないことを表す
+// 制限が解除される日時は RateLimitError から取り出す
+var ErrRateLimited = errors.New("rate limited")
+
+// RateLimitError はレート制限に達したことと、制限が解除される日時を表す
+// errors.Is(err, ErrRateLimited) で判定できる
+type RateLimitError struct {
+	ResetAt time.Time
+	Err     error
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s until %s: %v", ErrRateLimited, e.ResetAt.Format(time.RFC3339), e.Err)
+}
+
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
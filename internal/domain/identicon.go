@@ -0,0 +1,25 @@
+package domain
+
+import "fmt"
+
+// IdenticonVersion はIdenticonの生成方法のバージョン
+// カードごとに保存し、同じバージョンであれば何度生成しても同じIdenticonになる
+type IdenticonVersion int
+
+const (
+	// IdenticonVersionClassic はMD5から5x5の左右対称の模様とHSLの色を作る最初の生成方法
+	IdenticonVersionClassic IdenticonVersion = 1
+	// IdenticonVersionGrid7 はSHA-256から7x7の左右対称の模様を作り、色をパレットから選ぶ生成方法
+	IdenticonVersionGrid7 IdenticonVersion = 2
+	// IdenticonVersionWinter は7x7の模様に冬のイベント用のパレットを使う生成方法
+	IdenticonVersionWinter IdenticonVersion = 3
+)
+
+// Validate はバージョンとして正しい値かを確認する
+// 生成方法が登録されているかどうかはGenerator側で確認する
+func (v IdenticonVersion) Validate() error {
+	if v <= 0 {
+		return fmt.Errorf("%w: invalid identicon version: %d", ErrInvalidArgument, v)
+	}
+	return nil
+}
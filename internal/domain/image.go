@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ImageFormat はサーバーで描画する画像の形式
+type ImageFormat string
+
+const (
+	ImageFormatSVG ImageFormat = "svg"
+	ImageFormatPNG ImageFormat = "png"
+)
+
+// ContentType は画像の形式に対応するMIMEタイプを返す
+func (f ImageFormat) ContentType() string {
+	switch f {
+	case ImageFormatSVG:
+		return "image/svg+xml"
+	case ImageFormatPNG:
+		return "image/png"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// 画像の一辺の大きさ（px）の既定値と範囲
+const (
+	DefaultImageSize = 256
+	MinImageSize     = 16
+	MaxImageSize     = 2048
+)
+
+// ImageOptions は画像を描画するときの設定
+type ImageOptions struct {
+	Size int
+	// Padding は画像の周りの余白（nilの場合は一辺の大きさの1/12）
+	Padding *int
+	// Background は背景色（空の場合は透明）
+	Background Color
+}
+
+// WithDefaults は未指定の項目を既定値で埋め、値が正しいかを確認する
+func (o ImageOptions) WithDefaults() (ImageOptions, error) {
+	if o.Size == 0 {
+		o.Size = DefaultImageSize
+	}
+	if o.Size < MinImageSize || o.Size > MaxImageSize {
+		return ImageOptions{}, fmt.Errorf("%w: size must be between %d and %d", ErrInvalidArgument, MinImageSize, MaxImageSize)
+	}
+
+	padding := o.Size / 12
+	if o.Padding != nil {
+		padding = *o.Padding
+	}
+	// ブロック1つに少なくとも1px使えるようにする
+	if padding < 0 || o.Size-2*padding < MaxBlocksSize {
+		return ImageOptions{}, fmt.Errorf("%w: padding is too large for size %d", ErrInvalidArgument, o.Size)
+	}
+	o.Padding = &padding
+
+	if o.Background != "" {
+		background, err := ParseColor(string(o.Background))
+		if err != nil {
+			return ImageOptions{}, err
+		}
+		o.Background = background
+	}
+
+	return o, nil
+}
+
+// Image はサーバーで描画した画像
+type Image struct {
+	Format ImageFormat
+	Data   []byte
+	// ETag は描画に使ったデータから求めた値で、内容が変わらない限り同じになる
+	ETag string
+}
+
+// ParseColor は "#rrggbb" / "rrggbb" / "#rgb" 形式のカラーコードを "#rrggbb" の小文字に揃える
+func ParseColor(s string) (Color, error) {
+	hex := strings.ToLower(strings.TrimPrefix(s, "#"))
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 || strings.Trim(hex, "0123456789abcdef") != "" {
+		return "", fmt.Errorf("%w: invalid color: %s", ErrInvalidArgument, s)
+	}
+	return Color("#" + hex), nil
+}
+
+// CardImageContent はカード全体の画像に描く内容
+type CardImageContent struct {
+	Card Card
+	// TotalContribution は保存済みの記録から求めた過去1年間のコントリビュート数（nilの場合は描かない）
+	TotalContribution *int
+}
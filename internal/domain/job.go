@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobStatus はバックグラウンドのジョブの実行状態
+type JobStatus string
+
+const (
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+)
+
+// JobRun はバックグラウンドのジョブの1回の実行の記録
+type JobRun struct {
+	ID      uuid.UUID
+	JobName string
+	// InstanceID はジョブを実行したインスタンス
+	InstanceID string
+	Status     JobStatus
+	StartedAt  time.Time
+	// FinishedAt は終了した日時（実行中の場合はnil）
+	FinishedAt *time.Time
+	// ProcessedCount は更新したカードやコミュニティの数
+	ProcessedCount int
+	// Error は失敗した場合のエラーの内容
+	Error string
+}
+
+func NewJobRun(jobName string, instanceID string, startedAt time.Time) *JobRun {
+	return &JobRun{
+		ID:         uuid.New(),
+		JobName:    jobName,
+		InstanceID: instanceID,
+		Status:     JobStatusRunning,
+		StartedAt:  startedAt,
+	}
+}
+
+// Finish はジョブの実行を終了し、結果を記録する
+func (r *JobRun) Finish(finishedAt time.Time, processedCount int, err error) {
+	r.FinishedAt = &finishedAt
+	r.ProcessedCount = processedCount
+	r.Status = JobStatusSucceeded
+	if err != nil {
+		r.Status = JobStatusFailed
+		r.Error = err.Error()
+	}
+}
@@ -11,3 +11,16 @@ func NewLanguage(languageName string, color string) *Language {
 		Color:        color,
 	}
 }
+
+// MaxTopLanguages はカードや統計情報に残す言語の数の上限
+const MaxTopLanguages = 10
+
+// LanguageBreakdown は言語ごとのコードの量（バイト数）と割合
+type LanguageBreakdown struct {
+	LanguageName string
+	Bytes        int
+	// Percentage は全ての言語の合計に対する割合（0〜100）
+	// 上位 MaxTopLanguages 件だけを残すので、合計が100にならない場合がある
+	Percentage float64
+	Color      string
+}
@@ -0,0 +1,97 @@
+package domain
+
+import "fmt"
+
+// 1ページに含める件数の既定値と上限
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 200
+)
+
+// SortKey は一覧の並び替えに使う項目
+type SortKey string
+
+const (
+	// SortKeyCollectedAt はデッキにカードを追加した日時で並べる
+	SortKeyCollectedAt SortKey = "collected_at"
+	// SortKeyUserName はGitHubのユーザー名で並べる
+	SortKeyUserName SortKey = "user_name"
+	// SortKeyLanguage は最も使われている言語名で並べる
+	SortKeyLanguage SortKey = "language"
+	// SortKeyContribution はコミュニティ内のコントリビュート数で並べる
+	SortKeyContribution SortKey = "contribution"
+	// SortKeyCreatedAt は作成日時で並べる
+	SortKeyCreatedAt SortKey = "created_at"
+)
+
+// SortOrder は並び順
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// PageRequest は一覧をページ単位で取得するための条件
+// Cursor は前のページの NextCursor をそのまま渡す（空の場合は最初のページ）
+type PageRequest struct {
+	Limit   int
+	Cursor  string
+	SortKey SortKey
+	Order   SortOrder
+}
+
+// WithDefaults は未指定の項目を既定値で埋め、値が正しいかを確認する
+// allowedKeys の先頭が既定の並び替え項目になる
+func (p PageRequest) WithDefaults(defaultOrder SortOrder, allowedKeys ...SortKey) (PageRequest, error) {
+	if p.Limit == 0 {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Limit < 0 || p.Limit > MaxPageLimit {
+		return PageRequest{}, fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidArgument, MaxPageLimit)
+	}
+
+	if p.SortKey == "" && len(allowedKeys) > 0 {
+		p.SortKey = allowedKeys[0]
+	}
+	allowed := false
+	for _, key := range allowedKeys {
+		if p.SortKey == key {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return PageRequest{}, fmt.Errorf("%w: unsupported sort key: %s", ErrInvalidArgument, p.SortKey)
+	}
+
+	if p.Order == "" {
+		p.Order = defaultOrder
+	}
+	if p.Order != SortOrderAsc && p.Order != SortOrderDesc {
+		return PageRequest{}, fmt.Errorf("%w: unsupported sort order: %s", ErrInvalidArgument, p.Order)
+	}
+
+	return p, nil
+}
+
+// CardPage はページ単位で取得したカード一覧
+// NextCursor が空の場合は次のページがない
+type CardPage struct {
+	Cards      []Card
+	NextCursor string
+}
+
+// CommunityPage はページ単位で取得したコミュニティ一覧
+// NextCursor が空の場合は次のページがない
+type CommunityPage struct {
+	Communities []Community
+	NextCursor  string
+}
+
+// CollectedCardPage はページ単位で取得したデッキのカード一覧
+// NextCursor が空の場合は次のページがない
+type CollectedCardPage struct {
+	CollectedCards []CollectedCard
+	NextCursor     string
+}
@@ -1,17 +1,66 @@
 package domain
 
+import "time"
+
 type Stats struct {
-	Contributions      []Contribution
-	TotalContribution  int
-	MostUsedLanguage   Language
+	Contributions     []Contribution
+	TotalContribution int
+	MostUsedLanguage  Language
+	// TopLanguages はコードの量が多い順の言語の内訳
+	TopLanguages       []LanguageBreakdown
 	ContributionDetail ContributionDetail
+	// ActivityTimes は時刻が分かるコントリビューションの日時。時間帯の集計に使う
+	ActivityTimes []time.Time
+	// From と To は実際に集計した期間
+	From time.Time
+	To   time.Time
+	// Summary は統計情報から求めた連続記録や集計
+	Summary StatsSummary
+}
+
+// StatsQuery は統計情報を集計する条件
+type StatsQuery struct {
+	// From と To は集計する期間。nilの場合はService層で既定の期間を決める
+	From *time.Time
+	To   *time.Time
+	// Timezone は連続記録や時間帯を求めるタイムゾーン（IANAのタイムゾーン名）。空の場合はUTC
+	Timezone string
+}
+
+// HourBucketSize は時間帯の集計の区切りの長さ（時間）
+const HourBucketSize = 3
+
+// StatsSummary は統計情報から求めた連続記録や集計
+type StatsSummary struct {
+	// CurrentStreak は期間の最終日（その日がまだ0件の場合は前日）まで続いている、コントリビューションがある日の連続日数
+	CurrentStreak int
+	LongestStreak int
+	// MostActiveWeekday はコントリビューションが最も多い曜日。コントリビューションがない場合はnil
+	MostActiveWeekday *time.Weekday
+	// MostActiveHourBucket はコントリビューションが最も多い時間帯の開始時刻（HourBucketSize 時間ごと）。分からない場合はnil
+	MostActiveHourBucket *int
+	// Weekly は日曜日始まりの週ごとの合計、Monthly は月ごとの合計
+	Weekly  []ContributionAggregate
+	Monthly []ContributionAggregate
+	// Percentile は同じコミュニティの仲間のうち、期間の合計が自分より少ない人の割合（0〜100）。仲間がいない場合はnil
+	Percentile *float64
+	PeerCount  int
+	Timezone   string
+}
+
+// ContributionAggregate は週や月ごとのコントリビューション数の合計
+type ContributionAggregate struct {
+	Start time.Time
+	Count int
 }
 
-func NewStats(contributions []Contribution, totalContribution int, mostUsedLanguage Language, contributionDetail ContributionDetail) *Stats {
+func NewStats(contributions []Contribution, totalContribution int, mostUsedLanguage Language, contributionDetail ContributionDetail, from, to time.Time) *Stats {
 	return &Stats{
 		Contributions:      contributions,
 		TotalContribution:  totalContribution,
 		MostUsedLanguage:   mostUsedLanguage,
 		ContributionDetail: contributionDetail,
+		From:               from,
+		To:                 to,
 	}
 }
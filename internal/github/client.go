@@ -1,17 +1,75 @@
 package github
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+
 	"github.com/google/go-github/v80/github"
 )
 
 // Client はGitHub APIクライアントの実装
 type Client struct {
-	client *github.Client
+	client    *github.Client
+	limiter   *rateLimiter
+	languages LanguageConfig
+}
+
+// TokenSource はリクエストごとに使うトークンを返す
+// GitHub Appのインストールトークンのように期限があり、更新が必要なトークンに使う
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
 }
 
 // トークンで認証されたGitHub API Clientを生成する
+// レート制限の残りに合わせて同時実行数を絞り、5xxやセカンダリレート制限はやり直す
+// 同じトークンでClientを何度も作る場合は、残りを引き継げる RateLimiters.NewClient を使う
 func NewClient(token string) *Client {
+	return newClient(http.DefaultTransport, token, newRateLimiter())
+}
+
+// NewClientWithTokenSource はリクエストごとに source からトークンを取得するGitHub API Clientを生成する
+func NewClientWithTokenSource(source TokenSource) *Client {
+	return newClient(&tokenSourceTransport{base: http.DefaultTransport, source: source}, "", newRateLimiter())
+}
+
+func newClient(base http.RoundTripper, token string, limiter *rateLimiter) *Client {
+	transport := &rateLimitTransport{
+		base:    base,
+		limiter: limiter,
+		sleep:   sleepContext,
+	}
+
+	client := github.NewClient(&http.Client{Transport: transport})
+	if token != "" {
+		client = client.WithAuthToken(token)
+	}
 	return &Client{
-		client: github.NewClient(nil).WithAuthToken(token),
+		client:    client,
+		limiter:   limiter,
+		languages: DefaultLanguageConfig(),
 	}
 }
+
+// WithLanguageConfig は言語の集計の設定を変更する
+func (c *Client) WithLanguageConfig(cfg LanguageConfig) *Client {
+	c.languages = cfg
+	return c
+}
+
+// tokenSourceTransport はリクエストごとに TokenSource から取得したトークンを Authorization ヘッダーに付ける
+type tokenSourceTransport struct {
+	base   http.RoundTripper
+	source TokenSource
+}
+
+func (t *tokenSourceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.source.Token(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	return t.base.RoundTrip(req)
+}
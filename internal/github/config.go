@@ -0,0 +1,87 @@
+package github
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/furarico/octo-deck-api/internal/config"
+)
+
+// RepositoryAffiliation は言語を集計するリポジトリとユーザーの関係（GraphQLの RepositoryAffiliation）
+type RepositoryAffiliation string
+
+const (
+	// AffiliationOwner はユーザーが所有するリポジトリ
+	AffiliationOwner RepositoryAffiliation = "OWNER"
+	// AffiliationCollaborator はユーザーがコラボレーターとして追加されたリポジトリ
+	AffiliationCollaborator RepositoryAffiliation = "COLLABORATOR"
+	// AffiliationOrganizationMember はユーザーが所属するOrganizationのリポジトリ
+	AffiliationOrganizationMember RepositoryAffiliation = "ORGANIZATION_MEMBER"
+)
+
+// repositoriesPerPage は言語の集計で1回のクエリで取得するリポジトリの数（GitHubの上限）
+const repositoriesPerPage = 100
+
+// LanguageConfig は言語の集計の設定
+type LanguageConfig struct {
+	// Affiliations は集計するリポジトリとユーザーの関係
+	Affiliations []RepositoryAffiliation
+	// MaxRepositories は集計するリポジトリの最大数。多すぎるユーザーでクエリが増えすぎないようにする
+	MaxRepositories int
+}
+
+// DefaultLanguageConfig は環境変数で設定しない場合の言語の集計の設定
+func DefaultLanguageConfig() LanguageConfig {
+	return LanguageConfig{
+		Affiliations:    []RepositoryAffiliation{AffiliationOwner},
+		MaxRepositories: 1000,
+	}
+}
+
+// LoadLanguageConfig は環境変数から言語の集計の設定を読み込む
+// GITHUB_LANGUAGE_AFFILIATIONS は "OWNER,ORGANIZATION_MEMBER" のようにカンマ区切りで指定する
+func LoadLanguageConfig() (LanguageConfig, error) {
+	cfg := DefaultLanguageConfig()
+
+	if v := os.Getenv("GITHUB_LANGUAGE_AFFILIATIONS"); v != "" {
+		cfg.Affiliations = nil
+		for _, affiliation := range strings.Split(v, ",") {
+			cfg.Affiliations = append(cfg.Affiliations, RepositoryAffiliation(strings.ToUpper(strings.TrimSpace(affiliation))))
+		}
+	}
+	var err error
+	if cfg.MaxRepositories, err = config.Int("GITHUB_LANGUAGE_MAX_REPOSITORIES", cfg.MaxRepositories); err != nil {
+		return LanguageConfig{}, err
+	}
+
+	if err := cfg.validate(); err != nil {
+		return LanguageConfig{}, err
+	}
+
+	return cfg, nil
+}
+
+func (c LanguageConfig) validate() error {
+	if len(c.Affiliations) == 0 {
+		return fmt.Errorf("GITHUB_LANGUAGE_AFFILIATIONS must not be empty")
+	}
+	for _, affiliation := range c.Affiliations {
+		switch affiliation {
+		case AffiliationOwner, AffiliationCollaborator, AffiliationOrganizationMember:
+		default:
+			return fmt.Errorf("GITHUB_LANGUAGE_AFFILIATIONS must be %q, %q or %q: %q", AffiliationOwner, AffiliationCollaborator, AffiliationOrganizationMember, affiliation)
+		}
+	}
+
+	if c.MaxRepositories <= 0 {
+		return fmt.Errorf("GITHUB_LANGUAGE_MAX_REPOSITORIES must be positive")
+	}
+
+	return nil
+}
+
+// maxRepositoryPages は MaxRepositories 件のリポジトリを取得するのに必要なページ数
+func (c LanguageConfig) maxRepositoryPages() int {
+	return (c.MaxRepositories + repositoriesPerPage - 1) / repositoriesPerPage
+}
@@ -9,8 +9,8 @@ import (
 
 // GetUsersFullInfoByNodeIDs はNodeIDを使ってユーザーの全情報を一括取得する
 // - ユーザー基本情報（login, name, avatarUrl）
-// - 貢献データ（commits, issues, PRs, reviews）
-// - 言語情報（最も使用している言語）
+// - 貢献データ（日ごとの数, commits, issues, PRs, reviews）
+// - 言語情報（最も使用している言語と言語の内訳）
 // 3回のAPI呼び出しを1回に統合することで、レイテンシを大幅に削減する
 func (c *Client) GetUsersFullInfoByNodeIDs(ctx context.Context, nodeIDs []string, from, to time.Time) ([]UserFullInfo, error) {
 	if len(nodeIDs) == 0 {
@@ -84,7 +84,7 @@ func (c *Client) GetUsersFullInfoByNodeIDs(ctx context.Context, nodeIDs []string
 // getUsersFullInfoByNodeIDsBatch はNodeIDを使ってユーザーの全情報を一括取得する（内部用）
 func (c *Client) getUsersFullInfoByNodeIDsBatch(ctx context.Context, nodeIDs []string, from, to time.Time) ([]UserFullInfo, error) {
 	query := `
-		query ($ids: [ID!]!, $from: DateTime!, $to: DateTime!) {
+		query ($ids: [ID!]!, $from: DateTime!, $to: DateTime!, $affiliations: [RepositoryAffiliation]) {
 			nodes(ids: $ids) {
 				... on User {
 					login
@@ -93,23 +93,19 @@ func (c *Client) getUsersFullInfoByNodeIDsBatch(ctx context.Context, nodeIDs []s
 					contributionsCollection(from: $from, to: $to) {
 						total: contributionCalendar {
 							totalContributions
+							weeks {
+								contributionDays {
+									date
+									contributionCount
+								}
+							}
 						}
 						commits: totalCommitContributions
 						issues: totalIssueContributions
 						prs: totalPullRequestContributions
 						reviews: totalPullRequestReviewContributions
 					}
-					repositories(first: 100, ownerAffiliations: OWNER, isFork: false, privacy: PUBLIC) {
-						nodes {
-							languages(first: 20) {
-								edges {
-									size
-									node {
-										name
-									}
-								}
-							}
-						}
+					repositories(first: 100, ownerAffiliations: $affiliations, isFork: false, privacy: PUBLIC) {` + repositoryLanguagesFields + `
 					}
 				}
 			}
@@ -117,9 +113,10 @@ func (c *Client) getUsersFullInfoByNodeIDsBatch(ctx context.Context, nodeIDs []s
 	`
 
 	variables := map[string]interface{}{
-		"ids":  nodeIDs,
-		"from": from.Format(time.RFC3339),
-		"to":   to.Format(time.RFC3339),
+		"ids":          nodeIDs,
+		"from":         from.Format(time.RFC3339),
+		"to":           to.Format(time.RFC3339),
+		"affiliations": c.affiliationsVariable(),
 	}
 
 	var result struct {
@@ -130,24 +127,19 @@ func (c *Client) getUsersFullInfoByNodeIDsBatch(ctx context.Context, nodeIDs []s
 			ContributionsCollection struct {
 				Total struct {
 					TotalContributions int `json:"totalContributions"`
+					Weeks              []struct {
+						ContributionDays []struct {
+							Date              string `json:"date"`
+							ContributionCount int    `json:"contributionCount"`
+						} `json:"contributionDays"`
+					} `json:"weeks"`
 				} `json:"total"`
 				Commits int `json:"commits"`
 				Issues  int `json:"issues"`
 				PRs     int `json:"prs"`
 				Reviews int `json:"reviews"`
 			} `json:"contributionsCollection"`
-			Repositories struct {
-				Nodes []struct {
-					Languages struct {
-						Edges []struct {
-							Size int `json:"size"`
-							Node struct {
-								Name string `json:"name"`
-							} `json:"node"`
-						} `json:"edges"`
-					} `json:"languages"`
-				} `json:"nodes"`
-			} `json:"repositories"`
+			Repositories repositoryLanguages `json:"repositories"`
 		} `json:"nodes"`
 	}
 
@@ -162,21 +154,21 @@ func (c *Client) getUsersFullInfoByNodeIDsBatch(ctx context.Context, nodeIDs []s
 			continue
 		}
 
-		// 最も使用している言語を集計
-		languageStats := make(map[string]int)
-		for _, repo := range node.Repositories.Nodes {
-			for _, edge := range repo.Languages.Edges {
-				languageStats[edge.Node.Name] += edge.Size
-			}
+		// 言語の内訳を集計（リポジトリが多いユーザーは続きのページも取得する）
+		repositories, err := c.getRepositoryLanguages(ctx, languageOwner{login: node.Login}, &node.Repositories)
+		if err != nil {
+			return nil, err
 		}
-
-		// 最大の言語を見つける
-		mostUsedLanguage := "Unknown"
-		maxSize := 0
-		for lang, size := range languageStats {
-			if size > maxSize {
-				maxSize = size
-				mostUsedLanguage = lang
+		language := newLanguageInfo(repositories.breakdown())
+
+		// 日ごとのコントリビューションデータを平坦化
+		var contributions []Contribution
+		for _, week := range node.ContributionsCollection.Total.Weeks {
+			for _, day := range week.ContributionDays {
+				contributions = append(contributions, Contribution{
+					Date:  day.Date,
+					Count: day.ContributionCount,
+				})
 			}
 		}
 
@@ -191,12 +183,14 @@ func (c *Client) getUsersFullInfoByNodeIDsBatch(ctx context.Context, nodeIDs []s
 			Name:                  name,
 			AvatarURL:             node.AvatarUrl,
 			Total:                 node.ContributionsCollection.Total.TotalContributions,
+			Contributions:         contributions,
 			Commits:               node.ContributionsCollection.Commits,
 			Issues:                node.ContributionsCollection.Issues,
 			PRs:                   node.ContributionsCollection.PRs,
 			Reviews:               node.ContributionsCollection.Reviews,
-			MostUsedLanguage:      mostUsedLanguage,
-			MostUsedLanguageColor: GetLanguageColor(mostUsedLanguage),
+			MostUsedLanguage:      language.Name,
+			MostUsedLanguageColor: language.Color,
+			Languages:             language.Breakdown,
 		})
 	}
 
@@ -8,13 +8,9 @@ import (
 
 // UserStatsをDomainのStatsに変換する
 func (us *UserStats) ToDomainStats() (*domain.Stats, error) {
-	contributions := make([]domain.Contribution, len(us.Contributions))
-	for i, c := range us.Contributions {
-		date, err := time.Parse("2006-01-02", c.Date)
-		if err != nil {
-			return nil, err
-		}
-		contributions[i] = *domain.NewContribution(date, c.Count)
+	contributions, err := ToDomainContributions(us.Contributions)
+	if err != nil {
+		return nil, err
 	}
 
 	language := domain.NewLanguage(us.MostUsedLanguage, us.MostUsedLanguageColor)
@@ -26,10 +22,42 @@ func (us *UserStats) ToDomainStats() (*domain.Stats, error) {
 		us.ContributionDetail.IssueCount,
 	)
 
-	return domain.NewStats(
+	stats := domain.NewStats(
 		contributions,
 		us.TotalContribution,
 		*language,
 		*contributionDetail,
-	), nil
+		us.From,
+		us.To,
+	)
+	stats.TopLanguages = ToDomainLanguageBreakdown(us.Languages)
+	stats.ActivityTimes = us.ActivityTimes
+	return stats, nil
+}
+
+// 言語の内訳をDomainのLanguageBreakdownに変換する
+func ToDomainLanguageBreakdown(breakdown []LanguageBreakdown) []domain.LanguageBreakdown {
+	result := make([]domain.LanguageBreakdown, len(breakdown))
+	for i, b := range breakdown {
+		result[i] = domain.LanguageBreakdown{
+			LanguageName: b.Name,
+			Bytes:        b.Bytes,
+			Percentage:   b.Percentage,
+			Color:        b.Color,
+		}
+	}
+	return result
+}
+
+// 日ごとのコントリビューションをDomainのContributionに変換する
+func ToDomainContributions(contributions []Contribution) ([]domain.Contribution, error) {
+	result := make([]domain.Contribution, len(contributions))
+	for i, c := range contributions {
+		date, err := time.Parse("2006-01-02", c.Date)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = *domain.NewContribution(date, c.Count)
+	}
+	return result, nil
 }
@@ -0,0 +1,67 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/google/go-github/v80/github"
+)
+
+// wrapError はGitHub APIのエラーをドメインエラーでラップする
+// 404は対象ユーザーが存在しない、401はトークンが無効、レート制限は解除される日時付きの domain.RateLimitError、
+// それ以外はGitHub側の障害として扱う
+func wrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var rateErr *github.RateLimitError
+	if errors.As(err, &rateErr) {
+		return &domain.RateLimitError{ResetAt: rateErr.Rate.Reset.Time, Err: err}
+	}
+
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		wait := secondaryRateLimitWait
+		if abuseErr.RetryAfter != nil {
+			wait = *abuseErr.RetryAfter
+		}
+		return &domain.RateLimitError{ResetAt: time.Now().Add(wait), Err: err}
+	}
+
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		switch errResp.Response.StatusCode {
+		case http.StatusNotFound:
+			return fmt.Errorf("%w: %w", domain.ErrNotFound, err)
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%w: %w", domain.ErrUnauthorized, err)
+		case http.StatusForbidden, http.StatusTooManyRequests:
+			if resetAt, ok := rateLimitResetAt(errResp.Response); ok {
+				return &domain.RateLimitError{ResetAt: resetAt, Err: err}
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: %w", domain.ErrUpstreamUnavailable, err)
+}
+
+// rateLimitResetAt はレート制限のレスポンスから制限が解除される日時を求める
+// レート制限のレスポンスでない場合はfalseを返す
+func rateLimitResetAt(resp *http.Response) (time.Time, bool) {
+	if wait, ok := retryAfter(resp); ok {
+		return time.Now().Add(wait), true
+	}
+	if resp.Header.Get("X-RateLimit-Remaining") != "0" {
+		return time.Time{}, false
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return time.Now().Add(secondaryRateLimitWait), true
+	}
+	return time.Unix(reset, 0), true
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 // GraphQLクエリを実行する共通メソッド
@@ -26,6 +29,7 @@ func (c *Client) executeGraphQL(ctx context.Context, query string, variables map
 	var graphQLResp struct {
 		Data   json.RawMessage `json:"data"`
 		Errors []struct {
+			Type    string `json:"type"`
 			Message string `json:"message"`
 		} `json:"errors"`
 	}
@@ -33,12 +37,23 @@ func (c *Client) executeGraphQL(ctx context.Context, query string, variables map
 	// リクエスト実行
 	_, err = c.client.Do(ctx, httpReq, &graphQLResp)
 	if err != nil {
-		return fmt.Errorf("failed to execute GraphQL query: %w", err)
+		return fmt.Errorf("failed to execute GraphQL query: %w", wrapError(err))
 	}
 
 	// GraphQLエラーチェック
 	if len(graphQLResp.Errors) > 0 {
-		return fmt.Errorf("GraphQL error: %s", graphQLResp.Errors[0].Message)
+		switch graphQLResp.Errors[0].Type {
+		case "NOT_FOUND":
+			return fmt.Errorf("%w: GraphQL error: %s", domain.ErrNotFound, graphQLResp.Errors[0].Message)
+		case "RATE_LIMITED":
+			// GraphQLのレート制限は200で返るので、記録しておいた残りから解除される日時を求める
+			resetAt := time.Now().Add(secondaryRateLimitWait)
+			if budget, ok := c.limiter.budget(resourceGraphQL); ok {
+				resetAt = budget.reset
+			}
+			return &domain.RateLimitError{ResetAt: resetAt, Err: fmt.Errorf("GraphQL error: %s", graphQLResp.Errors[0].Message)}
+		}
+		return fmt.Errorf("%w: GraphQL error: %s", domain.ErrUpstreamUnavailable, graphQLResp.Errors[0].Message)
 	}
 
 	// 結果をUnmarshal
@@ -0,0 +1,177 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// repositoryLanguagesFields はリポジトリごとの言語を取得するフィールド。repositories の中に書く
+const repositoryLanguagesFields = `
+	pageInfo {
+		hasNextPage
+		endCursor
+	}
+	nodes {
+		languages(first: 20) {
+			edges {
+				size
+				node {
+					name
+				}
+			}
+		}
+	}`
+
+// repositoryLanguages はリポジトリごとの言語のバイト数（GraphQLの応答）
+type repositoryLanguages struct {
+	PageInfo struct {
+		HasNextPage bool   `json:"hasNextPage"`
+		EndCursor   string `json:"endCursor"`
+	} `json:"pageInfo"`
+	Nodes []struct {
+		Languages struct {
+			Edges []struct {
+				Size int `json:"size"`
+				Node struct {
+					Name string `json:"name"`
+				} `json:"node"`
+			} `json:"edges"`
+		} `json:"languages"`
+	} `json:"nodes"`
+}
+
+// breakdown はリポジトリの言語のバイト数を合算し、多い順に上位 domain.MaxTopLanguages 件の内訳を返す
+// 割合は全ての言語の合計に対して求め、小数第1位で丸める
+func (r repositoryLanguages) breakdown() []LanguageBreakdown {
+	sizes := make(map[string]int)
+	total := 0
+	for _, repo := range r.Nodes {
+		for _, edge := range repo.Languages.Edges {
+			sizes[edge.Node.Name] += edge.Size
+			total += edge.Size
+		}
+	}
+
+	breakdown := make([]LanguageBreakdown, 0, len(sizes))
+	if total == 0 {
+		return breakdown
+	}
+	for name, size := range sizes {
+		breakdown = append(breakdown, LanguageBreakdown{
+			Name:       name,
+			Bytes:      size,
+			Percentage: math.Round(float64(size)*1000/float64(total)) / 10,
+			Color:      GetLanguageColor(name),
+		})
+	}
+
+	// バイト数が同じ場合は言語名の順にして、結果を安定させる
+	sort.Slice(breakdown, func(i, j int) bool {
+		if breakdown[i].Bytes != breakdown[j].Bytes {
+			return breakdown[i].Bytes > breakdown[j].Bytes
+		}
+		return breakdown[i].Name < breakdown[j].Name
+	})
+	if len(breakdown) > domain.MaxTopLanguages {
+		breakdown = breakdown[:domain.MaxTopLanguages]
+	}
+	return breakdown
+}
+
+// newLanguageInfo は内訳の先頭を最も使用している言語とした LanguageInfo を作る
+// 言語が見つからない場合は Unknown にする
+func newLanguageInfo(breakdown []LanguageBreakdown) LanguageInfo {
+	if len(breakdown) == 0 {
+		return LanguageInfo{Name: "Unknown", Color: defaultLanguageColor, Breakdown: breakdown}
+	}
+	return LanguageInfo{Name: breakdown[0].Name, Color: breakdown[0].Color, Breakdown: breakdown}
+}
+
+// languageOwner は言語を集計するユーザー
+// viewer の場合はトークンのユーザー自身のリポジトリを、トークンで見えるプライベートリポジトリも含めて集計する
+type languageOwner struct {
+	login  string
+	viewer bool
+}
+
+// affiliationsVariable は設定したリポジトリとの関係をGraphQLの変数の形にする
+func (c *Client) affiliationsVariable() []string {
+	affiliations := make([]string, len(c.languages.Affiliations))
+	for i, affiliation := range c.languages.Affiliations {
+		affiliations[i] = string(affiliation)
+	}
+	return affiliations
+}
+
+// getRepositoryLanguages はリポジトリを順にたどり、設定した最大数までのリポジトリの言語を取得する
+// first に取得済みの最初のページを渡すと、その続きから取得する
+func (c *Client) getRepositoryLanguages(ctx context.Context, owner languageOwner, first *repositoryLanguages) (repositoryLanguages, error) {
+	var all repositoryLanguages
+	all.PageInfo.HasNextPage = true
+	pages := 0
+	if first != nil {
+		all = *first
+		pages = 1
+	}
+
+	for ; all.PageInfo.HasNextPage && pages < c.languages.maxRepositoryPages(); pages++ {
+		page, err := c.getRepositoryLanguagesPage(ctx, owner, all.PageInfo.EndCursor)
+		if err != nil {
+			return repositoryLanguages{}, err
+		}
+		all.Nodes = append(all.Nodes, page.Nodes...)
+		all.PageInfo = page.PageInfo
+	}
+
+	return all, nil
+}
+
+// getRepositoryLanguagesPage は after の次のページのリポジトリの言語を取得する
+func (c *Client) getRepositoryLanguagesPage(ctx context.Context, owner languageOwner, after string) (repositoryLanguages, error) {
+	// viewer もuserという名前で受け取り、同じ形で扱う
+	query := `
+		query($login: String!, $after: String, $affiliations: [RepositoryAffiliation], $privacy: RepositoryPrivacy) {
+			user(login: $login) {
+				repositories(first: 100, after: $after, ownerAffiliations: $affiliations, isFork: false, privacy: $privacy) {` + repositoryLanguagesFields + `
+				}
+			}
+		}
+	`
+	variables := map[string]interface{}{
+		"login":        owner.login,
+		"after":        nil,
+		"affiliations": c.affiliationsVariable(),
+		"privacy":      "PUBLIC",
+	}
+	if owner.viewer {
+		query = `
+			query($after: String, $affiliations: [RepositoryAffiliation], $privacy: RepositoryPrivacy) {
+				user: viewer {
+					repositories(first: 100, after: $after, ownerAffiliations: $affiliations, isFork: false, privacy: $privacy) {` + repositoryLanguagesFields + `
+					}
+				}
+			}
+		`
+		delete(variables, "login")
+		// プライバシーで絞り込まず、プライベートリポジトリも含める
+		variables["privacy"] = nil
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var result struct {
+		User struct {
+			Repositories repositoryLanguages `json:"repositories"`
+		} `json:"user"`
+	}
+	if err := c.executeGraphQL(ctx, query, variables, &result); err != nil {
+		return repositoryLanguages{}, fmt.Errorf("failed to get repository languages: %w", err)
+	}
+
+	return result.User.Repositories, nil
+}
@@ -0,0 +1,217 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// repositoryLanguages をGraphQLの応答から作る
+func newRepositoryLanguages(t *testing.T, repos ...map[string]int) repositoryLanguages {
+	t.Helper()
+
+	type edge struct {
+		Size int `json:"size"`
+		Node struct {
+			Name string `json:"name"`
+		} `json:"node"`
+	}
+	var nodes []map[string]any
+	for _, repo := range repos {
+		var edges []edge
+		for name, size := range repo {
+			e := edge{Size: size}
+			e.Node.Name = name
+			edges = append(edges, e)
+		}
+		nodes = append(nodes, map[string]any{"languages": map[string]any{"edges": edges}})
+	}
+
+	data, err := json.Marshal(map[string]any{"nodes": nodes})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var r repositoryLanguages
+	if err := json.Unmarshal(data, &r); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	return r
+}
+
+// 言語のバイト数をリポジトリをまたいで合算し、多い順に並べる
+func TestRepositoryLanguages_Breakdown(t *testing.T) {
+	r := newRepositoryLanguages(t,
+		map[string]int{"Go": 600, "Shell": 100},
+		map[string]int{"Go": 150, "TypeScript": 150},
+	)
+
+	breakdown := r.breakdown()
+
+	want := []LanguageBreakdown{
+		{Name: "Go", Bytes: 750, Percentage: 75, Color: GetLanguageColor("Go")},
+		// バイト数が同じ場合は言語名の順
+		{Name: "TypeScript", Bytes: 150, Percentage: 15, Color: GetLanguageColor("TypeScript")},
+		{Name: "Shell", Bytes: 100, Percentage: 10, Color: GetLanguageColor("Shell")},
+	}
+	if len(breakdown) != len(want) {
+		t.Fatalf("breakdown = %+v, want %+v", breakdown, want)
+	}
+	for i := range want {
+		if breakdown[i] != want[i] {
+			t.Errorf("breakdown[%d] = %+v, want %+v", i, breakdown[i], want[i])
+		}
+	}
+
+	info := newLanguageInfo(breakdown)
+	if info.Name != "Go" || info.Color != GetLanguageColor("Go") {
+		t.Errorf("newLanguageInfo() = %+v, want Go", info)
+	}
+}
+
+// 上位 domain.MaxTopLanguages 件だけを残し、割合は全ての言語の合計に対して求める
+func TestRepositoryLanguages_BreakdownLimit(t *testing.T) {
+	repo := make(map[string]int)
+	for i := 0; i < domain.MaxTopLanguages+2; i++ {
+		repo[fmt.Sprintf("Lang%02d", i)] = 100
+	}
+
+	breakdown := newRepositoryLanguages(t, repo).breakdown()
+
+	if len(breakdown) != domain.MaxTopLanguages {
+		t.Fatalf("len(breakdown) = %d, want %d", len(breakdown), domain.MaxTopLanguages)
+	}
+	if breakdown[0].Name != "Lang00" || breakdown[0].Percentage != 8.3 {
+		t.Errorf("breakdown[0] = %+v, want Lang00 8.3%%", breakdown[0])
+	}
+}
+
+// 言語がない場合は Unknown にする
+func TestNewLanguageInfo_Empty(t *testing.T) {
+	info := newLanguageInfo(newRepositoryLanguages(t).breakdown())
+	if info.Name != "Unknown" || info.Color != defaultLanguageColor || len(info.Breakdown) != 0 {
+		t.Errorf("newLanguageInfo() = %+v, want Unknown", info)
+	}
+}
+
+// repositoryLanguagesRequest はリポジトリの言語を取得するクエリの変数
+type repositoryLanguagesRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		Login        string   `json:"login"`
+		After        *string  `json:"after"`
+		Affiliations []string `json:"affiliations"`
+		Privacy      *string  `json:"privacy"`
+	} `json:"variables"`
+}
+
+// newPagedLanguagesClient はページごとに1つのGoのリポジトリを返すテスト用のクライアントを作る
+func newPagedLanguagesClient(t *testing.T, cfg LanguageConfig) (*Client, *[]repositoryLanguagesRequest) {
+	t.Helper()
+
+	var requests []repositoryLanguagesRequest
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req repositoryLanguagesRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		requests = append(requests, req)
+
+		page := len(requests)
+		fmt.Fprintf(w, `{"data": {"user": {"repositories": {
+			"pageInfo": {"hasNextPage": true, "endCursor": "cursor%d"},
+			"nodes": [{"languages": {"edges": [{"size": 100, "node": {"name": "Go"}}]}}]
+		}}}}`, page)
+	})
+	client.WithLanguageConfig(cfg)
+	return client, &requests
+}
+
+// リポジトリを順にたどり、設定した最大数で止める
+func TestGetMostUsedLanguage_Pagination(t *testing.T) {
+	client, requests := newPagedLanguagesClient(t, LanguageConfig{
+		Affiliations:    []RepositoryAffiliation{AffiliationOwner, AffiliationOrganizationMember},
+		MaxRepositories: 250,
+	})
+
+	info, err := client.GetMostUsedLanguage(context.Background(), "octocat")
+	if err != nil {
+		t.Fatalf("GetMostUsedLanguage() error = %v", err)
+	}
+
+	if len(*requests) != 3 {
+		t.Fatalf("requests = %d, want 3", len(*requests))
+	}
+	for i, req := range *requests {
+		if req.Variables.Login != "octocat" {
+			t.Errorf("requests[%d].login = %q, want octocat", i, req.Variables.Login)
+		}
+		if strings.Join(req.Variables.Affiliations, ",") != "OWNER,ORGANIZATION_MEMBER" {
+			t.Errorf("requests[%d].affiliations = %v", i, req.Variables.Affiliations)
+		}
+		if req.Variables.Privacy == nil || *req.Variables.Privacy != "PUBLIC" {
+			t.Errorf("requests[%d].privacy = %v, want PUBLIC", i, req.Variables.Privacy)
+		}
+	}
+	if (*requests)[0].Variables.After != nil || (*requests)[2].Variables.After == nil || *(*requests)[2].Variables.After != "cursor2" {
+		t.Errorf("after = %v, %v", (*requests)[0].Variables.After, (*requests)[2].Variables.After)
+	}
+
+	if info.Name != "Go" || len(info.Breakdown) != 1 || info.Breakdown[0].Bytes != 300 {
+		t.Errorf("info = %+v, want Go 300 bytes", info)
+	}
+}
+
+// 自分の言語はviewerから、プライバシーで絞り込まずに取得する
+func TestGetMyLanguages(t *testing.T) {
+	client, requests := newPagedLanguagesClient(t, LanguageConfig{
+		Affiliations:    []RepositoryAffiliation{AffiliationOwner},
+		MaxRepositories: 100,
+	})
+
+	info, err := client.GetMyLanguages(context.Background())
+	if err != nil {
+		t.Fatalf("GetMyLanguages() error = %v", err)
+	}
+
+	if len(*requests) != 1 {
+		t.Fatalf("requests = %d, want 1", len(*requests))
+	}
+	req := (*requests)[0]
+	if !strings.Contains(req.Query, "user: viewer") || strings.Contains(req.Query, "$login") {
+		t.Errorf("query does not use viewer: %s", req.Query)
+	}
+	if req.Variables.Privacy != nil {
+		t.Errorf("privacy = %v, want null", *req.Variables.Privacy)
+	}
+	if info.Name != "Go" {
+		t.Errorf("info = %+v, want Go", info)
+	}
+}
+
+// 言語の集計の設定を検証する
+func TestLanguageConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     LanguageConfig
+		wantErr bool
+	}{
+		{name: "既定の設定", cfg: DefaultLanguageConfig()},
+		{name: "すべての関係", cfg: LanguageConfig{Affiliations: []RepositoryAffiliation{AffiliationOwner, AffiliationCollaborator, AffiliationOrganizationMember}, MaxRepositories: 100}},
+		{name: "関係がない", cfg: LanguageConfig{MaxRepositories: 100}, wantErr: true},
+		{name: "不明な関係", cfg: LanguageConfig{Affiliations: []RepositoryAffiliation{"MEMBER"}, MaxRepositories: 100}, wantErr: true},
+		{name: "最大数が0", cfg: LanguageConfig{Affiliations: []RepositoryAffiliation{AffiliationOwner}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.cfg.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -11,9 +11,10 @@ type MockClient struct {
 	GetAuthenticatedUserFunc      func(ctx context.Context) (*UserInfo, error)
 	GetUserByIDFunc               func(ctx context.Context, id int64) (*UserInfo, error)
 	GetUsersByIDsFunc             func(ctx context.Context, ids []int64) (map[int64]*UserInfo, error)
-	GetUserStatsFunc              func(ctx context.Context, githubID int64) (*UserStats, error)
-	GetMostUsedLanguageFunc       func(ctx context.Context, login string) (string, string, error)
+	GetUserStatsFunc              func(ctx context.Context, githubID int64, from, to time.Time) (*UserStats, error)
+	GetMostUsedLanguageFunc       func(ctx context.Context, login string) (LanguageInfo, error)
 	GetMostUsedLanguagesFunc      func(ctx context.Context, logins []string) (map[string]LanguageInfo, error)
+	GetMyLanguagesFunc            func(ctx context.Context) (LanguageInfo, error)
 	GetUsersFullInfoByNodeIDsFunc func(ctx context.Context, nodeIDs []string, from, to time.Time) ([]UserFullInfo, error)
 }
 
@@ -51,18 +52,22 @@ func (m *MockClient) GetUsersByIDs(ctx context.Context, ids []int64) (map[int64]
 	return result, nil
 }
 
-func (m *MockClient) GetUserStats(ctx context.Context, githubID int64) (*UserStats, error) {
+func (m *MockClient) GetUserStats(ctx context.Context, githubID int64, from, to time.Time) (*UserStats, error) {
 	if m.GetUserStatsFunc != nil {
-		return m.GetUserStatsFunc(ctx, githubID)
+		return m.GetUserStatsFunc(ctx, githubID, from, to)
 	}
 	return &UserStats{}, nil
 }
 
-func (m *MockClient) GetMostUsedLanguage(ctx context.Context, login string) (string, string, error) {
+func (m *MockClient) GetMostUsedLanguage(ctx context.Context, login string) (LanguageInfo, error) {
 	if m.GetMostUsedLanguageFunc != nil {
 		return m.GetMostUsedLanguageFunc(ctx, login)
 	}
-	return "Go", "#00ADD8", nil
+	return LanguageInfo{
+		Name:      "Go",
+		Color:     "#00ADD8",
+		Breakdown: []LanguageBreakdown{{Name: "Go", Bytes: 1000, Percentage: 100, Color: "#00ADD8"}},
+	}, nil
 }
 
 func (m *MockClient) GetMostUsedLanguages(ctx context.Context, logins []string) (map[string]LanguageInfo, error) {
@@ -72,16 +77,24 @@ func (m *MockClient) GetMostUsedLanguages(ctx context.Context, logins []string)
 	// デフォルトでは各ログイン名に対してGetMostUsedLanguageを呼び出す
 	result := make(map[string]LanguageInfo)
 	for _, login := range logins {
-		name, color, err := m.GetMostUsedLanguage(ctx, login)
+		info, err := m.GetMostUsedLanguage(ctx, login)
 		if err != nil {
 			result[login] = LanguageInfo{Name: "Unknown", Color: defaultLanguageColor}
 			continue
 		}
-		result[login] = LanguageInfo{Name: name, Color: color}
+		result[login] = info
 	}
 	return result, nil
 }
 
+func (m *MockClient) GetMyLanguages(ctx context.Context) (LanguageInfo, error) {
+	if m.GetMyLanguagesFunc != nil {
+		return m.GetMyLanguagesFunc(ctx)
+	}
+	// デフォルトではGetMostUsedLanguageと同じ結果を返す
+	return m.GetMostUsedLanguage(ctx, "")
+}
+
 func (m *MockClient) GetUsersFullInfoByNodeIDs(ctx context.Context, nodeIDs []string, from, to time.Time) ([]UserFullInfo, error) {
 	if m.GetUsersFullInfoByNodeIDsFunc != nil {
 		return m.GetUsersFullInfoByNodeIDsFunc(ctx, nodeIDs, from, to)
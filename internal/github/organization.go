@@ -0,0 +1,30 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v80/github"
+)
+
+// ListOrgMembers はOrganizationのメンバーを全ページ取得する
+// メンバー一覧のAPIは名前を返さないので、UserInfo.Name にはログイン名が入る
+func (c *Client) ListOrgMembers(ctx context.Context, org string) ([]UserInfo, error) {
+	opts := &github.ListMembersOptions{ListOptions: github.ListOptions{PerPage: 100}}
+
+	var members []UserInfo
+	for {
+		users, resp, err := c.client.Organizations.ListMembers(ctx, org, opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list organization members: %w", wrapError(err))
+		}
+		for _, user := range users {
+			members = append(members, *c.toUserInfo(user))
+		}
+
+		if resp.NextPage == 0 {
+			return members, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
@@ -0,0 +1,308 @@
+package github
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// レート制限の種類（GitHubの X-RateLimit-Resource ヘッダーの値）
+const (
+	resourceCore    = "core"
+	resourceGraphQL = "graphql"
+)
+
+const (
+	// maxRetries は5xxやセカンダリレート制限のときにリクエストをやり直す最大回数
+	maxRetries = 3
+	// baseRetryDelay はやり直すまでの待ち時間の基準。やり直すたびに倍にし、ジッターを加える
+	baseRetryDelay = 500 * time.Millisecond
+	// maxRetryWait はやり直すために待つ最大の時間
+	// Retry-After がこれより長い場合は待たずにレート制限のエラーにする（ユーザーのリクエストを長く止めないため）
+	maxRetryWait = 10 * time.Second
+	// secondaryRateLimitWait はセカンダリレート制限で Retry-After がない場合に待つ時間（GitHubのドキュメントの推奨値）
+	secondaryRateLimitWait = time.Minute
+	// drainThreshold は残りの割合がこれを下回ったら同時実行数を減らし始める
+	drainThreshold = 0.5
+)
+
+// rateBudget はGitHubから返された、ある種類のレート制限の残り
+type rateBudget struct {
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+// rateLimiter はREST・GraphQLそれぞれのレート制限の残りを記録し、残りに合わせて同時実行数を絞る
+type rateLimiter struct {
+	mu       sync.Mutex
+	budgets  map[string]rateBudget
+	inflight map[string]int
+	// wake はリクエストが終わったときや残りが更新されたときに閉じて、待っているリクエストを起こす
+	wake chan struct{}
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		budgets:  make(map[string]rateBudget),
+		inflight: make(map[string]int),
+		wake:     make(chan struct{}),
+	}
+}
+
+// RateLimiters はトークンごとのレート制限の残りを、リクエストをまたいで保持する
+// リクエストごとにClientを作ってもGitHubから返された残りを引き継げるようにする
+// トークンそのものは保存せず、SHA-256のハッシュをキーにする。最大数を超えた場合は最も長く使われていないトークンから捨てる
+type RateLimiters struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	items map[string]*list.Element
+}
+
+type rateLimiterEntry struct {
+	key     string
+	limiter *rateLimiter
+}
+
+func NewRateLimiters(size int) *RateLimiters {
+	return &RateLimiters{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// NewClient はトークンで認証され、同じトークンのClientとレート制限の残りを共有するGitHub API Clientを生成する
+func (r *RateLimiters) NewClient(token string) *Client {
+	return newClient(http.DefaultTransport, token, r.limiter(token))
+}
+
+// limiter はトークンのrateLimiterを返す。まだない場合は作る
+func (r *RateLimiters) limiter(token string) *rateLimiter {
+	h := sha256.Sum256([]byte(token))
+	key := hex.EncodeToString(h[:])
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if elem, ok := r.items[key]; ok {
+		r.order.MoveToFront(elem)
+		return elem.Value.(*rateLimiterEntry).limiter
+	}
+
+	limiter := newRateLimiter()
+	r.items[key] = r.order.PushFront(&rateLimiterEntry{key: key, limiter: limiter})
+	for r.order.Len() > r.size {
+		oldest := r.order.Back()
+		r.order.Remove(oldest)
+		delete(r.items, oldest.Value.(*rateLimiterEntry).key)
+	}
+	return limiter
+}
+
+// budget はある種類のレート制限の残りを返す。まだ分からない場合はfalseを返す
+func (l *rateLimiter) budget(resource string) (rateBudget, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.budgets[resource]
+	return b, ok
+}
+
+// concurrencyLocked はある種類のリクエストを同時に送ってよい数を返す
+// 残りが半分を切ったら残りに比例して減らし、最低でも1つは送れるようにする
+func (l *rateLimiter) concurrencyLocked(resource string) int {
+	b, ok := l.budgets[resource]
+	if !ok || b.limit <= 0 {
+		return maxConcurrentRequests
+	}
+
+	ratio := float64(b.remaining) / float64(b.limit)
+	if ratio >= drainThreshold {
+		return maxConcurrentRequests
+	}
+	n := int(float64(maxConcurrentRequests) * ratio / drainThreshold)
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// acquire は同時実行数に空きができるまで待つ
+func (l *rateLimiter) acquire(ctx context.Context, resource string) error {
+	for {
+		l.mu.Lock()
+		if l.inflight[resource] < l.concurrencyLocked(resource) {
+			l.inflight[resource]++
+			l.mu.Unlock()
+			return nil
+		}
+		wake := l.wake
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wake:
+		}
+	}
+}
+
+func (l *rateLimiter) release(resource string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inflight[resource]--
+	l.broadcastLocked()
+}
+
+// update はレスポンスの X-RateLimit-* ヘッダーから残りを記録する
+func (l *rateLimiter) update(header http.Header) {
+	resource := header.Get("X-RateLimit-Resource")
+	limit, errLimit := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	remaining, errRemaining := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	reset, errReset := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64)
+	if resource == "" || errLimit != nil || errRemaining != nil || errReset != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.budgets[resource] = rateBudget{limit: limit, remaining: remaining, reset: time.Unix(reset, 0)}
+	l.broadcastLocked()
+}
+
+func (l *rateLimiter) broadcastLocked() {
+	close(l.wake)
+	l.wake = make(chan struct{})
+}
+
+// resourceOf はリクエストがどの種類のレート制限を使うかを返す
+func resourceOf(req *http.Request) string {
+	if strings.HasSuffix(req.URL.Path, "/graphql") {
+		return resourceGraphQL
+	}
+	return resourceCore
+}
+
+// rateLimitTransport はレート制限の残りを記録しながらリクエストを送る http.RoundTripper
+// 5xxとセカンダリレート制限のレスポンスはジッター付きのバックオフで待ってからやり直す
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	limiter *rateLimiter
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resource := resourceOf(req)
+	if err := t.limiter.acquire(req.Context(), resource); err != nil {
+		return nil, err
+	}
+	defer t.limiter.release(resource)
+
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+		t.limiter.update(resp.Header)
+
+		// 本文を作り直せないリクエストはやり直さない
+		wait, retry := retryDelay(resp, attempt)
+		if !retry || attempt >= maxRetries || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+			return resp, nil
+		}
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		if err := t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryDelay はレスポンスをやり直すべきかと、やり直すまでに待つ時間を返す
+// 残りが0になった（プライマリの）レート制限はやり直さず、go-githubのRateLimitErrorとして返す
+func retryDelay(resp *http.Response, attempt int) (time.Duration, bool) {
+	switch {
+	case resp.StatusCode >= 500:
+		if wait, ok := retryAfter(resp); ok {
+			return wait, wait <= maxRetryWait
+		}
+		return backoff(attempt), true
+
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+		if resp.Header.Get("X-RateLimit-Remaining") == "0" {
+			return 0, false
+		}
+		if wait, ok := retryAfter(resp); ok {
+			return wait, wait <= maxRetryWait
+		}
+		if isSecondaryRateLimit(resp) {
+			return secondaryRateLimitWait, secondaryRateLimitWait <= maxRetryWait
+		}
+	}
+
+	return 0, false
+}
+
+// backoff は attempt 回目のやり直しまでに待つ時間を返す（フルジッター）
+func backoff(attempt int) time.Duration {
+	d := baseRetryDelay << attempt
+	return d/2 + rand.N(d/2+1)
+}
+
+// retryAfter は Retry-After ヘッダー（秒数）を読み込む
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	seconds, err := strconv.Atoi(v)
+	if err != nil || seconds < 0 {
+		return 0, false
+	}
+	return time.Duration(seconds) * time.Second, true
+}
+
+// isSecondaryRateLimit はレスポンスの本文からセカンダリレート制限かどうかを判定する
+// 本文は読み込んだ後に元に戻すので、呼び出し元でもそのまま読める
+func isSecondaryRateLimit(resp *http.Response) bool {
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+	if err != nil {
+		return false
+	}
+	return bytes.Contains(bytes.ToLower(data), []byte("secondary rate limit"))
+}
+
+// sleepContext は d の間待つ。ctxがキャンセルされた場合はその時点で戻る
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
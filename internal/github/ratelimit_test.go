@@ -0,0 +1,226 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/google/go-github/v80/github"
+)
+
+// newTestClient はテスト用のサーバーに接続し、待たずにやり直すClientを作る
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, *[]time.Duration) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	var waits []time.Duration
+	limiter := newRateLimiter()
+	transport := &rateLimitTransport{
+		base:    http.DefaultTransport,
+		limiter: limiter,
+		sleep: func(ctx context.Context, d time.Duration) error {
+			waits = append(waits, d)
+			return nil
+		},
+	}
+	client := github.NewClient(&http.Client{Transport: transport})
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+	return &Client{client: client, limiter: limiter, languages: DefaultLanguageConfig()}, &waits
+}
+
+func setRateLimitHeaders(w http.ResponseWriter, resource string, limit, remaining int, reset time.Time) {
+	w.Header().Set("X-RateLimit-Resource", resource)
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+}
+
+// 5xxはバックオフしてやり直す
+func TestRateLimitTransport_RetryServerError(t *testing.T) {
+	var calls atomic.Int32
+	client, waits := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 1, "login": "octocat"}`))
+	})
+
+	user, err := client.GetUserByID(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if user.Login != "octocat" {
+		t.Errorf("Login = %s, want octocat", user.Login)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("calls = %d, want 3", calls.Load())
+	}
+	if len(*waits) != 2 || (*waits)[0] > baseRetryDelay || (*waits)[1] > 2*baseRetryDelay {
+		t.Errorf("waits = %v", *waits)
+	}
+}
+
+// セカンダリレート制限は Retry-After だけ待ってやり直す
+func TestRateLimitTransport_RetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	client, waits := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "3")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message": "You have exceeded a secondary rate limit."}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id": 1, "login": "octocat"}`))
+	})
+
+	if _, err := client.GetUserByID(context.Background(), 1); err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if len(*waits) != 1 || (*waits)[0] != 3*time.Second {
+		t.Errorf("waits = %v, want [3s]", *waits)
+	}
+}
+
+// 残りが0になった場合はやり直さず、解除される日時付きのエラーを返す
+func TestRateLimitTransport_Exhausted(t *testing.T) {
+	reset := time.Now().Add(30 * time.Minute).Truncate(time.Second)
+	var calls atomic.Int32
+	client, waits := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		setRateLimitHeaders(w, resourceCore, 5000, 0, reset)
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+	})
+
+	_, err := client.GetUserByID(context.Background(), 1)
+	if !errors.Is(err, domain.ErrRateLimited) {
+		t.Fatalf("GetUserByID() error = %v, want %v", err, domain.ErrRateLimited)
+	}
+	var rateErr *domain.RateLimitError
+	if !errors.As(err, &rateErr) || !rateErr.ResetAt.Equal(reset) {
+		t.Errorf("ResetAt = %v, want %v", rateErr, reset)
+	}
+	if calls.Load() != 1 || len(*waits) != 0 {
+		t.Errorf("calls = %d, waits = %v", calls.Load(), *waits)
+	}
+}
+
+// GraphQLのレート制限は記録しておいた残りから解除される日時を求める
+func TestExecuteGraphQL_RateLimited(t *testing.T) {
+	reset := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		setRateLimitHeaders(w, resourceGraphQL, 5000, 0, reset)
+		_, _ = w.Write([]byte(`{"errors": [{"type": "RATE_LIMITED", "message": "API rate limit exceeded"}]}`))
+	})
+
+	var result struct{}
+	err := client.executeGraphQL(context.Background(), "query { viewer { login } }", nil, &result)
+	var rateErr *domain.RateLimitError
+	if !errors.As(err, &rateErr) {
+		t.Fatalf("executeGraphQL() error = %v, want RateLimitError", err)
+	}
+	if !rateErr.ResetAt.Equal(reset) {
+		t.Errorf("ResetAt = %v, want %v", rateErr.ResetAt, reset)
+	}
+}
+
+// 残りが半分を切ったら残りに比例して同時実行数を減らす
+func TestRateLimiter_Concurrency(t *testing.T) {
+	reset := time.Now().Add(time.Hour)
+	tests := []struct {
+		name      string
+		remaining int
+		want      int
+	}{
+		{name: "残りが多い", remaining: 4000, want: maxConcurrentRequests},
+		{name: "残りが4分の1", remaining: 1250, want: maxConcurrentRequests / 2},
+		{name: "残りが0", remaining: 0, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter := newRateLimiter()
+			header := http.Header{}
+			header.Set("X-RateLimit-Resource", resourceCore)
+			header.Set("X-RateLimit-Limit", "5000")
+			header.Set("X-RateLimit-Remaining", strconv.Itoa(tt.remaining))
+			header.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+			limiter.update(header)
+
+			limiter.mu.Lock()
+			got := limiter.concurrencyLocked(resourceCore)
+			limiter.mu.Unlock()
+			if got != tt.want {
+				t.Errorf("concurrency = %d, want %d", got, tt.want)
+			}
+			if other := limiter.concurrencyLocked(resourceGraphQL); other != maxConcurrentRequests {
+				t.Errorf("graphql concurrency = %d, want %d", other, maxConcurrentRequests)
+			}
+		})
+	}
+}
+
+// 同じトークンで作ったClientは、前のリクエストで分かったレート制限の残りを引き継ぐ
+func TestRateLimiters_ShareBudgetPerToken(t *testing.T) {
+	reset := time.Now().Add(time.Hour)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		setRateLimitHeaders(w, resourceCore, 5000, 1250, reset)
+		_, _ = w.Write([]byte(`{"id": 1, "login": "octocat"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	limiters := NewRateLimiters(10)
+	newServerClient := func(token string) *Client {
+		client := limiters.NewClient(token)
+		client.client.BaseURL, _ = url.Parse(server.URL + "/")
+		return client
+	}
+
+	// 1回目のリクエストで残りを記録する
+	if _, err := newServerClient("token-a").GetUserByID(context.Background(), 1); err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+
+	// 2回目のリクエストのClientは、同じトークンなら1回目の残りを使う
+	second := newServerClient("token-a")
+	budget, ok := second.limiter.budget(resourceCore)
+	if !ok || budget.remaining != 1250 || !budget.reset.Equal(reset.Truncate(time.Second)) {
+		t.Fatalf("budget = %+v, %v, want remaining 1250", budget, ok)
+	}
+	second.limiter.mu.Lock()
+	got := second.limiter.concurrencyLocked(resourceCore)
+	second.limiter.mu.Unlock()
+	if got != maxConcurrentRequests/2 {
+		t.Errorf("concurrency = %d, want %d", got, maxConcurrentRequests/2)
+	}
+
+	// 別のトークンの残りは共有しない
+	if _, ok := newServerClient("token-b").limiter.budget(resourceCore); ok {
+		t.Errorf("budget of another token is shared")
+	}
+}
+
+// 最大数を超えた場合は最も長く使われていないトークンの残りから捨てる
+func TestRateLimiters_Evict(t *testing.T) {
+	limiters := NewRateLimiters(2)
+	a := limiters.limiter("token-a")
+	b := limiters.limiter("token-b")
+	limiters.limiter("token-a")
+	limiters.limiter("token-c")
+
+	if limiters.limiter("token-a") != a {
+		t.Errorf("recently used limiter was evicted")
+	}
+	if limiters.limiter("token-b") == b {
+		t.Errorf("least recently used limiter was not evicted")
+	}
+}
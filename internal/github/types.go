@@ -1,5 +1,7 @@
 package github
 
+import "time"
+
 type UserInfo struct {
 	ID        int64
 	NodeID    string
@@ -17,8 +19,15 @@ type UserStats struct {
 	Contributions         []Contribution
 	MostUsedLanguage      string
 	MostUsedLanguageColor string
-	TotalContribution     int
-	ContributionDetail    ContributionDetail
+	// Languages はコードの量が多い順の言語の内訳
+	Languages          []LanguageBreakdown
+	TotalContribution  int
+	ContributionDetail ContributionDetail
+	// ActivityTimes は時刻が分かるコントリビューション（Issue・PR・レビュー）の日時。期間ごとに種類ごとに最大100件
+	ActivityTimes []time.Time
+	// From と To はGitHubが実際に集計した期間
+	From time.Time
+	To   time.Time
 }
 
 type ContributionDetail struct {
@@ -35,10 +44,20 @@ type UserFullInfo struct {
 	Name                  string
 	AvatarURL             string
 	Total                 int
+	Contributions         []Contribution
 	Commits               int
 	Issues                int
 	PRs                   int
 	Reviews               int
 	MostUsedLanguage      string
 	MostUsedLanguageColor string
+	Languages             []LanguageBreakdown
+}
+
+// LanguageBreakdown は言語ごとのバイト数と割合
+type LanguageBreakdown struct {
+	Name       string
+	Bytes      int
+	Percentage float64 // 全ての言語の合計に対する割合（0〜100）
+	Color      string
 }
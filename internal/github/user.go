@@ -19,7 +19,7 @@ const (
 func (c *Client) GetAuthenticatedUser(ctx context.Context) (*UserInfo, error) {
 	user, _, err := c.client.Users.Get(ctx, "")
 	if err != nil {
-		return nil, fmt.Errorf("failed to get authenticated user: %w", err)
+		return nil, fmt.Errorf("failed to get authenticated user: %w", wrapError(err))
 	}
 
 	return c.toUserInfo(user), nil
@@ -29,7 +29,7 @@ func (c *Client) GetAuthenticatedUser(ctx context.Context) (*UserInfo, error) {
 func (c *Client) GetUserByID(ctx context.Context, id int64) (*UserInfo, error) {
 	user, _, err := c.client.Users.GetByID(ctx, id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get user by ID: %w", err)
+		return nil, fmt.Errorf("failed to get user by ID: %w", wrapError(err))
 	}
 
 	return c.toUserInfo(user), nil
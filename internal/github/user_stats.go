@@ -4,23 +4,67 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
+// maxContributionsRange はGitHubが1回のクエリで集計できる期間の上限（1年）
+const maxContributionsRange = 365 * 24 * time.Hour
+
 // GitHubIDからコントリビューション統計を取得する
-func (c *Client) GetUserStats(ctx context.Context, githubID int64) (*UserStats, error) {
+// from と to がゼロ値の場合はGitHubの既定の過去1年間を集計する
+// 1年より長い期間は1年ごとに分けて取得し、日ごとのデータと内訳を合算する
+func (c *Client) GetUserStats(ctx context.Context, githubID int64, from, to time.Time) (*UserStats, error) {
 	// GitHubIDからユーザー情報を取得してログイン名を取得
 	userInfo, err := c.GetUserByID(ctx, githubID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user info: %w", err)
 	}
 
-	// GraphQLクエリ（デフォルトで過去1年間）
+	if from.IsZero() && to.IsZero() {
+		return c.getUserStatsInRange(ctx, userInfo.Login, nil, nil, true)
+	}
+
+	// 言語の集計は期間によらないので、最初の期間でだけ取得する
+	// 日ごとの数は1日分がまとめて返るので、期間の境目はUTCの0時に揃えて同じ日が2つの期間に分かれないようにする
+	var stats *UserStats
+	for start := from; start.Before(to); {
+		end := start.Add(maxContributionsRange).Truncate(24 * time.Hour)
+		if end.After(to) {
+			end = to
+		}
+
+		chunk, err := c.getUserStatsInRange(ctx, userInfo.Login, &start, &end, stats == nil)
+		if err != nil {
+			return nil, err
+		}
+		if stats == nil {
+			stats = chunk
+		} else {
+			stats.merge(chunk)
+		}
+		start = end
+	}
+	if stats == nil {
+		return nil, fmt.Errorf("%w: from must be before to: from=%s, to=%s", domain.ErrInvalidArgument, from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+
+	return stats, nil
+}
+
+// getUserStatsInRange は1年以内の期間のコントリビューション統計を取得する
+// from と to がnilの場合はGitHubの既定の過去1年間を集計する。withLanguages がfalseの場合は言語を集計しない
+func (c *Client) getUserStatsInRange(ctx context.Context, login string, from, to *time.Time, withLanguages bool) (*UserStats, error) {
 	query := `
-		query($login: String!) {
+		query($login: String!, $from: DateTime, $to: DateTime, $withLanguages: Boolean!, $affiliations: [RepositoryAffiliation]) {
 			user(login: $login) {
 				# 1. コントリビューション関連の集計
-				contributionsCollection {
-					# 過去1年間のトータルと日毎のデータ
+				contributionsCollection(from: $from, to: $to) {
+					# 実際に集計された期間
+					startedAt
+					endedAt
+					# 期間のトータルと日毎のデータ
 					contributionCalendar {
 						totalContributions
 						weeks {
@@ -35,32 +79,50 @@ func (c *Client) GetUserStats(ctx context.Context, githubID int64) (*UserStats,
 					totalIssueContributions
 					totalPullRequestContributions
 					totalPullRequestReviewContributions
-				}
-				# 2. 言語統計のためのリポジトリ情報
-				repositories(first: 100, ownerAffiliations: OWNER, isFork: false, privacy: PUBLIC) {
-					nodes {
-						languages(first: 20) {
-							edges {
-								size
-								node {
-									name
-								}
-							}
+					# 時間帯を求めるための日時（コミットは日付しか分からないので含めない）
+					issueContributions(first: 100) {
+						nodes {
+							occurredAt
+						}
+					}
+					pullRequestContributions(first: 100) {
+						nodes {
+							occurredAt
+						}
+					}
+					pullRequestReviewContributions(first: 100) {
+						nodes {
+							occurredAt
 						}
 					}
 				}
+				# 2. 言語統計のためのリポジトリ情報（続きのページは別のクエリで取得する）
+				repositories(first: 100, ownerAffiliations: $affiliations, isFork: false, privacy: PUBLIC) @include(if: $withLanguages) {` + repositoryLanguagesFields + `
+				}
 			}
 		}
 	`
 
 	variables := map[string]interface{}{
-		"login": userInfo.Login,
+		"login":         login,
+		"from":          nil,
+		"to":            nil,
+		"withLanguages": withLanguages,
+		"affiliations":  c.affiliationsVariable(),
+	}
+	if from != nil {
+		variables["from"] = from.Format(time.RFC3339)
+	}
+	if to != nil {
+		variables["to"] = to.Format(time.RFC3339)
 	}
 
 	// GraphQLリクエストの実行
 	var result struct {
 		User struct {
 			ContributionsCollection struct {
+				StartedAt            time.Time `json:"startedAt"`
+				EndedAt              time.Time `json:"endedAt"`
 				ContributionCalendar struct {
 					TotalContributions int `json:"totalContributions"`
 					Weeks              []struct {
@@ -70,23 +132,15 @@ func (c *Client) GetUserStats(ctx context.Context, githubID int64) (*UserStats,
 						} `json:"contributionDays"`
 					} `json:"weeks"`
 				} `json:"contributionCalendar"`
-				TotalCommitContributions            int `json:"totalCommitContributions"`
-				TotalIssueContributions             int `json:"totalIssueContributions"`
-				TotalPullRequestContributions       int `json:"totalPullRequestContributions"`
-				TotalPullRequestReviewContributions int `json:"totalPullRequestReviewContributions"`
+				TotalCommitContributions            int                  `json:"totalCommitContributions"`
+				TotalIssueContributions             int                  `json:"totalIssueContributions"`
+				TotalPullRequestContributions       int                  `json:"totalPullRequestContributions"`
+				TotalPullRequestReviewContributions int                  `json:"totalPullRequestReviewContributions"`
+				IssueContributions                  occurredAtConnection `json:"issueContributions"`
+				PullRequestContributions            occurredAtConnection `json:"pullRequestContributions"`
+				PullRequestReviewContributions      occurredAtConnection `json:"pullRequestReviewContributions"`
 			} `json:"contributionsCollection"`
-			Repositories struct {
-				Nodes []struct {
-					Languages struct {
-						Edges []struct {
-							Size int `json:"size"`
-							Node struct {
-								Name string `json:"name"`
-							} `json:"node"`
-						} `json:"edges"`
-					} `json:"languages"`
-				} `json:"nodes"`
-			} `json:"repositories"`
+			Repositories repositoryLanguages `json:"repositories"`
 		} `json:"user"`
 	}
 
@@ -94,6 +148,18 @@ func (c *Client) GetUserStats(ctx context.Context, githubID int64) (*UserStats,
 		return nil, err
 	}
 
+	// 時刻が分かるコントリビューションの日時を集める
+	var activityTimes []time.Time
+	for _, connection := range []occurredAtConnection{
+		result.User.ContributionsCollection.IssueContributions,
+		result.User.ContributionsCollection.PullRequestContributions,
+		result.User.ContributionsCollection.PullRequestReviewContributions,
+	} {
+		for _, node := range connection.Nodes {
+			activityTimes = append(activityTimes, node.OccurredAt)
+		}
+	}
+
 	// 日ごとのコントリビューションデータを平坦化
 	var contributions []Contribution
 	for _, week := range result.User.ContributionsCollection.ContributionCalendar.Weeks {
@@ -105,121 +171,89 @@ func (c *Client) GetUserStats(ctx context.Context, githubID int64) (*UserStats,
 		}
 	}
 
-	// 最も使用している言語を集計
-	languageStats := make(map[string]int)
-	for _, repo := range result.User.Repositories.Nodes {
-		for _, edge := range repo.Languages.Edges {
-			languageStats[edge.Node.Name] += edge.Size
-		}
-	}
-
-	// 最大の言語を見つける
-	mostUsedLanguage := ""
-	maxSize := 0
-	for lang, size := range languageStats {
-		if size > maxSize {
-			maxSize = size
-			mostUsedLanguage = lang
-		}
-	}
-
-	// 言語が見つからない場合はデフォルト値を設定
-	if mostUsedLanguage == "" {
-		mostUsedLanguage = "Unknown"
-	}
-
 	stats := &UserStats{
-		Contributions:         contributions,
-		MostUsedLanguage:      mostUsedLanguage,
-		MostUsedLanguageColor: GetLanguageColor(mostUsedLanguage),
-		TotalContribution:     result.User.ContributionsCollection.ContributionCalendar.TotalContributions,
+		Contributions:     contributions,
+		TotalContribution: result.User.ContributionsCollection.ContributionCalendar.TotalContributions,
 		ContributionDetail: ContributionDetail{
 			ReviewCount:      result.User.ContributionsCollection.TotalPullRequestReviewContributions,
 			CommitCount:      result.User.ContributionsCollection.TotalCommitContributions,
 			IssueCount:       result.User.ContributionsCollection.TotalIssueContributions,
 			PullRequestCount: result.User.ContributionsCollection.TotalPullRequestContributions,
 		},
+		ActivityTimes: activityTimes,
+		From:          result.User.ContributionsCollection.StartedAt,
+		To:            result.User.ContributionsCollection.EndedAt,
+	}
+	if !withLanguages {
+		return stats, nil
 	}
 
+	// 言語の内訳を集計
+	repositories, err := c.getRepositoryLanguages(ctx, languageOwner{login: login}, &result.User.Repositories)
+	if err != nil {
+		return nil, err
+	}
+	language := newLanguageInfo(repositories.breakdown())
+	stats.MostUsedLanguage = language.Name
+	stats.MostUsedLanguageColor = language.Color
+	stats.Languages = language.Breakdown
 	return stats, nil
 }
 
-// GetMostUsedLanguage はユーザーの最も使用している言語を取得する
-func (c *Client) GetMostUsedLanguage(ctx context.Context, login string) (string, string, error) {
-	query := `
-		query($login: String!) {
-			user(login: $login) {
-				repositories(first: 100, ownerAffiliations: OWNER, isFork: false, privacy: PUBLIC) {
-					nodes {
-						languages(first: 20) {
-							edges {
-								size
-								node {
-									name
-								}
-							}
-						}
-					}
-				}
-			}
-		}
-	`
-
-	variables := map[string]interface{}{
-		"login": login,
-	}
+// occurredAtConnection はコントリビューションの一覧のうち、行った日時だけを取り出す
+type occurredAtConnection struct {
+	Nodes []struct {
+		OccurredAt time.Time `json:"occurredAt"`
+	} `json:"nodes"`
+}
 
-	var result struct {
-		User struct {
-			Repositories struct {
-				Nodes []struct {
-					Languages struct {
-						Edges []struct {
-							Size int `json:"size"`
-							Node struct {
-								Name string `json:"name"`
-							} `json:"node"`
-						} `json:"edges"`
-					} `json:"languages"`
-				} `json:"nodes"`
-			} `json:"repositories"`
-		} `json:"user"`
+// merge は続きの期間の統計を合算する
+// 期間の境目の日は両方のカレンダーにその日全体の数で含まれることがあるので、同じ日は1つだけ残して合計からも1日分を除く
+func (us *UserStats) merge(next *UserStats) {
+	contributions := next.Contributions
+	overlap := 0
+	if n := len(us.Contributions); n > 0 && len(contributions) > 0 && us.Contributions[n-1].Date == contributions[0].Date {
+		overlap = min(us.Contributions[n-1].Count, contributions[0].Count)
+		us.Contributions[n-1].Count = max(us.Contributions[n-1].Count, contributions[0].Count)
+		contributions = contributions[1:]
 	}
+	us.Contributions = append(us.Contributions, contributions...)
 
-	if err := c.executeGraphQL(ctx, query, variables, &result); err != nil {
-		return "", "", fmt.Errorf("failed to execute GraphQL query: %w", err)
-	}
+	us.ActivityTimes = append(us.ActivityTimes, next.ActivityTimes...)
+	us.TotalContribution += next.TotalContribution - overlap
+	us.ContributionDetail.ReviewCount += next.ContributionDetail.ReviewCount
+	us.ContributionDetail.CommitCount += next.ContributionDetail.CommitCount
+	us.ContributionDetail.IssueCount += next.ContributionDetail.IssueCount
+	us.ContributionDetail.PullRequestCount += next.ContributionDetail.PullRequestCount
+	us.To = next.To
+}
 
-	// 最も使用している言語を集計
-	languageStats := make(map[string]int)
-	for _, repo := range result.User.Repositories.Nodes {
-		for _, edge := range repo.Languages.Edges {
-			languageStats[edge.Node.Name] += edge.Size
-		}
+// GetMostUsedLanguage はユーザーの公開リポジトリから、最も使用している言語と言語の内訳を取得する
+func (c *Client) GetMostUsedLanguage(ctx context.Context, login string) (LanguageInfo, error) {
+	repositories, err := c.getRepositoryLanguages(ctx, languageOwner{login: login}, nil)
+	if err != nil {
+		return LanguageInfo{}, err
 	}
 
-	// 最大の言語を見つける
-	mostUsedLanguage := ""
-	maxSize := 0
-	for lang, size := range languageStats {
-		if size > maxSize {
-			maxSize = size
-			mostUsedLanguage = lang
-		}
-	}
+	return newLanguageInfo(repositories.breakdown()), nil
+}
 
-	// 言語が見つからない場合はデフォルト値を返す
-	if mostUsedLanguage == "" {
-		return "Unknown", defaultLanguageColor, nil
+// GetMyLanguages はトークンのユーザー自身の、プライベートリポジトリも含めた言語の内訳を取得する
+// トークンでプライベートリポジトリが見えない場合は公開リポジトリだけで集計する
+func (c *Client) GetMyLanguages(ctx context.Context) (LanguageInfo, error) {
+	repositories, err := c.getRepositoryLanguages(ctx, languageOwner{viewer: true}, nil)
+	if err != nil {
+		return LanguageInfo{}, err
 	}
 
-	return mostUsedLanguage, GetLanguageColor(mostUsedLanguage), nil
+	return newLanguageInfo(repositories.breakdown()), nil
 }
 
 // LanguageInfo は言語名と色を保持する構造体
 type LanguageInfo struct {
-	Name  string // 言語名（例: "Go", "Python"）
-	Color string // 言語の表示色（例: "#00ADD8"）
+	Name      string              // 言語名（例: "Go", "Python"）
+	Color     string              // 言語の表示色（例: "#00ADD8"）
+	Breakdown []LanguageBreakdown // コードの量が多い順の言語の内訳
 }
 
 // GetMostUsedLanguages は複数ユーザーの最も使用している言語を一括取得する
@@ -257,10 +291,10 @@ func (c *Client) GetMostUsedLanguages(ctx context.Context, logins []string) (map
 				defer func() { <-sem }()
 			}
 
-			langName, langColor, err := c.GetMostUsedLanguage(ctx, login)
+			info, err := c.GetMostUsedLanguage(ctx, login)
 			results <- result{
 				login: login,
-				info:  LanguageInfo{Name: langName, Color: langColor},
+				info:  info,
 				err:   err,
 			}
 		}(login)
@@ -0,0 +1,137 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// 1年より長い期間は1年ごとに分けて取得し、日ごとのデータと内訳を合算する
+func TestGetUserStats_SplitRange(t *testing.T) {
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(2*maxContributionsRange + 24*time.Hour)
+
+	var ranges [][2]string
+	var withLanguages []bool
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/graphql") {
+			_, _ = w.Write([]byte(`{"id": 1, "login": "octocat"}`))
+			return
+		}
+
+		var req struct {
+			Variables struct {
+				From          string `json:"from"`
+				To            string `json:"to"`
+				WithLanguages bool   `json:"withLanguages"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		ranges = append(ranges, [2]string{req.Variables.From, req.Variables.To})
+		withLanguages = append(withLanguages, req.Variables.WithLanguages)
+
+		// 期間の最初と最後の日にそれぞれ1件ずつコントリビューションがあるとする
+		start, _ := time.Parse(time.RFC3339, req.Variables.From)
+		end, _ := time.Parse(time.RFC3339, req.Variables.To)
+		repositories := ""
+		if req.Variables.WithLanguages {
+			repositories = `, "repositories": {"nodes": [{"languages": {"edges": [{"size": 10, "node": {"name": "Go"}}]}}]}`
+		}
+		fmt.Fprintf(w, `{"data": {"user": {"contributionsCollection": {
+			"startedAt": %q, "endedAt": %q,
+			"contributionCalendar": {"totalContributions": 2, "weeks": [{"contributionDays": [
+				{"date": %q, "contributionCount": 1}, {"date": %q, "contributionCount": 1}
+			]}]},
+			"totalCommitContributions": 2, "totalIssueContributions": 0,
+			"totalPullRequestContributions": 0, "totalPullRequestReviewContributions": 0
+		}%s}}}`, req.Variables.From, req.Variables.To, start.Format(time.DateOnly), end.Format(time.DateOnly), repositories)
+	})
+
+	stats, err := client.GetUserStats(context.Background(), 1, from, to)
+	if err != nil {
+		t.Fatalf("GetUserStats() error = %v", err)
+	}
+
+	if len(ranges) != 3 {
+		t.Fatalf("queries = %v, want 3", ranges)
+	}
+	if ranges[0][0] != from.Format(time.RFC3339) || ranges[2][1] != to.Format(time.RFC3339) || ranges[0][1] != ranges[1][0] {
+		t.Errorf("ranges = %v", ranges)
+	}
+	if !withLanguages[0] || withLanguages[1] || withLanguages[2] {
+		t.Errorf("withLanguages = %v, want only the first", withLanguages)
+	}
+
+	// 期間の境目の日は両方のカレンダーに含まれるので、1日分だけを数える
+	if stats.TotalContribution != 4 || stats.ContributionDetail.CommitCount != 6 {
+		t.Errorf("TotalContribution = %d, CommitCount = %d, want 4 and 6", stats.TotalContribution, stats.ContributionDetail.CommitCount)
+	}
+	if len(stats.Contributions) != 4 || stats.Contributions[1].Count != 1 || stats.Contributions[2].Count != 1 {
+		t.Errorf("Contributions = %v", stats.Contributions)
+	}
+	if !stats.From.Equal(from) || !stats.To.Equal(to) {
+		t.Errorf("range = %v - %v, want %v - %v", stats.From, stats.To, from, to)
+	}
+	if stats.MostUsedLanguage != "Go" {
+		t.Errorf("MostUsedLanguage = %s, want Go", stats.MostUsedLanguage)
+	}
+}
+
+// 期間の途中から始まる場合も、期間の境目はUTCの0時に揃えて境目の日を二重に数えない
+func TestGetUserStats_SplitRangeBoundaryDay(t *testing.T) {
+	from := time.Date(2022, 1, 1, 15, 0, 0, 0, time.UTC)
+	to := from.Add(400 * 24 * time.Hour)
+	boundary := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var ranges [][2]string
+	client, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/graphql") {
+			_, _ = w.Write([]byte(`{"id": 1, "login": "octocat"}`))
+			return
+		}
+
+		var req struct {
+			Variables struct {
+				From string `json:"from"`
+				To   string `json:"to"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("failed to decode request: %v", err)
+		}
+		ranges = append(ranges, [2]string{req.Variables.From, req.Variables.To})
+
+		// カレンダーは期間の最初と最後の日を、その日全体の数（3件）で返す
+		start, _ := time.Parse(time.RFC3339, req.Variables.From)
+		end, _ := time.Parse(time.RFC3339, req.Variables.To)
+		fmt.Fprintf(w, `{"data": {"user": {"contributionsCollection": {
+			"startedAt": %q, "endedAt": %q,
+			"contributionCalendar": {"totalContributions": 6, "weeks": [{"contributionDays": [
+				{"date": %q, "contributionCount": 3}, {"date": %q, "contributionCount": 3}
+			]}]},
+			"totalCommitContributions": 0, "totalIssueContributions": 0,
+			"totalPullRequestContributions": 0, "totalPullRequestReviewContributions": 0
+		}}}}`, req.Variables.From, req.Variables.To, start.Format(time.DateOnly), end.Format(time.DateOnly))
+	})
+
+	stats, err := client.GetUserStats(context.Background(), 1, from, to)
+	if err != nil {
+		t.Fatalf("GetUserStats() error = %v", err)
+	}
+
+	if len(ranges) != 2 || ranges[0][1] != boundary.Format(time.RFC3339) || ranges[1][0] != boundary.Format(time.RFC3339) {
+		t.Fatalf("ranges = %v, want split at %s", ranges, boundary.Format(time.RFC3339))
+	}
+	if len(stats.Contributions) != 3 || stats.Contributions[1].Date != boundary.Format(time.DateOnly) || stats.Contributions[1].Count != 3 {
+		t.Errorf("Contributions = %v", stats.Contributions)
+	}
+	if stats.TotalContribution != 9 {
+		t.Errorf("TotalContribution = %d, want 9", stats.TotalContribution)
+	}
+}
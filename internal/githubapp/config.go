@@ -0,0 +1,61 @@
+package githubapp
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/furarico/octo-deck-api/internal/config"
+)
+
+// Config はバックグラウンドの処理や管理用のコマンドで使うGitHub Appの設定
+type Config struct {
+	// AppID はGitHub AppのID
+	AppID int64
+	// InstallationID はGitHub Appをインストールした先（Organization）のID
+	InstallationID int64
+	// PrivateKey はGitHub Appの秘密鍵（PEM）
+	PrivateKey []byte
+}
+
+// LoadConfig は環境変数からGitHub Appの設定を読み込む
+// どれも設定されていない場合は Enabled が false の設定を返す
+func LoadConfig() (Config, error) {
+	privateKey := os.Getenv("GITHUB_APP_PRIVATE_KEY")
+	privateKeyPath := os.Getenv("GITHUB_APP_PRIVATE_KEY_PATH")
+
+	var cfg Config
+	var err error
+	if cfg.AppID, err = config.Int64("GITHUB_APP_ID", 0); err != nil {
+		return Config{}, err
+	}
+	if cfg.InstallationID, err = config.Int64("GITHUB_APP_INSTALLATION_ID", 0); err != nil {
+		return Config{}, err
+	}
+
+	if cfg.AppID == 0 && cfg.InstallationID == 0 && privateKey == "" && privateKeyPath == "" {
+		return Config{}, nil
+	}
+	if cfg.AppID <= 0 || cfg.InstallationID <= 0 {
+		return Config{}, fmt.Errorf("GITHUB_APP_ID and GITHUB_APP_INSTALLATION_ID must be positive")
+	}
+
+	switch {
+	case privateKey != "":
+		// .env などでは改行を \n と書けるようにする
+		cfg.PrivateKey = []byte(strings.ReplaceAll(privateKey, `\n`, "\n"))
+	case privateKeyPath != "":
+		if cfg.PrivateKey, err = os.ReadFile(privateKeyPath); err != nil {
+			return Config{}, fmt.Errorf("failed to read GITHUB_APP_PRIVATE_KEY_PATH: %w", err)
+		}
+	default:
+		return Config{}, fmt.Errorf("GITHUB_APP_PRIVATE_KEY or GITHUB_APP_PRIVATE_KEY_PATH is required")
+	}
+
+	return cfg, nil
+}
+
+// Enabled はGitHub Appが設定されているかどうかを返す
+func (c Config) Enabled() bool {
+	return c.AppID != 0
+}
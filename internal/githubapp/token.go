@@ -0,0 +1,86 @@
+package githubapp
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/github"
+	gogithub "github.com/google/go-github/v80/github"
+)
+
+// tokenRefreshMargin は期限のこの時間前になったらインストールトークンを取り直す
+const tokenRefreshMargin = 5 * time.Minute
+
+// InstallationTokenSource はGitHub Appのインストールトークンを発行し、期限が近づくまで使い回す
+type InstallationTokenSource struct {
+	appID          int64
+	installationID int64
+	key            *rsa.PrivateKey
+	httpClient     *http.Client
+	// baseURL はGitHub APIのURL（テストで差し替える）
+	baseURL *url.URL
+	now     func() time.Time
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func NewInstallationTokenSource(cfg Config) (*InstallationTokenSource, error) {
+	key, err := parsePrivateKey(cfg.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InstallationTokenSource{
+		appID:          cfg.AppID,
+		installationID: cfg.InstallationID,
+		key:            key,
+		httpClient:     http.DefaultClient,
+		now:            time.Now,
+	}, nil
+}
+
+// Token はインストールトークンを返す。期限が近い場合は発行し直す
+func (s *InstallationTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.token != "" && now.Add(tokenRefreshMargin).Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	jwt, err := signJWT(s.key, s.appID, now)
+	if err != nil {
+		return "", err
+	}
+
+	client := gogithub.NewClient(s.httpClient).WithAuthToken(jwt)
+	if s.baseURL != nil {
+		client.BaseURL = s.baseURL
+	}
+	token, _, err := client.Apps.CreateInstallationToken(ctx, s.installationID, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create installation token: installationID=%d: %w", s.installationID, err)
+	}
+
+	s.token = token.GetToken()
+	s.expiresAt = token.GetExpiresAt().Time
+	return s.token, nil
+}
+
+// NewClient はGitHub Appのインストールトークンで認証されたGitHub API Clientを作る
+// ユーザーの代わりではなく、サービスとして行う処理（全カードの更新や管理用のコマンド）に使う
+func NewClient(cfg Config) (*github.Client, error) {
+	source, err := NewInstallationTokenSource(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return github.NewClientWithTokenSource(source), nil
+}
@@ -0,0 +1,128 @@
+package githubapp
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTestTokenSource はテスト用のサーバーからインストールトークンを発行するTokenSourceを作る
+func newTestTokenSource(t *testing.T, handler http.HandlerFunc) (*InstallationTokenSource, *rsa.PrivateKey, *time.Time) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	source, err := NewInstallationTokenSource(Config{AppID: 42, InstallationID: 7, PrivateKey: privateKey})
+	if err != nil {
+		t.Fatalf("NewInstallationTokenSource() error = %v", err)
+	}
+	source.baseURL, _ = url.Parse(server.URL + "/")
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	source.now = func() time.Time { return now }
+	return source, key, &now
+}
+
+// verifyJWT はJWTの署名を検証し、クレームを返す
+func verifyJWT(t *testing.T, key *rsa.PrivateKey, token string) map[string]any {
+	t.Helper()
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("JWT has %d parts", len(parts))
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("failed to decode signature: %v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		t.Fatalf("invalid JWT signature: %v", err)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatalf("failed to decode claims: %v", err)
+	}
+	var claims map[string]any
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		t.Fatalf("failed to parse claims: %v", err)
+	}
+	return claims
+}
+
+// 期限が近づくまでは同じトークンを使い、近づいたら発行し直す
+func TestInstallationTokenSource_Token(t *testing.T) {
+	var calls atomic.Int32
+	var jwt atomic.Value
+	source, key, now := newTestTokenSource(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/app/installations/7/access_tokens" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		jwt.Store(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+
+		n := calls.Add(1)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprintf(w, `{"token": "ghs_%d", "expires_at": "2026-01-01T01:00:00Z"}`, n)
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		token, err := source.Token(ctx)
+		if err != nil {
+			t.Fatalf("Token() error = %v", err)
+		}
+		if token != "ghs_1" {
+			t.Errorf("token = %s, want ghs_1", token)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1", calls.Load())
+	}
+
+	claims := verifyJWT(t, key, jwt.Load().(string))
+	if claims["iss"] != "42" {
+		t.Errorf("iss = %v, want 42", claims["iss"])
+	}
+	if exp := int64(claims["exp"].(float64)); exp != now.Add(jwtLifetime).Unix() {
+		t.Errorf("exp = %d, want %d", exp, now.Add(jwtLifetime).Unix())
+	}
+
+	*now = now.Add(56 * time.Minute)
+	token, err := source.Token(ctx)
+	if err != nil {
+		t.Fatalf("Token() error = %v", err)
+	}
+	if token != "ghs_2" {
+		t.Errorf("renewed token = %s, want ghs_2", token)
+	}
+}
+
+func TestInstallationTokenSource_Error(t *testing.T) {
+	source, _, _ := newTestTokenSource(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message": "A JSON web token could not be decoded"}`))
+	})
+
+	if _, err := source.Token(context.Background()); err == nil {
+		t.Fatal("Token() error = nil, want error")
+	}
+}
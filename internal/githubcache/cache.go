@@ -0,0 +1,158 @@
+package githubcache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+)
+
+// revalidateTimeout は古くなったエントリを裏で取り直すときの時間の上限
+// 元のリクエストが終わっても取り直しを続けるため、リクエストのcontextとは別に期限を付ける
+const revalidateTimeout = 30 * time.Second
+
+// Cache はGitHub APIの応答をリクエストをまたいで使い回す
+// ユーザーのトークンに依存しない応答（ユーザー情報・言語・統計）だけを保存する
+type Cache struct {
+	store  Store
+	config Config
+	now    func() time.Time
+
+	mu           sync.Mutex
+	revalidating map[string]bool
+	wg           sync.WaitGroup
+}
+
+func New(store Store, cfg Config) *Cache {
+	return &Cache{
+		store:        store,
+		config:       cfg,
+		now:          time.Now,
+		revalidating: make(map[string]bool),
+	}
+}
+
+// Wrap は client の呼び出しをキャッシュするGitHub APIクライアントを返す
+func (c *Cache) Wrap(client service.GitHubClient) service.GitHubClient {
+	return &cachedClient{inner: client, cache: c}
+}
+
+// freshness はキャッシュのエントリの状態
+type freshness int
+
+const (
+	missing freshness = iota
+	fresh
+	stale
+)
+
+// fetch は key のエントリがあればそれを返し、なければ load で取得して保存する
+// 新しい期間（ttl）を過ぎたエントリは、期限が切れるまでそのまま返しつつ裏で load し直す
+func fetch[T any](ctx context.Context, c *Cache, key string, ttl time.Duration, load func(ctx context.Context) (T, error)) (T, error) {
+	value, state := lookup[T](ctx, c, key, ttl)
+	switch state {
+	case fresh:
+		return value, nil
+	case stale:
+		revalidate(ctx, c, key, ttl, load)
+		return value, nil
+	}
+
+	value, err := load(ctx)
+	if err != nil {
+		return value, err
+	}
+	c.save(ctx, key, ttl, value)
+	return value, nil
+}
+
+// lookup は key のエントリを取り出して、その状態と一緒に返す
+// 保存先の障害はキャッシュがない場合と同じように扱い、GitHub APIから取得できるようにする
+func lookup[T any](ctx context.Context, c *Cache, key string, ttl time.Duration) (T, freshness) {
+	var value T
+
+	entry, err := c.store.Get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, domain.ErrNotFound) {
+			log.Printf("Warning: failed to get GitHub cache %s: %v", key, err)
+		}
+		return value, missing
+	}
+
+	now := c.now()
+	if entry.IsExpired(now) {
+		return value, missing
+	}
+	if err := json.Unmarshal(entry.Value, &value); err != nil {
+		log.Printf("Warning: failed to decode GitHub cache %s: %v", key, err)
+		return value, missing
+	}
+
+	if now.Sub(entry.FetchedAt) > ttl {
+		return value, stale
+	}
+	return value, fresh
+}
+
+// revalidate は古くなった key のエントリを裏で取り直す
+// 同じキーの取り直しが既に動いている場合は何もしない
+func revalidate[T any](ctx context.Context, c *Cache, key string, ttl time.Duration, load func(ctx context.Context) (T, error)) {
+	c.mu.Lock()
+	if c.revalidating[key] {
+		c.mu.Unlock()
+		return
+	}
+	c.revalidating[key] = true
+	c.mu.Unlock()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer func() {
+			c.mu.Lock()
+			delete(c.revalidating, key)
+			c.mu.Unlock()
+		}()
+
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), revalidateTimeout)
+		defer cancel()
+
+		value, err := load(ctx)
+		if err != nil {
+			log.Printf("Warning: failed to revalidate GitHub cache %s: %v", key, err)
+			return
+		}
+		c.save(ctx, key, ttl, value)
+	}()
+}
+
+// save は value をJSONにして保存する
+// 期限は新しい期間に古いデータとして使う期間を足したもの
+func (c *Cache) save(ctx context.Context, key string, ttl time.Duration, value any) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		log.Printf("Warning: failed to encode GitHub cache %s: %v", key, err)
+		return
+	}
+
+	now := c.now()
+	entry := &domain.CacheEntry{
+		Key:       key,
+		Value:     data,
+		FetchedAt: now,
+		ExpiresAt: now.Add(ttl + c.config.StaleTTL),
+	}
+	if err := c.store.Set(ctx, entry); err != nil {
+		log.Printf("Warning: failed to save GitHub cache %s: %v", key, err)
+	}
+}
+
+// wait は裏で動いている取り直しが終わるまで待つ
+func (c *Cache) wait() {
+	c.wg.Wait()
+}
@@ -0,0 +1,173 @@
+package githubcache
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/github"
+	"github.com/furarico/octo-deck-api/internal/service"
+)
+
+// unknownLanguage はGitHubの言語の一括取得が失敗したときにも返す言語名
+// 一時的な失敗を保存しないように、一括取得の結果ではキャッシュしない
+const unknownLanguage = "Unknown"
+
+// statsWindowLastYear はGetUserStatsが期間を指定されなかったときに集計する期間（GitHubの既定の過去1年間）
+const statsWindowLastYear = "last-year"
+
+// statsKeyPrecision は統計のキャッシュのキーで期間の終わりを揃える単位
+// 期間の終わりは現在時刻になることが多いので、そのままキーにすると毎回別のキーになって読み出されないエントリが溜まる
+// 現在から求めた期間の始まりは、呼び出し元（StatsService）が同じ単位に揃えて渡す
+const statsKeyPrecision = time.Hour
+
+// cachedClient はGitHub APIクライアントの呼び出しをCacheに保存するデコレーター
+type cachedClient struct {
+	inner service.GitHubClient
+	cache *Cache
+}
+
+func userKey(id int64) string {
+	return "user:" + strconv.FormatInt(id, 10)
+}
+
+func languageKey(login string) string {
+	// GitHubのログイン名は大文字小文字を区別しない
+	return "language:" + strings.ToLower(login)
+}
+
+func statsKey(githubID int64, from, to time.Time) string {
+	window := statsWindowLastYear
+	if !from.IsZero() || !to.IsZero() {
+		window = from.UTC().Format(time.RFC3339) + ":" + to.UTC().Truncate(statsKeyPrecision).Format(time.RFC3339)
+	}
+	return "stats:" + strconv.FormatInt(githubID, 10) + ":" + window
+}
+
+func fullInfoKey(nodeIDs []string, from, to time.Time) string {
+	// NodeIDの数が多いとキーが長くなるのでハッシュにする。結果の順序がNodeIDの順序に依存するので並べ替えない
+	h := sha256.Sum256([]byte(strings.Join(nodeIDs, ",")))
+	return fmt.Sprintf("fullinfo:%s:%s:%s", from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339), hex.EncodeToString(h[:16]))
+}
+
+// GetAuthenticatedUser はトークンごとに結果が違うのでキャッシュせず、取得したユーザー情報だけを保存する
+func (c *cachedClient) GetAuthenticatedUser(ctx context.Context) (*github.UserInfo, error) {
+	user, err := c.inner.GetAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.cache.save(ctx, userKey(user.ID), c.cache.config.UserTTL, user)
+	return user, nil
+}
+
+func (c *cachedClient) GetUserByID(ctx context.Context, id int64) (*github.UserInfo, error) {
+	return fetch(ctx, c.cache, userKey(id), c.cache.config.UserTTL, func(ctx context.Context) (*github.UserInfo, error) {
+		return c.inner.GetUserByID(ctx, id)
+	})
+}
+
+// GetUsersByIDs はキャッシュにないユーザーだけをまとめて取得する
+func (c *cachedClient) GetUsersByIDs(ctx context.Context, ids []int64) (map[int64]*github.UserInfo, error) {
+	ttl := c.cache.config.UserTTL
+	users := make(map[int64]*github.UserInfo, len(ids))
+	var missingIDs []int64
+
+	for _, id := range ids {
+		user, state := lookup[*github.UserInfo](ctx, c.cache, userKey(id), ttl)
+		if state == missing {
+			missingIDs = append(missingIDs, id)
+			continue
+		}
+		if state == stale {
+			revalidate(ctx, c.cache, userKey(id), ttl, func(ctx context.Context) (*github.UserInfo, error) {
+				return c.inner.GetUserByID(ctx, id)
+			})
+		}
+		users[id] = user
+	}
+
+	if len(missingIDs) == 0 {
+		return users, nil
+	}
+
+	fetched, err := c.inner.GetUsersByIDs(ctx, missingIDs)
+	if err != nil {
+		// 一部でも取得できていれば部分的な結果を返す（GitHubClientの一括取得と同じ扱い）
+		if len(users) > 0 {
+			return users, nil
+		}
+		return nil, err
+	}
+	for id, user := range fetched {
+		c.cache.save(ctx, userKey(id), ttl, user)
+		users[id] = user
+	}
+
+	return users, nil
+}
+
+func (c *cachedClient) GetUserStats(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
+	return fetch(ctx, c.cache, statsKey(githubID, from, to), c.cache.config.StatsTTL, func(ctx context.Context) (*github.UserStats, error) {
+		return c.inner.GetUserStats(ctx, githubID, from, to)
+	})
+}
+
+func (c *cachedClient) GetMostUsedLanguage(ctx context.Context, login string) (github.LanguageInfo, error) {
+	return fetch(ctx, c.cache, languageKey(login), c.cache.config.LanguageTTL, func(ctx context.Context) (github.LanguageInfo, error) {
+		return c.inner.GetMostUsedLanguage(ctx, login)
+	})
+}
+
+// GetMostUsedLanguages はキャッシュにないユーザーだけをまとめて取得する
+func (c *cachedClient) GetMostUsedLanguages(ctx context.Context, logins []string) (map[string]github.LanguageInfo, error) {
+	ttl := c.cache.config.LanguageTTL
+	languages := make(map[string]github.LanguageInfo, len(logins))
+	var missingLogins []string
+
+	for _, login := range logins {
+		info, state := lookup[github.LanguageInfo](ctx, c.cache, languageKey(login), ttl)
+		if state == missing {
+			missingLogins = append(missingLogins, login)
+			continue
+		}
+		if state == stale {
+			revalidate(ctx, c.cache, languageKey(login), ttl, func(ctx context.Context) (github.LanguageInfo, error) {
+				return c.inner.GetMostUsedLanguage(ctx, login)
+			})
+		}
+		languages[login] = info
+	}
+
+	if len(missingLogins) == 0 {
+		return languages, nil
+	}
+
+	fetched, err := c.inner.GetMostUsedLanguages(ctx, missingLogins)
+	if err != nil {
+		return nil, err
+	}
+	for login, info := range fetched {
+		if info.Name != unknownLanguage {
+			c.cache.save(ctx, languageKey(login), ttl, info)
+		}
+		languages[login] = info
+	}
+
+	return languages, nil
+}
+
+// GetMyLanguages はプライベートリポジトリを含み、ユーザーをまたいで共有できないのでキャッシュしない
+func (c *cachedClient) GetMyLanguages(ctx context.Context) (github.LanguageInfo, error) {
+	return c.inner.GetMyLanguages(ctx)
+}
+
+func (c *cachedClient) GetUsersFullInfoByNodeIDs(ctx context.Context, nodeIDs []string, from, to time.Time) ([]github.UserFullInfo, error) {
+	return fetch(ctx, c.cache, fullInfoKey(nodeIDs, from, to), c.cache.config.StatsTTL, func(ctx context.Context) ([]github.UserFullInfo, error) {
+		return c.inner.GetUsersFullInfoByNodeIDs(ctx, nodeIDs, from, to)
+	})
+}
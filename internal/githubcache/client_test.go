@@ -0,0 +1,257 @@
+package githubcache
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/github"
+)
+
+// テスト用の設定
+var testConfig = Config{
+	Backend:     BackendMemory,
+	Size:        100,
+	UserTTL:     time.Minute,
+	LanguageTTL: time.Minute,
+	StatsTTL:    time.Minute,
+	StaleTTL:    time.Hour,
+}
+
+// newTestCache は時刻を進められるCacheを作る
+func newTestCache(t *testing.T) (*Cache, *time.Time) {
+	t.Helper()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewLRUStore(testConfig.Size)
+	store.now = func() time.Time { return now }
+	cache := New(store, testConfig)
+	cache.now = func() time.Time { return now }
+	return cache, &now
+}
+
+func TestCachedClient_GetUserByID(t *testing.T) {
+	cache, now := newTestCache(t)
+
+	var calls atomic.Int32
+	inner := &github.MockClient{
+		GetUserByIDFunc: func(ctx context.Context, id int64) (*github.UserInfo, error) {
+			n := calls.Add(1)
+			if n == 1 {
+				return &github.UserInfo{ID: id, Login: "octocat"}, nil
+			}
+			return &github.UserInfo{ID: id, Login: "renamed"}, nil
+		},
+	}
+	client := cache.Wrap(inner)
+	ctx := context.Background()
+
+	// 1回目はGitHubから取得し、2回目はキャッシュを返す
+	for i := 0; i < 2; i++ {
+		user, err := client.GetUserByID(ctx, 12345)
+		if err != nil {
+			t.Fatalf("GetUserByID() error = %v", err)
+		}
+		if user.Login != "octocat" {
+			t.Errorf("Login = %s, want octocat", user.Login)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("GitHub calls = %d, want 1", calls.Load())
+	}
+
+	// 新しい期間を過ぎたら古い値を返しつつ裏で取り直す
+	*now = now.Add(2 * time.Minute)
+	user, err := client.GetUserByID(ctx, 12345)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if user.Login != "octocat" {
+		t.Errorf("stale Login = %s, want octocat", user.Login)
+	}
+	cache.wait()
+	if calls.Load() != 2 {
+		t.Errorf("GitHub calls after revalidate = %d, want 2", calls.Load())
+	}
+
+	user, err = client.GetUserByID(ctx, 12345)
+	if err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if user.Login != "renamed" {
+		t.Errorf("revalidated Login = %s, want renamed", user.Login)
+	}
+
+	// 期限が切れたら取得し直すまで待つ
+	*now = now.Add(2 * time.Hour)
+	if _, err := client.GetUserByID(ctx, 12345); err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("GitHub calls after expiry = %d, want 3", calls.Load())
+	}
+}
+
+// エラーはキャッシュしない
+func TestCachedClient_DoesNotCacheErrors(t *testing.T) {
+	cache, _ := newTestCache(t)
+
+	var calls atomic.Int32
+	inner := &github.MockClient{
+		GetUserStatsFunc: func(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
+			calls.Add(1)
+			return nil, domain.ErrUpstreamUnavailable
+		},
+	}
+	client := cache.Wrap(inner)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetUserStats(context.Background(), 12345, time.Time{}, time.Time{}); !errors.Is(err, domain.ErrUpstreamUnavailable) {
+			t.Fatalf("GetUserStats() error = %v, want %v", err, domain.ErrUpstreamUnavailable)
+		}
+	}
+	if calls.Load() != 2 {
+		t.Errorf("GitHub calls = %d, want 2", calls.Load())
+	}
+}
+
+// 一括取得ではキャッシュにないものだけをGitHubから取得する
+func TestCachedClient_GetUsersByIDs(t *testing.T) {
+	cache, _ := newTestCache(t)
+
+	var requested [][]int64
+	inner := &github.MockClient{
+		GetUserByIDFunc: func(ctx context.Context, id int64) (*github.UserInfo, error) {
+			return &github.UserInfo{ID: id, Login: "single"}, nil
+		},
+		GetUsersByIDsFunc: func(ctx context.Context, ids []int64) (map[int64]*github.UserInfo, error) {
+			requested = append(requested, ids)
+			users := make(map[int64]*github.UserInfo, len(ids))
+			for _, id := range ids {
+				users[id] = &github.UserInfo{ID: id, Login: "batch"}
+			}
+			return users, nil
+		},
+	}
+	client := cache.Wrap(inner)
+	ctx := context.Background()
+
+	if _, err := client.GetUserByID(ctx, 1); err != nil {
+		t.Fatalf("GetUserByID() error = %v", err)
+	}
+
+	users, err := client.GetUsersByIDs(ctx, []int64{1, 2, 3})
+	if err != nil {
+		t.Fatalf("GetUsersByIDs() error = %v", err)
+	}
+	if len(users) != 3 {
+		t.Fatalf("len(users) = %d, want 3", len(users))
+	}
+	if users[1].Login != "single" || users[2].Login != "batch" {
+		t.Errorf("users = %+v, %+v", users[1], users[2])
+	}
+	if len(requested) != 1 || len(requested[0]) != 2 {
+		t.Errorf("requested = %v, want [[2 3]]", requested)
+	}
+
+	if _, err := client.GetUsersByIDs(ctx, []int64{1, 2, 3}); err != nil {
+		t.Fatalf("GetUsersByIDs() error = %v", err)
+	}
+	if len(requested) != 1 {
+		t.Errorf("requested again: %v", requested)
+	}
+}
+
+// 一括取得で言語が分からなかったユーザーは一時的な失敗の可能性があるのでキャッシュしない
+func TestCachedClient_GetMostUsedLanguages(t *testing.T) {
+	cache, _ := newTestCache(t)
+
+	var requested [][]string
+	inner := &github.MockClient{
+		GetMostUsedLanguagesFunc: func(ctx context.Context, logins []string) (map[string]github.LanguageInfo, error) {
+			requested = append(requested, logins)
+			return map[string]github.LanguageInfo{
+				"octocat": {Name: "Go", Color: "#00ADD8"},
+				"hubot":   {Name: "Unknown", Color: "#586069"},
+			}, nil
+		},
+	}
+	client := cache.Wrap(inner)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		languages, err := client.GetMostUsedLanguages(ctx, []string{"octocat", "hubot"})
+		if err != nil {
+			t.Fatalf("GetMostUsedLanguages() error = %v", err)
+		}
+		if languages["octocat"].Name != "Go" {
+			t.Errorf("octocat = %+v, want Go", languages["octocat"])
+		}
+	}
+	if len(requested) != 2 || len(requested[1]) != 1 || requested[1][0] != "hubot" {
+		t.Errorf("requested = %v, want [[octocat hubot] [hubot]]", requested)
+	}
+
+	// 1件ずつの取得でも同じキャッシュを使う（ログイン名の大文字小文字は区別しない）
+	info, err := client.GetMostUsedLanguage(ctx, "OctoCat")
+	if err != nil {
+		t.Fatalf("GetMostUsedLanguage() error = %v", err)
+	}
+	if info.Name != "Go" {
+		t.Errorf("name = %s, want Go", info.Name)
+	}
+}
+
+// 統計は期間ごとに別々にキャッシュする
+func TestCachedClient_GetUsersFullInfoByNodeIDs(t *testing.T) {
+	cache, _ := newTestCache(t)
+
+	var calls atomic.Int32
+	inner := &github.MockClient{
+		GetUsersFullInfoByNodeIDsFunc: func(ctx context.Context, nodeIDs []string, from, to time.Time) ([]github.UserFullInfo, error) {
+			calls.Add(1)
+			return []github.UserFullInfo{{Login: "octocat", Total: 10}}, nil
+		},
+	}
+	client := cache.Wrap(inner)
+	ctx := context.Background()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	for i := 0; i < 2; i++ {
+		infos, err := client.GetUsersFullInfoByNodeIDs(ctx, []string{"U_1"}, from, to)
+		if err != nil {
+			t.Fatalf("GetUsersFullInfoByNodeIDs() error = %v", err)
+		}
+		if len(infos) != 1 || infos[0].Total != 10 {
+			t.Errorf("infos = %+v", infos)
+		}
+	}
+	if _, err := client.GetUsersFullInfoByNodeIDs(ctx, []string{"U_1"}, from, to.AddDate(0, 1, 0)); err != nil {
+		t.Fatalf("GetUsersFullInfoByNodeIDs() error = %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("GitHub calls = %d, want 2", calls.Load())
+	}
+}
+
+// 統計のキャッシュのキーは期間の終わりを1時間単位に揃えることをテスト
+func TestStatsKey(t *testing.T) {
+	from := time.Date(2025, 5, 10, 9, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 6, 1, 12, 0, 5, 0, time.UTC)
+
+	if statsKey(1, time.Time{}, time.Time{}) != "stats:1:"+statsWindowLastYear {
+		t.Errorf("期間を指定しない場合のキーが違う: %s", statsKey(1, time.Time{}, time.Time{}))
+	}
+	if statsKey(1, from, to) != statsKey(1, from, to.Add(30*time.Minute)) {
+		t.Errorf("同じ時間帯の終わりのキーが違う")
+	}
+	if statsKey(1, from, to) == statsKey(1, from, to.Add(time.Hour)) {
+		t.Errorf("別の時間帯の終わりのキーが同じ")
+	}
+	if statsKey(1, from, to) == statsKey(1, from.Add(time.Minute), to) {
+		t.Errorf("始まりが違うキーが同じ")
+	}
+}
@@ -0,0 +1,91 @@
+package githubcache
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/config"
+)
+
+// Backend はキャッシュの保存先の種類
+type Backend string
+
+const (
+	// BackendMemory はインスタンスのメモリ（LRU）だけに保存する
+	BackendMemory Backend = "memory"
+	// BackendPostgres はメモリ（LRU）に加えてPostgreSQLにも保存し、インスタンス間で共有する
+	BackendPostgres Backend = "postgres"
+	// BackendNone はキャッシュしない
+	BackendNone Backend = "none"
+)
+
+// Config はGitHub APIの応答のキャッシュの設定
+type Config struct {
+	Backend Backend
+	// Size はメモリに保存するエントリの最大数
+	Size int
+
+	// 新しいデータとして使う期間（メソッドごと）
+	UserTTL     time.Duration
+	LanguageTTL time.Duration
+	StatsTTL    time.Duration
+	// StaleTTL は新しいデータとして使う期間を過ぎた後、裏で取り直しながら古いデータを返す期間
+	StaleTTL time.Duration
+}
+
+// LoadConfig は環境変数からキャッシュの設定を読み込む
+func LoadConfig() (Config, error) {
+	cfg := Config{
+		Backend:     BackendMemory,
+		Size:        10000,
+		UserTTL:     time.Hour,
+		LanguageTTL: 6 * time.Hour,
+		StatsTTL:    15 * time.Minute,
+		StaleTTL:    24 * time.Hour,
+	}
+
+	if v := os.Getenv("GITHUB_CACHE_BACKEND"); v != "" {
+		cfg.Backend = Backend(v)
+	}
+
+	var err error
+	if cfg.Size, err = config.Int("GITHUB_CACHE_SIZE", cfg.Size); err != nil {
+		return Config{}, err
+	}
+	if cfg.UserTTL, err = config.Duration("GITHUB_CACHE_USER_TTL", cfg.UserTTL); err != nil {
+		return Config{}, err
+	}
+	if cfg.LanguageTTL, err = config.Duration("GITHUB_CACHE_LANGUAGE_TTL", cfg.LanguageTTL); err != nil {
+		return Config{}, err
+	}
+	if cfg.StatsTTL, err = config.Duration("GITHUB_CACHE_STATS_TTL", cfg.StatsTTL); err != nil {
+		return Config{}, err
+	}
+	if cfg.StaleTTL, err = config.Duration("GITHUB_CACHE_STALE_TTL", cfg.StaleTTL); err != nil {
+		return Config{}, err
+	}
+
+	if err := cfg.validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+func (c Config) validate() error {
+	switch c.Backend {
+	case BackendMemory, BackendPostgres, BackendNone:
+	default:
+		return fmt.Errorf("GITHUB_CACHE_BACKEND must be one of %q, %q or %q", BackendMemory, BackendPostgres, BackendNone)
+	}
+
+	if c.Size <= 0 {
+		return fmt.Errorf("GITHUB_CACHE_SIZE must be positive")
+	}
+	if c.UserTTL < 0 || c.LanguageTTL < 0 || c.StatsTTL < 0 || c.StaleTTL < 0 {
+		return fmt.Errorf("GitHub cache TTLs must not be negative")
+	}
+
+	return nil
+}
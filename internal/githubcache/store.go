@@ -0,0 +1,139 @@
+package githubcache
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// Store はキャッシュの保存先
+// Get はエントリが見つからない場合と期限が切れている場合に domain.ErrNotFound を返す
+type Store interface {
+	Get(ctx context.Context, key string) (*domain.CacheEntry, error)
+	Set(ctx context.Context, entry *domain.CacheEntry) error
+}
+
+// LRUStore はインスタンスのメモリに保存するStore
+// 最大数を超えた場合は最も長く使われていないエントリから捨てる
+type LRUStore struct {
+	mu    sync.Mutex
+	size  int
+	order *list.List
+	items map[string]*list.Element
+	now   func() time.Time
+}
+
+func NewLRUStore(size int) *LRUStore {
+	return &LRUStore{
+		size:  size,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+		now:   time.Now,
+	}
+}
+
+func (s *LRUStore) Get(ctx context.Context, key string) (*domain.CacheEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.items[key]
+	if !ok {
+		return nil, fmt.Errorf("cache entry not found: key=%s: %w", key, domain.ErrNotFound)
+	}
+
+	entry := elem.Value.(*domain.CacheEntry)
+	if entry.IsExpired(s.now()) {
+		s.order.Remove(elem)
+		delete(s.items, key)
+		return nil, fmt.Errorf("cache entry expired: key=%s: %w", key, domain.ErrNotFound)
+	}
+
+	s.order.MoveToFront(elem)
+	copied := *entry
+	return &copied, nil
+}
+
+func (s *LRUStore) Set(ctx context.Context, entry *domain.CacheEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	copied := *entry
+	if elem, ok := s.items[entry.Key]; ok {
+		elem.Value = &copied
+		s.order.MoveToFront(elem)
+		return nil
+	}
+
+	s.items[entry.Key] = s.order.PushFront(&copied)
+	for s.order.Len() > s.size {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(*domain.CacheEntry).Key)
+	}
+
+	return nil
+}
+
+// Delete はエントリを捨てる。エントリがなくてもエラーにしない
+func (s *LRUStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.items[key]; ok {
+		s.order.Remove(elem)
+		delete(s.items, key)
+	}
+	return nil
+}
+
+// TieredStore は前の Store から順に探し、後ろの Store で見つかったエントリは前の Store にも保存する
+// メモリの前にPostgreSQLを置くと、インスタンス間でキャッシュを共有しつつDBへの問い合わせを減らせる
+type TieredStore []Store
+
+func (s TieredStore) Get(ctx context.Context, key string) (*domain.CacheEntry, error) {
+	for i, store := range s {
+		entry, err := store.Get(ctx, key)
+		if errors.Is(err, domain.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, front := range s[:i] {
+			if err := front.Set(ctx, entry); err != nil {
+				return nil, err
+			}
+		}
+		return entry, nil
+	}
+
+	return nil, fmt.Errorf("cache entry not found: key=%s: %w", key, domain.ErrNotFound)
+}
+
+func (s TieredStore) Set(ctx context.Context, entry *domain.CacheEntry) error {
+	for _, store := range s {
+		if err := store.Set(ctx, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewStore は設定に合わせた保存先を作る
+// BackendPostgres の場合はメモリの後ろに shared（PostgreSQL）を置く。BackendNone の場合はnilを返す
+func NewStore(cfg Config, shared Store) Store {
+	switch cfg.Backend {
+	case BackendMemory:
+		return NewLRUStore(cfg.Size)
+	case BackendPostgres:
+		return TieredStore{NewLRUStore(cfg.Size), shared}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,67 @@
+package githubcache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+func newTestEntry(key string, expiresAt time.Time) *domain.CacheEntry {
+	return &domain.CacheEntry{Key: key, Value: []byte(`{}`), ExpiresAt: expiresAt}
+}
+
+// 最大数を超えたら最も長く使われていないエントリから捨てる
+func TestLRUStore_Evict(t *testing.T) {
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour)
+	store := NewLRUStore(2)
+
+	_ = store.Set(ctx, newTestEntry("a", expiresAt))
+	_ = store.Set(ctx, newTestEntry("b", expiresAt))
+	if _, err := store.Get(ctx, "a"); err != nil {
+		t.Fatalf("Get(a) error = %v", err)
+	}
+	_ = store.Set(ctx, newTestEntry("c", expiresAt))
+
+	if _, err := store.Get(ctx, "b"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Get(b) error = %v, want %v", err, domain.ErrNotFound)
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, err := store.Get(ctx, key); err != nil {
+			t.Errorf("Get(%s) error = %v", key, err)
+		}
+	}
+}
+
+func TestLRUStore_Expired(t *testing.T) {
+	ctx := context.Background()
+	store := NewLRUStore(2)
+
+	_ = store.Set(ctx, newTestEntry("a", time.Now().Add(-time.Second)))
+	if _, err := store.Get(ctx, "a"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Get(a) error = %v, want %v", err, domain.ErrNotFound)
+	}
+}
+
+// 後ろのStoreで見つかったエントリは前のStoreにも保存する
+func TestTieredStore(t *testing.T) {
+	ctx := context.Background()
+	front := NewLRUStore(10)
+	back := NewLRUStore(10)
+	store := TieredStore{front, back}
+
+	_ = back.Set(ctx, newTestEntry("a", time.Now().Add(time.Hour)))
+	if _, err := store.Get(ctx, "a"); err != nil {
+		t.Fatalf("Get(a) error = %v", err)
+	}
+	if _, err := front.Get(ctx, "a"); err != nil {
+		t.Errorf("front.Get(a) error = %v", err)
+	}
+
+	if _, err := store.Get(ctx, "missing"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Get(missing) error = %v, want %v", err, domain.ErrNotFound)
+	}
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// カード交換を受け取る
+// (POST /exchanges/{token}/accept)
+func (h *Handler) AcceptExchange(ctx context.Context, request api.AcceptExchangeRequestObject) (api.AcceptExchangeResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	var detail domain.CollectDetail
+	if request.Body != nil {
+		if request.Body.Source != nil {
+			detail.Source = domain.CollectSource(*request.Body.Source)
+		}
+		if request.Body.Note != nil {
+			detail.Note = *request.Body.Note
+		}
+		detail.CommunityID, err = parseOptionalCommunityID(request.Body.CommunityId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	exchange, err := h.cardService.AcceptExchange(ctx, request.Token, githubID, detail)
+	if err != nil {
+		return nil, fmt.Errorf("failed to accept exchange: %w", err)
+	}
+
+	return api.AcceptExchange200JSONResponse{Exchange: convertCardExchangeToAPI(*exchange)}, nil
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// カード交換の受け取りのテスト
+func TestAcceptExchange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	communityID := domain.NewCommunityID()
+
+	tests := []struct {
+		name      string
+		body      string
+		setupMock func() *service.MockCardService
+		wantCode  int
+		validate  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "集めたときの状況を指定して受け取れる",
+			body: fmt.Sprintf(`{"source": "nfc", "communityId": %q, "note": "hello"}`, communityID),
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					AcceptExchangeFunc: func(ctx context.Context, token string, githubID string, detail domain.CollectDetail) (*domain.CardExchange, error) {
+						if token != "token-1" || githubID != "test_user" {
+							return nil, fmt.Errorf("unexpected args: token=%s githubID=%s", token, githubID)
+						}
+						if detail.Source != domain.CollectSourceNFC || detail.Note != "hello" {
+							return nil, fmt.Errorf("unexpected detail: %+v", detail)
+						}
+						if detail.CommunityID == nil || *detail.CommunityID != communityID {
+							return nil, fmt.Errorf("unexpected community id: %v", detail.CommunityID)
+						}
+						return newTestCardExchange(token, domain.CardExchangeStatusAccepted), nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Exchange api.CardExchange `json:"exchange"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if response.Exchange.Status != "accepted" {
+					t.Errorf("statusが違う: 期待=accepted, 実際=%s", response.Exchange.Status)
+				}
+			},
+		},
+		{
+			name: "コミュニティIDが不正な場合",
+			body: `{"communityId": "not-a-uuid"}`,
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					AcceptExchangeFunc: func(ctx context.Context, token string, githubID string, detail domain.CollectDetail) (*domain.CardExchange, error) {
+						t.Errorf("AcceptExchangeが呼ばれるべきではない")
+						return nil, nil
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+		{
+			name: "受け取り済みの申し出の場合",
+			body: `{}`,
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					AcceptExchangeFunc: func(ctx context.Context, token string, githubID string, detail domain.CollectDetail) (*domain.CardExchange, error) {
+						return nil, fmt.Errorf("%w: exchange has already been accepted", domain.ErrAlreadyExists)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardHandler := NewCardHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/exchanges/token-1/accept", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
@@ -5,10 +5,11 @@ import (
 	"fmt"
 
 	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
 	"github.com/google/uuid"
 )
 
-// 指定したコミュニティに自分のカードを追加
+// 指定したコミュニティにユーザーのカードを追加
 // (POST /communities/{id}/cards)
 func (h *Handler) AddCardToCommunity(ctx context.Context, request api.AddCardToCommunityRequestObject) (api.AddCardToCommunityResponseObject, error) {
 	githubClient, err := getGitHubClient(ctx)
@@ -21,8 +22,17 @@ func (h *Handler) AddCardToCommunity(ctx context.Context, request api.AddCardToC
 		return nil, fmt.Errorf("unauthorized: %w", err)
 	}
 
-	// github_idから自分のカードを取得
-	card, err := h.cardService.GetMyCard(ctx, githubID, githubClient)
+	// 管理者以上のみ直接カードを追加できる。それ以外のユーザーは招待コードで参加する
+	if err := h.communityService.AuthorizeMember(ctx, request.Id, githubID, domain.CommunityRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	if request.Body == nil || request.Body.GithubId == "" {
+		return nil, fmt.Errorf("%w: githubId is required", domain.ErrInvalidArgument)
+	}
+
+	// 追加するユーザーのカードを取得
+	card, err := h.cardService.GetCardByGitHubID(ctx, request.Body.GithubId, githubClient)
 	if err != nil {
 		return nil, fmt.Errorf("card not found: %w", err)
 	}
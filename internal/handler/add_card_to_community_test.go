@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -12,39 +13,60 @@ import (
 	"github.com/furarico/octo-deck-api/internal/domain"
 	"github.com/furarico/octo-deck-api/internal/service"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 // コミュニティにカードを追加するテスト
 func TestAddCardToCommunity(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
+	memberCard := &domain.Card{
+		ID:       domain.NewCardID(),
+		GithubID: "member_user",
+		UserName: "member_user",
+		FullName: "Member User",
+		IconUrl:  "https://example.com/member.png",
+		Color:    domain.Color("#000000"),
+		Blocks:   domain.Blocks{},
+	}
+
 	tests := []struct {
 		name               string
+		body               string
 		setupCardMock      func() *service.MockCardService
 		setupCommunityMock func() *service.MockCommunityService
 		wantCode           int
 		validate           func(t *testing.T, w *httptest.ResponseRecorder)
 	}{
 		{
-			name: "正常にコミュニティにカードを追加できる",
+			name: "管理者が指定したユーザーのカードをコミュニティに追加できる",
+			body: `{"githubId": "member_user"}`,
 			setupCardMock: func() *service.MockCardService {
 				return &service.MockCardService{
+					GetCardByGitHubIDFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
+						if githubID != "member_user" {
+							return nil, fmt.Errorf("card not found: githubID=%s: %w", githubID, domain.ErrNotFound)
+						}
+						return memberCard, nil
+					},
 					GetMyCardFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
-						return &domain.Card{
-							ID:       domain.NewCardID(),
-							GithubID: "test_user",
-							UserName: "test_user",
-							FullName: "Test User",
-							IconUrl:  "https://example.com/test.png",
-							Color:    domain.Color("#000000"),
-							Blocks:   domain.Blocks{},
-						}, nil
+						t.Errorf("自分のカードは取得しない")
+						return nil, fmt.Errorf("unexpected call")
 					},
 				}
 			},
 			setupCommunityMock: func() *service.MockCommunityService {
 				return &service.MockCommunityService{
+					AuthorizeMemberFunc: func(ctx context.Context, communityID string, githubID string, required domain.CommunityRole) error {
+						if githubID != "test_user" || required != domain.CommunityRoleAdmin {
+							return fmt.Errorf("%w: not an admin", domain.ErrForbidden)
+						}
+						return nil
+					},
 					AddCardToCommunityFunc: func(ctx context.Context, communityID string, cardID string) error {
+						if cardID != uuid.UUID(memberCard.ID).String() {
+							t.Errorf("追加したカードが違う: 期待=%s, 実際=%s", uuid.UUID(memberCard.ID).String(), cardID)
+						}
 						return nil
 					},
 				}
@@ -58,40 +80,71 @@ func TestAddCardToCommunity(t *testing.T) {
 				if err != nil {
 					t.Errorf("JSONパースに失敗しました: %v", err)
 				}
-				if response.Card.GithubId != "test_user" {
-					t.Errorf("GithubIDが違う: 期待=test_user, 実際=%s", response.Card.GithubId)
+				if response.Card.GithubId != "member_user" {
+					t.Errorf("GithubIDが違う: 期待=member_user, 実際=%s", response.Card.GithubId)
 				}
 			},
 		},
+		{
+			name: "GitHub IDが指定されていない場合",
+			body: `{}`,
+			setupCardMock: func() *service.MockCardService {
+				return &service.MockCardService{}
+			},
+			setupCommunityMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					AddCardToCommunityFunc: func(ctx context.Context, communityID string, cardID string) error {
+						t.Errorf("GitHub IDがない場合はカードを追加しない")
+						return nil
+					},
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			validate: nil,
+		},
 		{
 			name: "カードが見つからない場合",
+			body: `{"githubId": "unknown_user"}`,
 			setupCardMock: func() *service.MockCardService {
 				return &service.MockCardService{
-					GetMyCardFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
-						return nil, fmt.Errorf("card not found")
+					GetCardByGitHubIDFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
+						return nil, fmt.Errorf("card not found: githubID=%s: %w", githubID, domain.ErrNotFound)
 					},
 				}
 			},
 			setupCommunityMock: func() *service.MockCommunityService {
 				return &service.MockCommunityService{}
 			},
-			wantCode: http.StatusInternalServerError,
+			wantCode: http.StatusNotFound,
+			validate: nil,
+		},
+		{
+			name: "既にコミュニティに参加している場合",
+			body: `{"githubId": "member_user"}`,
+			setupCardMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					GetCardByGitHubIDFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
+						return memberCard, nil
+					},
+				}
+			},
+			setupCommunityMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					AddCardToCommunityFunc: func(ctx context.Context, communityID string, cardID string) error {
+						return fmt.Errorf("card already in community: %w", domain.ErrAlreadyExists)
+					},
+				}
+			},
+			wantCode: http.StatusConflict,
 			validate: nil,
 		},
 		{
 			name: "コミュニティへのカード追加でエラーが発生した場合",
+			body: `{"githubId": "member_user"}`,
 			setupCardMock: func() *service.MockCardService {
 				return &service.MockCardService{
-					GetMyCardFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
-						return &domain.Card{
-							ID:       domain.NewCardID(),
-							GithubID: "test_user",
-							UserName: "test_user",
-							FullName: "Test User",
-							IconUrl:  "https://example.com/test.png",
-							Color:    domain.Color("#000000"),
-							Blocks:   domain.Blocks{},
-						}, nil
+					GetCardByGitHubIDFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
+						return memberCard, nil
 					},
 				}
 			},
@@ -105,6 +158,34 @@ func TestAddCardToCommunity(t *testing.T) {
 			wantCode: http.StatusInternalServerError,
 			validate: nil,
 		},
+		{
+			name: "コミュニティの管理者でない場合",
+			body: `{"githubId": "member_user"}`,
+			setupCardMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					GetCardByGitHubIDFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
+						t.Errorf("権限がない場合はカードを取得しない")
+						return nil, fmt.Errorf("unexpected call")
+					},
+				}
+			},
+			setupCommunityMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					AuthorizeMemberFunc: func(ctx context.Context, communityID string, githubID string, required domain.CommunityRole) error {
+						if required != domain.CommunityRoleAdmin {
+							t.Errorf("必要な役割が違う: 期待=admin, 実際=%s", required)
+						}
+						return fmt.Errorf("%w: not a member of community: id=%s", domain.ErrForbidden, communityID)
+					},
+					AddCardToCommunityFunc: func(ctx context.Context, communityID string, cardID string) error {
+						t.Errorf("権限がない場合はカードを追加しない")
+						return nil
+					},
+				}
+			},
+			wantCode: http.StatusForbidden,
+			validate: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -115,11 +196,12 @@ func TestAddCardToCommunity(t *testing.T) {
 			handler := NewHandler(mockCardService, mockCommunityService, nil)
 			router := gin.Default()
 			router.Use(setTestContext)
-			strictHandler := api.NewStrictHandler(handler, nil)
+			strictHandler := api.NewStrictHandler(handler, []api.StrictMiddlewareFunc{ErrorHandlerMiddleware})
 			api.RegisterHandlers(router, strictHandler)
 
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequest("POST", "/communities/test-id/cards", nil)
+			req, _ := http.NewRequest("POST", "/communities/test-id/cards", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
 			router.ServeHTTP(w, req)
 
 			if w.Code != tt.wantCode {
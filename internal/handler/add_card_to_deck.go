@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/google/uuid"
 )
 
 // カードをデッキに追加
@@ -20,16 +22,55 @@ func (h *Handler) AddCardToDeck(ctx context.Context, request api.AddCardToDeckRe
 		return nil, fmt.Errorf("unauthorized: %w", err)
 	}
 
-	// リクエストボディから追加対象のGitHub IDを取得
-	if request.Body == nil {
-		return nil, fmt.Errorf("request body is required")
+	// 相手から受け取った署名付きペイロードと集めたときの状況を取得
+	payload, detail, err := parseAddCardToDeckRequest(request)
+	if err != nil {
+		return nil, err
 	}
-	targetGithubID := string(*request.Body)
 
-	card, err := h.cardService.AddCardToDeck(ctx, collectorGithubID, targetGithubID, githubClient)
+	card, err := h.cardService.AddCardToDeck(ctx, collectorGithubID, payload, detail, githubClient)
 	if err != nil {
 		return nil, fmt.Errorf("failed to add card to deck: %w", err)
 	}
 
 	return api.AddCardToDeck200JSONResponse{Card: convertCardToAPI(*card)}, nil
 }
+
+// parseAddCardToDeckRequest はリクエストボディからカードの共有ペイロードを、クエリパラメータから集めたときの状況を読み取る
+func parseAddCardToDeckRequest(request api.AddCardToDeckRequestObject) (string, domain.CollectDetail, error) {
+	if request.Body == nil || *request.Body == "" {
+		return "", domain.CollectDetail{}, fmt.Errorf("%w: request body is required", domain.ErrInvalidArgument)
+	}
+	payload := string(*request.Body)
+
+	var detail domain.CollectDetail
+	params := request.Params
+	if params.Source != nil {
+		detail.Source = domain.CollectSource(*params.Source)
+	}
+	if params.Note != nil {
+		detail.Note = *params.Note
+	}
+	communityID, err := parseOptionalCommunityID(params.CommunityId)
+	if err != nil {
+		return "", domain.CollectDetail{}, err
+	}
+	detail.CommunityID = communityID
+
+	return payload, detail, nil
+}
+
+// parseOptionalCommunityID はカードを交換したコミュニティのIDを読み取る
+// 指定されていない場合はnilを返す
+func parseOptionalCommunityID(s *string) (*domain.CommunityID, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+
+	communityID, err := uuid.Parse(*s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid community id: %w", domain.ErrInvalidArgument, err)
+	}
+	id := domain.CommunityID(communityID)
+	return &id, nil
+}
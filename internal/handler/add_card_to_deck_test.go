@@ -22,6 +22,7 @@ func TestAddCardToDeck(t *testing.T) {
 	tests := []struct {
 		name      string
 		setupMock func() *service.MockCardService
+		query     string
 		body      string
 		wantCode  int
 		validate  func(t *testing.T, w *httptest.ResponseRecorder)
@@ -30,10 +31,13 @@ func TestAddCardToDeck(t *testing.T) {
 			name: "正常にカードをデッキに追加できる",
 			setupMock: func() *service.MockCardService {
 				return &service.MockCardService{
-					AddCardToDeckFunc: func(ctx context.Context, collectorGithubID string, targetGithubID string, githubClient service.GitHubClient) (*domain.Card, error) {
+					AddCardToDeckFunc: func(ctx context.Context, collectorGithubID string, payload string, detail domain.CollectDetail, githubClient service.GitHubClient) (*domain.Card, error) {
+						if payload != "v1.body.signature" {
+							return nil, fmt.Errorf("unexpected payload: %s", payload)
+						}
 						return &domain.Card{
 							ID:       domain.NewCardID(),
-							GithubID: targetGithubID,
+							GithubID: "target_user",
 							UserName: "target_user",
 							FullName: "Target User",
 							IconUrl:  "https://example.com/target.png",
@@ -43,7 +47,7 @@ func TestAddCardToDeck(t *testing.T) {
 					},
 				}
 			},
-			body:     `"target_user"`,
+			body:     "v1.body.signature",
 			wantCode: http.StatusOK,
 			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
 				var response struct {
@@ -59,6 +63,34 @@ func TestAddCardToDeck(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:  "クエリパラメータで集めたときの状況を渡せる",
+			query: "?source=qr&communityId=0b7a3c1e-5f0e-4f5e-9d8a-2f3a4b5c6d7e&note=met%20at%20meetup",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					AddCardToDeckFunc: func(ctx context.Context, collectorGithubID string, payload string, detail domain.CollectDetail, githubClient service.GitHubClient) (*domain.Card, error) {
+						if detail.Source != domain.CollectSourceQR || detail.Note != "met at meetup" {
+							return nil, fmt.Errorf("unexpected detail: %+v", detail)
+						}
+						if detail.CommunityID == nil || detail.CommunityID.String() != "0b7a3c1e-5f0e-4f5e-9d8a-2f3a4b5c6d7e" {
+							return nil, fmt.Errorf("unexpected community id: %v", detail.CommunityID)
+						}
+						return &domain.Card{ID: domain.NewCardID(), GithubID: "target_user"}, nil
+					},
+				}
+			},
+			body:     "v1.body.signature",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:  "コミュニティIDが不正な場合",
+			query: "?communityId=invalid",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{}
+			},
+			body:     "v1.body.signature",
+			wantCode: http.StatusInternalServerError,
+		},
 		{
 			name: "リクエストボディが空の場合",
 			setupMock: func() *service.MockCardService {
@@ -72,12 +104,12 @@ func TestAddCardToDeck(t *testing.T) {
 			name: "カードの追加に失敗した場合",
 			setupMock: func() *service.MockCardService {
 				return &service.MockCardService{
-					AddCardToDeckFunc: func(ctx context.Context, collectorGithubID string, targetGithubID string, githubClient service.GitHubClient) (*domain.Card, error) {
+					AddCardToDeckFunc: func(ctx context.Context, collectorGithubID string, payload string, detail domain.CollectDetail, githubClient service.GitHubClient) (*domain.Card, error) {
 						return nil, fmt.Errorf("card not found")
 					},
 				}
 			},
-			body:     `"target_user"`,
+			body:     "v1.body.signature",
 			wantCode: http.StatusInternalServerError,
 			validate: nil,
 		},
@@ -96,7 +128,7 @@ func TestAddCardToDeck(t *testing.T) {
 			w := httptest.NewRecorder()
 			var req *http.Request
 			if tt.body != "" {
-				req, _ = http.NewRequest("POST", "/cards", bytes.NewBufferString(tt.body))
+				req, _ = http.NewRequest("POST", "/cards"+tt.query, bytes.NewBufferString(tt.body))
 				req.Header.Set("Content-Type", "application/json")
 			} else {
 				req, _ = http.NewRequest("POST", "/cards", nil)
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"strings"
+
 	api "github.com/furarico/octo-deck-api/generated"
 	"github.com/furarico/octo-deck-api/internal/domain"
 	"github.com/google/uuid"
@@ -22,17 +24,93 @@ func convertCardToAPI(card domain.Card) api.Card {
 			Name:  card.MostUsedLanguage.LanguageName,
 			Color: card.MostUsedLanguage.Color,
 		},
+		TopLanguages: convertLanguageBreakdownToAPI(card.TopLanguages),
+	}
+}
+
+// 言語の内訳をAPIのLanguageBreakdown型に変換する
+func convertLanguageBreakdownToAPI(breakdown []domain.LanguageBreakdown) []api.LanguageBreakdown {
+	result := make([]api.LanguageBreakdown, len(breakdown))
+	for i, b := range breakdown {
+		result[i] = api.LanguageBreakdown{
+			Name:       b.LanguageName,
+			Bytes:      int64(b.Bytes),
+			Percentage: b.Percentage,
+			Color:      b.Color,
+		}
+	}
+	return result
+}
+
+// APIのCardSettings型に変換する
+func convertCardSettingsToAPI(settings domain.CardSettings) api.CardSettings {
+	return api.CardSettings{
+		IncludePrivateRepositories: settings.IncludePrivateRepositories,
+	}
+}
+
+// APIのCollectedCard型に変換する
+func convertCollectedCardToAPI(collectedCard domain.CollectedCard) api.CollectedCard {
+	var card api.Card
+	if collectedCard.Card != nil {
+		card = convertCardToAPI(*collectedCard.Card)
+	}
+
+	result := api.CollectedCard{
+		GithubId:         card.GithubId,
+		UserName:         card.UserName,
+		FullName:         card.FullName,
+		IconUrl:          card.IconUrl,
+		Identicon:        card.Identicon,
+		MostUsedLanguage: card.MostUsedLanguage,
+		TopLanguages:     card.TopLanguages,
+		CollectedAt:      collectedCard.CollectedAt,
+		Source:           api.CollectSource(collectedCard.Source),
+	}
+	if collectedCard.CommunityID != nil {
+		communityID := collectedCard.CommunityID.String()
+		result.CommunityId = &communityID
+	}
+	if collectedCard.Note != "" {
+		result.Note = &collectedCard.Note
+	}
+	return result
+}
+
+// APIのCardShare型に変換する
+func convertCardShareToAPI(cardShare domain.CardShare) api.CardShare {
+	return api.CardShare{
+		Payload:   cardShare.Payload,
+		ExpiresAt: cardShare.ExpiresAt,
+	}
+}
+
+// APIのCardExchange型に変換する
+func convertCardExchangeToAPI(exchange domain.CardExchange) api.CardExchange {
+	result := api.CardExchange{
+		Token:      exchange.Token,
+		Status:     string(exchange.Status),
+		ExpiresAt:  exchange.ExpiresAt,
+		AcceptedAt: exchange.AcceptedAt,
+		CreatedAt:  exchange.CreatedAt,
+	}
+	if exchange.OfferedCard != nil {
+		result.OfferedCard = convertCardToAPI(*exchange.OfferedCard)
 	}
+	if exchange.AcceptedCard != nil {
+		acceptedCard := convertCardToAPI(*exchange.AcceptedCard)
+		result.AcceptedCard = &acceptedCard
+	}
+	return result
 }
 
 // ドメインのBlocks型をAPIのBlocks型に変換する
+// 一辺の数はIdenticonのバージョンによって変わるので、そのままの大きさで変換する
 func convertBlocks(blocks domain.Blocks) [][]bool {
-	blocksArray := make([][]bool, 5)
+	blocksArray := make([][]bool, len(blocks))
 	for i := range blocksArray {
-		blocksArray[i] = make([]bool, 5)
-		for j := range blocksArray[i] {
-			blocksArray[i][j] = blocks[i][j]
-		}
+		blocksArray[i] = make([]bool, len(blocks[i]))
+		copy(blocksArray[i], blocks[i])
 	}
 	return blocksArray
 }
@@ -40,8 +118,31 @@ func convertBlocks(blocks domain.Blocks) [][]bool {
 // APIのCommunity型に変換する
 func convertCommunityToAPI(community domain.Community) api.Community {
 	return api.Community{
-		Id:   uuid.UUID(community.ID).String(),
-		Name: community.Name,
+		Id:            uuid.UUID(community.ID).String(),
+		Name:          community.Name,
+		OwnerGithubId: community.OwnerGithubID,
+		StartDateTime: community.StartedAt,
+		EndDateTime:   community.EndedAt,
+	}
+}
+
+// APIのCommunityInvite型に変換する
+func convertCommunityInviteToAPI(invite domain.CommunityInvite) api.CommunityInvite {
+	return api.CommunityInvite{
+		Code:        invite.Code,
+		CommunityId: uuid.UUID(invite.CommunityID).String(),
+		SingleUse:   invite.SingleUse,
+		UsedCount:   invite.UsedCount,
+		ExpiresAt:   invite.ExpiresAt,
+		CreatedAt:   invite.CreatedAt,
+	}
+}
+
+// APIのCommunityMember型に変換する
+func convertCommunityMemberToAPI(member domain.CommunityMember) api.CommunityMember {
+	return api.CommunityMember{
+		Card: convertCardToAPI(member.Card),
+		Role: string(member.Role),
 	}
 }
 
@@ -68,9 +169,79 @@ func convertUserStatsToAPI(stats *domain.Stats) (api.UserStats, error) {
 			Name:  stats.MostUsedLanguage.LanguageName,
 			Color: stats.MostUsedLanguage.Color,
 		},
+		TopLanguages: convertLanguageBreakdownToAPI(stats.TopLanguages),
+		From:         stats.From,
+		To:           stats.To,
+		Summary:      convertStatsSummaryToAPI(stats.Summary),
 	}, nil
 }
 
+// StatsSummaryをAPIのUserStatsSummary型に変換する
+func convertStatsSummaryToAPI(summary domain.StatsSummary) api.UserStatsSummary {
+	result := api.UserStatsSummary{
+		CurrentStreak: int32(summary.CurrentStreak),
+		LongestStreak: int32(summary.LongestStreak),
+		Weekly:        convertContributionAggregatesToAPI(summary.Weekly),
+		Monthly:       convertContributionAggregatesToAPI(summary.Monthly),
+		Percentile:    summary.Percentile,
+		PeerCount:     int32(summary.PeerCount),
+		Timezone:      summary.Timezone,
+	}
+	if summary.MostActiveWeekday != nil {
+		weekday := api.UserStatsSummaryMostActiveWeekday(strings.ToLower(summary.MostActiveWeekday.String()))
+		result.MostActiveWeekday = &weekday
+	}
+	if summary.MostActiveHourBucket != nil {
+		hour := int32(*summary.MostActiveHourBucket)
+		result.MostActiveHourBucket = &hour
+	}
+	return result
+}
+
+func convertContributionAggregatesToAPI(aggregates []domain.ContributionAggregate) []api.ContributionAggregate {
+	result := make([]api.ContributionAggregate, len(aggregates))
+	for i, a := range aggregates {
+		result[i] = api.ContributionAggregate{
+			Start: types.Date{Time: a.Start},
+			Count: int32(a.Count),
+		}
+	}
+	return result
+}
+
+// ContributionHistoryをAPIのContributionHistory型に変換する
+func convertContributionHistoryToAPI(history *domain.ContributionHistory) api.ContributionHistory {
+	contributions := make([]api.Contribution, len(history.Contributions))
+	for i, c := range history.Contributions {
+		contributions[i] = api.Contribution{
+			Date:  types.Date{Time: c.Date},
+			Count: int32(c.Count),
+		}
+	}
+
+	result := api.ContributionHistory{
+		From:              types.Date{Time: history.From},
+		To:                types.Date{Time: history.To},
+		Contributions:     contributions,
+		TotalContribution: int32(history.TotalContribution),
+	}
+	if detail := history.Detail; detail != nil {
+		result.ContributionDetail = &api.ContributionDetailSnapshot{
+			From:              detail.From,
+			To:                detail.To,
+			TotalContribution: int32(detail.TotalContribution),
+			ContributionDetail: api.ContributionDetail{
+				ReviewCount:      int32(detail.ContributionDetail.ReviewCount),
+				CommitCount:      int32(detail.ContributionDetail.CommitCount),
+				IssueCount:       int32(detail.ContributionDetail.IssueCount),
+				PullRequestCount: int32(detail.ContributionDetail.PullRequestCount),
+			},
+			FetchedAt: detail.FetchedAt,
+		}
+	}
+	return result
+}
+
 // HighlightedCardをAPIのHighlightedCard型に変換する
 func convertHighlightedCardToAPI(hc domain.HighlightedCard) api.HighlightedCard {
 	return api.HighlightedCard{
@@ -81,3 +252,44 @@ func convertHighlightedCardToAPI(hc domain.HighlightedCard) api.HighlightedCard
 		BestReviewer:      convertCardToAPI(hc.BestReviewer),
 	}
 }
+
+// convertPageRequest はクエリパラメータをページ単位で取得する条件に変換する
+// 未指定の項目は空のままにし、既定値はService層で決める
+func convertPageRequest[S ~string, O ~string](limit *int, cursor *string, sort *S, order *O) domain.PageRequest {
+	var page domain.PageRequest
+	if limit != nil {
+		page.Limit = *limit
+	}
+	if cursor != nil {
+		page.Cursor = *cursor
+	}
+	if sort != nil {
+		page.SortKey = domain.SortKey(*sort)
+	}
+	if order != nil {
+		page.Order = domain.SortOrder(*order)
+	}
+	return page
+}
+
+// convertNextCursor は次のページのカーソルをレスポンスの値に変換する
+// 次のページがない場合はnilを返す
+func convertNextCursor(cursor string) *string {
+	if cursor == "" {
+		return nil
+	}
+	return &cursor
+}
+
+// 画像のクエリパラメータから描画の設定に変換する
+func convertImageOptions(size *int, padding *int, background *string) domain.ImageOptions {
+	var opts domain.ImageOptions
+	if size != nil {
+		opts.Size = *size
+	}
+	opts.Padding = padding
+	if background != nil {
+		opts.Background = domain.Color(*background)
+	}
+	return opts
+}
@@ -80,14 +80,8 @@ func TestConvertCardToAPI(t *testing.T) {
 				FullName: "",
 				IconUrl:  "",
 				Identicon: api.Identicon{
-					Blocks: [][]bool{
-						{false, false, false, false, false},
-						{false, false, false, false, false},
-						{false, false, false, false, false},
-						{false, false, false, false, false},
-						{false, false, false, false, false},
-					},
-					Color: "",
+					Blocks: [][]bool{},
+					Color:  "",
 				},
 				MostUsedLanguage: api.Language{
 					Name:  "",
@@ -165,12 +159,19 @@ func TestConvertBlocks(t *testing.T) {
 		{
 			name:   "空のBlocksを変換できる",
 			blocks: domain.Blocks{},
+			want:   [][]bool{},
+		},
+		{
+			name:   "7x7のBlocksをそのままの大きさで変換できる",
+			blocks: domain.NewBlocks(7),
 			want: [][]bool{
-				{false, false, false, false, false},
-				{false, false, false, false, false},
-				{false, false, false, false, false},
-				{false, false, false, false, false},
-				{false, false, false, false, false},
+				{false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false},
+				{false, false, false, false, false, false, false},
 			},
 		},
 		{
@@ -5,18 +5,25 @@ import (
 	"fmt"
 
 	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 // コミュニティを作成
 // (POST /communities)
 func (h *Handler) CreateCommunity(ctx context.Context, request api.CreateCommunityRequestObject) (api.CreateCommunityResponseObject, error) {
 	if request.Body == nil || request.Body.Name == "" {
-		return nil, fmt.Errorf("community name is required")
+		return nil, fmt.Errorf("%w: community name is required", domain.ErrInvalidArgument)
+	}
+
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
 	}
 
 	community, err := h.communityService.CreateCommunityWithPeriod(
 		ctx,
 		request.Body.Name,
+		githubID,
 		request.Body.StartDateTime,
 		request.Body.EndDateTime,
 	)
@@ -0,0 +1,45 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// コミュニティの招待コードを作成
+// (POST /communities/{id}/invites)
+func (h *Handler) CreateCommunityInvite(ctx context.Context, request api.CreateCommunityInviteRequestObject) (api.CreateCommunityInviteResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	// オーナーのみ招待コードを作成できる
+	if err := h.communityService.AuthorizeMember(ctx, request.Id, githubID, domain.CommunityRoleOwner); err != nil {
+		return nil, err
+	}
+
+	var expiresIn time.Duration
+	singleUse := false
+	if request.Body != nil {
+		if request.Body.ExpiresInMinutes != nil {
+			if *request.Body.ExpiresInMinutes <= 0 {
+				return nil, fmt.Errorf("%w: expiresInMinutes must be positive", domain.ErrInvalidArgument)
+			}
+			expiresIn = time.Duration(*request.Body.ExpiresInMinutes) * time.Minute
+		}
+		if request.Body.SingleUse != nil {
+			singleUse = *request.Body.SingleUse
+		}
+	}
+
+	invite, err := h.communityService.CreateInvite(ctx, request.Id, githubID, expiresIn, singleUse)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invite: %w", err)
+	}
+
+	return api.CreateCommunityInvite200JSONResponse{Invite: convertCommunityInviteToAPI(*invite)}, nil
+}
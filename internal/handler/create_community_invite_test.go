@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// コミュニティの招待コード作成のテスト
+func TestCreateCommunityInvite(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		body      string
+		setupMock func() *service.MockCommunityService
+		wantCode  int
+		validate  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "正常に1回限りの招待コードを作成できる",
+			body: `{"expiresInMinutes": 60, "singleUse": true}`,
+			setupMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					CreateInviteFunc: func(ctx context.Context, communityID string, createdByGithubID string, expiresIn time.Duration, singleUse bool) (*domain.CommunityInvite, error) {
+						if expiresIn != time.Hour {
+							return nil, fmt.Errorf("unexpected expiresIn: %s", expiresIn)
+						}
+						return &domain.CommunityInvite{
+							ID:          domain.NewCommunityInviteID(),
+							CommunityID: domain.NewCommunityID(),
+							Code:        "ABCD2345",
+							SingleUse:   singleUse,
+							ExpiresAt:   time.Now().Add(expiresIn),
+						}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Invite api.CommunityInvite `json:"invite"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if response.Invite.Code != "ABCD2345" {
+					t.Errorf("招待コードが違う: 期待=ABCD2345, 実際=%s", response.Invite.Code)
+				}
+				if !response.Invite.SingleUse {
+					t.Errorf("singleUseがtrueになっていない")
+				}
+			},
+		},
+		{
+			name: "オーナーでない場合",
+			body: `{}`,
+			setupMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					AuthorizeMemberFunc: func(ctx context.Context, communityID string, githubID string, required domain.CommunityRole) error {
+						return fmt.Errorf("%w: owner role is required", domain.ErrForbidden)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			communityHandler := NewCommunityHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(communityHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/communities/test-id/invites", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// カード交換を申し出る
+// (POST /exchanges)
+func (h *Handler) CreateExchange(ctx context.Context, request api.CreateExchangeRequestObject) (api.CreateExchangeResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	var expiresIn time.Duration
+	if request.Body != nil && request.Body.ExpiresInSeconds != nil {
+		if *request.Body.ExpiresInSeconds <= 0 {
+			return nil, fmt.Errorf("%w: expiresInSeconds must be positive", domain.ErrInvalidArgument)
+		}
+		expiresIn = time.Duration(*request.Body.ExpiresInSeconds) * time.Second
+	}
+
+	exchange, err := h.cardService.CreateExchange(ctx, githubID, expiresIn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create exchange: %w", err)
+	}
+
+	return api.CreateExchange200JSONResponse{Exchange: convertCardExchangeToAPI(*exchange)}, nil
+}
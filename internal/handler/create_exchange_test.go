@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// カード交換の申し出のテスト
+func TestCreateExchange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		body      string
+		setupMock func() *service.MockCardService
+		wantCode  int
+		validate  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "有効期限を指定して申し出を作成できる",
+			body: `{"expiresInSeconds": 120}`,
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					CreateExchangeFunc: func(ctx context.Context, githubID string, expiresIn time.Duration) (*domain.CardExchange, error) {
+						if githubID != "test_user" {
+							return nil, fmt.Errorf("unexpected githubID: %s", githubID)
+						}
+						if expiresIn != 2*time.Minute {
+							return nil, fmt.Errorf("unexpected expiresIn: %s", expiresIn)
+						}
+						return newTestCardExchange("token-1", domain.CardExchangeStatusPending), nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Exchange api.CardExchange `json:"exchange"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if response.Exchange.Token != "token-1" {
+					t.Errorf("tokenが違う: 期待=token-1, 実際=%s", response.Exchange.Token)
+				}
+				if response.Exchange.Status != "pending" {
+					t.Errorf("statusが違う: 期待=pending, 実際=%s", response.Exchange.Status)
+				}
+				if response.Exchange.OfferedCard.GithubId != "test_user" {
+					t.Errorf("offeredCardが違う: 実際=%s", response.Exchange.OfferedCard.GithubId)
+				}
+			},
+		},
+		{
+			name: "自分のカードがない場合",
+			body: `{}`,
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					CreateExchangeFunc: func(ctx context.Context, githubID string, expiresIn time.Duration) (*domain.CardExchange, error) {
+						return nil, fmt.Errorf("my card not found: %w", domain.ErrNotFound)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardHandler := NewCardHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/exchanges", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
+
+// テスト用のカード交換を作成する
+func newTestCardExchange(token string, status domain.CardExchangeStatus) *domain.CardExchange {
+	offeredCard := domain.NewCard("test_user", "node_test_user", domain.Color("#000000"), domain.Blocks{}, domain.IdenticonVersionClassic, domain.Language{}, "test", "Test User", "")
+	exchange := &domain.CardExchange{
+		ID:                domain.NewCardExchangeID(),
+		Token:             token,
+		OfferedByGithubID: offeredCard.GithubID,
+		OfferedCardID:     offeredCard.ID,
+		Status:            status,
+		ExpiresAt:         time.Now().Add(5 * time.Minute),
+		CreatedAt:         time.Now(),
+		OfferedCard:       offeredCard,
+	}
+	if status == domain.CardExchangeStatusAccepted {
+		acceptedCard := domain.NewCard("other_user", "node_other_user", domain.Color("#ffffff"), domain.Blocks{}, domain.IdenticonVersionClassic, domain.Language{}, "other", "Other User", "")
+		acceptedBy := acceptedCard.GithubID
+		acceptedAt := time.Now()
+		exchange.AcceptedByGithubID = &acceptedBy
+		exchange.AcceptedCardID = &acceptedCard.ID
+		exchange.AcceptedAt = &acceptedAt
+		exchange.AcceptedCard = acceptedCard
+	}
+	return exchange
+}
@@ -5,18 +5,30 @@ import (
 	"fmt"
 
 	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 // コミュニティを削除
 // (DELETE /communities/{id})
 func (h *Handler) DeleteCommunity(ctx context.Context, request api.DeleteCommunityRequestObject) (api.DeleteCommunityResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	// オーナーのみ削除できる
+	if err := h.communityService.AuthorizeMember(ctx, request.Id, githubID, domain.CommunityRoleOwner); err != nil {
+		return nil, err
+	}
+
 	// 削除前にコミュニティ情報を取得
 	community, err := h.communityService.GetCommunityByID(ctx, request.Id)
 	if err != nil {
 		return nil, fmt.Errorf("community not found: %w", err)
 	}
 
-	if err := h.communityService.DeleteCommunity(ctx, request.Id); err != nil {
+	permanent := request.Params.Permanent != nil && *request.Params.Permanent
+	if err := h.communityService.DeleteCommunity(ctx, request.Id, permanent); err != nil {
 		return nil, fmt.Errorf("failed to delete community: %w", err)
 	}
 
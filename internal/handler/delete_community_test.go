@@ -34,7 +34,7 @@ func TestDeleteCommunity(t *testing.T) {
 							Name: "Test Community",
 						}, nil
 					},
-					DeleteCommunityFunc: func(ctx context.Context, id string) error {
+					DeleteCommunityFunc: func(ctx context.Context, id string, permanent bool) error {
 						return nil
 					},
 				}
@@ -75,7 +75,7 @@ func TestDeleteCommunity(t *testing.T) {
 							Name: "Test Community",
 						}, nil
 					},
-					DeleteCommunityFunc: func(ctx context.Context, id string) error {
+					DeleteCommunityFunc: func(ctx context.Context, id string, permanent bool) error {
 						return fmt.Errorf("database error")
 					},
 				}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/gin-gonic/gin"
+)
+
+// エラーレスポンスのcode
+const (
+	errorCodeInvalidArgument     = "invalid_argument"
+	errorCodeUnauthorized        = "unauthorized"
+	errorCodeForbidden           = "forbidden"
+	errorCodeNotFound            = "not_found"
+	errorCodeAlreadyExists       = "already_exists"
+	errorCodeUpstreamUnavailable = "upstream_unavailable"
+	errorCodeRateLimited         = "rate_limited"
+	errorCodeInternal            = "internal"
+)
+
+// ErrorHandlerMiddleware はハンドラーが返したエラーをHTTPステータスコードと構造化されたエラーレスポンスに変換する
+// api.NewStrictHandler のミドルウェアとして登録する
+func ErrorHandlerMiddleware(f api.StrictHandlerFunc, operationID string) api.StrictHandlerFunc {
+	return func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		response, err := f(ctx, request)
+		if err == nil {
+			return response, nil
+		}
+
+		status, code := statusFromError(err)
+		message := err.Error()
+		// 5xxの場合は内部の詳細をクライアントに返さない
+		if status == http.StatusInternalServerError {
+			message = http.StatusText(status)
+		}
+
+		_ = ctx.Error(err)
+		body := api.Error{Code: code, Message: message}
+		var rateErr *domain.RateLimitError
+		if errors.As(err, &rateErr) {
+			setRetryAfter(ctx, rateErr.ResetAt)
+			body.ResetAt = &rateErr.ResetAt
+		}
+		ctx.JSON(status, body)
+
+		// レスポンスは書き込み済みなので、生成コード側でのエラー処理をさせない
+		return nil, nil
+	}
+}
+
+// RequestErrorMiddleware は生成コードがリクエストボディを解釈できなかった場合のエラーを構造化されたエラーレスポンスで返す
+// 生成コードはステータスコードを400にしてエラーを ctx.Error に積むだけなので、ginのミドルウェアとして後から書き込む
+func RequestErrorMiddleware(c *gin.Context) {
+	c.Next()
+	if c.Writer.Written() || c.Writer.Status() != http.StatusBadRequest || len(c.Errors) == 0 {
+		return
+	}
+
+	err := fmt.Errorf("%w: invalid request body: %v", domain.ErrInvalidArgument, c.Errors.Last().Err)
+	status, code := statusFromError(err)
+	c.JSON(status, api.Error{Code: code, Message: err.Error()})
+}
+
+// RequestErrorHandler はパスやクエリのパラメーターを解釈できなかった場合のエラーを構造化されたエラーレスポンスで返す
+// api.GinServerOptions の ErrorHandler に登録する
+func RequestErrorHandler(c *gin.Context, err error, statusCode int) {
+	AbortWithError(c, statusCode, err.Error())
+}
+
+// ValidationErrorHandler はOpenAPIのリクエストバリデーションエラーを構造化されたエラーレスポンスで返す
+func ValidationErrorHandler(c *gin.Context, message string, statusCode int) {
+	AbortWithError(c, statusCode, message)
+}
+
+// AbortWithError はステータスコードに対応するエラーレスポンスを返してリクエストを中断する
+func AbortWithError(c *gin.Context, statusCode int, message string) {
+	c.AbortWithStatusJSON(statusCode, api.Error{Code: codeFromStatus(statusCode), Message: message})
+}
+
+// AbortWithRateLimit はレート制限に達したことを503で返してリクエストを中断する
+// 制限が解除される日時が分かる場合は Retry-After ヘッダーとresetAtに入れる
+func AbortWithRateLimit(c *gin.Context, err error, message string) {
+	body := api.Error{Code: errorCodeRateLimited, Message: message}
+	var rateErr *domain.RateLimitError
+	if errors.As(err, &rateErr) {
+		setRetryAfter(c, rateErr.ResetAt)
+		body.ResetAt = &rateErr.ResetAt
+	}
+	c.AbortWithStatusJSON(http.StatusServiceUnavailable, body)
+}
+
+// setRetryAfter は制限が解除されるまでの秒数を Retry-After ヘッダーに入れる
+func setRetryAfter(c *gin.Context, resetAt time.Time) {
+	seconds := int(math.Ceil(time.Until(resetAt).Seconds()))
+	if seconds < 0 {
+		seconds = 0
+	}
+	c.Header("Retry-After", strconv.Itoa(seconds))
+}
+
+// statusFromError はドメインエラーをHTTPステータスコードとエラーレスポンスのcodeに変換する
+func statusFromError(err error) (int, string) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidArgument):
+		return http.StatusBadRequest, errorCodeInvalidArgument
+	case errors.Is(err, domain.ErrUnauthorized):
+		return http.StatusUnauthorized, errorCodeUnauthorized
+	case errors.Is(err, domain.ErrForbidden):
+		return http.StatusForbidden, errorCodeForbidden
+	case errors.Is(err, domain.ErrNotFound):
+		return http.StatusNotFound, errorCodeNotFound
+	case errors.Is(err, domain.ErrAlreadyExists):
+		return http.StatusConflict, errorCodeAlreadyExists
+	case errors.Is(err, domain.ErrRateLimited):
+		return http.StatusServiceUnavailable, errorCodeRateLimited
+	case errors.Is(err, domain.ErrUpstreamUnavailable):
+		return http.StatusBadGateway, errorCodeUpstreamUnavailable
+	default:
+		return http.StatusInternalServerError, errorCodeInternal
+	}
+}
+
+// codeFromStatus はHTTPステータスコードからエラーレスポンスのcodeを決める
+func codeFromStatus(statusCode int) string {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return errorCodeInvalidArgument
+	case http.StatusUnauthorized:
+		return errorCodeUnauthorized
+	case http.StatusForbidden:
+		return errorCodeForbidden
+	case http.StatusNotFound:
+		return errorCodeNotFound
+	case http.StatusConflict:
+		return errorCodeAlreadyExists
+	case http.StatusBadGateway:
+		return errorCodeUpstreamUnavailable
+	case http.StatusServiceUnavailable:
+		return errorCodeRateLimited
+	default:
+		return errorCodeInternal
+	}
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ドメインエラーがHTTPステータスコードとエラーレスポンスに変換されることをテスト
+func TestErrorHandlerMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:     "NotFoundは404になる",
+			err:      fmt.Errorf("card not found: githubID=1: %w", domain.ErrNotFound),
+			wantCode: http.StatusNotFound,
+			wantBody: errorCodeNotFound,
+		},
+		{
+			name:     "InvalidArgumentは400になる",
+			err:      fmt.Errorf("%w: invalid github id", domain.ErrInvalidArgument),
+			wantCode: http.StatusBadRequest,
+			wantBody: errorCodeInvalidArgument,
+		},
+		{
+			name:     "Unauthorizedは401になる",
+			err:      fmt.Errorf("github_client not found in context: %w", domain.ErrUnauthorized),
+			wantCode: http.StatusUnauthorized,
+			wantBody: errorCodeUnauthorized,
+		},
+		{
+			name:     "Forbiddenは403になる",
+			err:      fmt.Errorf("%w: not a member", domain.ErrForbidden),
+			wantCode: http.StatusForbidden,
+			wantBody: errorCodeForbidden,
+		},
+		{
+			name:     "AlreadyExistsは409になる",
+			err:      fmt.Errorf("failed to add card: %w", domain.ErrAlreadyExists),
+			wantCode: http.StatusConflict,
+			wantBody: errorCodeAlreadyExists,
+		},
+		{
+			name:     "UpstreamUnavailableは502になる",
+			err:      fmt.Errorf("failed to get github user info: %w", domain.ErrUpstreamUnavailable),
+			wantCode: http.StatusBadGateway,
+			wantBody: errorCodeUpstreamUnavailable,
+		},
+		{
+			name:     "RateLimitedは503になる",
+			err:      fmt.Errorf("failed to get github user info: %w", &domain.RateLimitError{ResetAt: time.Now().Add(time.Minute), Err: fmt.Errorf("API rate limit exceeded")}),
+			wantCode: http.StatusServiceUnavailable,
+			wantBody: errorCodeRateLimited,
+		},
+		{
+			name:     "ドメインエラー以外は500になる",
+			err:      fmt.Errorf("database error"),
+			wantCode: http.StatusInternalServerError,
+			wantBody: errorCodeInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &service.MockCardService{
+				GetCardByGitHubIDFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
+					return nil, tt.err
+				},
+			}
+			cardHandler := NewCardHandler(mockService)
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, []api.StrictMiddlewareFunc{ErrorHandlerMiddleware})
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/cards/1", nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			var response api.Error
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("JSONパースに失敗しました: %v", err)
+			}
+			if response.Code != tt.wantBody {
+				t.Errorf("codeが違う: 期待=%s, 実際=%s", tt.wantBody, response.Code)
+			}
+			if response.Message == "" {
+				t.Errorf("messageが空です")
+			}
+			if tt.wantCode == http.StatusServiceUnavailable {
+				if w.Header().Get("Retry-After") == "" {
+					t.Errorf("Retry-Afterヘッダーがありません")
+				}
+				if response.ResetAt == nil {
+					t.Errorf("resetAtがありません")
+				}
+			}
+		})
+	}
+}
+
+// リクエストを解釈できなかった場合も構造化されたエラーレスポンスになることをテスト
+func TestRequestErrorMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		wantCode int
+	}{
+		{
+			name:     "JSONとして解釈できないボディは400になる",
+			method:   "PUT",
+			path:     "/cards/me/settings",
+			body:     `{"includePrivateRepositories":`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "解釈できないクエリパラメーターは400になる",
+			method:   "GET",
+			path:     "/cards?limit=abc",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardHandler := NewCardHandler(&service.MockCardService{})
+			router := gin.New()
+			router.Use(RequestErrorMiddleware)
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, []api.StrictMiddlewareFunc{ErrorHandlerMiddleware})
+			api.RegisterHandlersWithOptions(router, strictHandler, api.GinServerOptions{ErrorHandler: RequestErrorHandler})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			var response api.Error
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("JSONパースに失敗しました: %v", err)
+			}
+			if response.Code != errorCodeInvalidArgument {
+				t.Errorf("codeが違う: 期待=%s, 実際=%s", errorCodeInvalidArgument, response.Code)
+			}
+			if response.Message == "" {
+				t.Errorf("messageが空です")
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// カードのIdenticonをPNGで取得
+// (GET /cards/{githubId}/identicon.png)
+func (h *Handler) GetCardIdenticonPng(ctx context.Context, request api.GetCardIdenticonPngRequestObject) (api.GetCardIdenticonPngResponseObject, error) {
+	params := request.Params
+	opts := convertImageOptions(params.Size, params.Padding, params.Background)
+
+	img, err := h.cardService.GetIdenticonImage(ctx, request.GithubId, domain.ImageFormatPNG, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identicon image: %w", err)
+	}
+
+	if matchesETag(params.IfNoneMatch, img.ETag) {
+		return api.GetCardIdenticonPng304Response{Headers: api.NotModifiedResponseHeaders{ETag: img.ETag}}, nil
+	}
+
+	return api.GetCardIdenticonPng200ImagepngResponse{
+		Body:          bytes.NewReader(img.Data),
+		ContentLength: int64(len(img.Data)),
+		Headers: api.GetCardIdenticonPng200ResponseHeaders{
+			CacheControl: imageCacheControl,
+			ETag:         img.ETag,
+		},
+	}, nil
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// カードのIdenticonをPNGで取得するテスト
+func TestGetCardIdenticonPng(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		query     string
+		setupMock func() *service.MockCardService
+		wantCode  int
+	}{
+		{
+			name: "PNGを取得できる",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					GetIdenticonImageFunc: func(ctx context.Context, githubID string, format domain.ImageFormat, opts domain.ImageOptions) (*domain.Image, error) {
+						if format != domain.ImageFormatPNG {
+							return nil, fmt.Errorf("unexpected format: %s", format)
+						}
+						if opts.Size != 0 || opts.Padding != nil || opts.Background != "" {
+							return nil, fmt.Errorf("options should be left to defaults: %+v", opts)
+						}
+						return &domain.Image{Format: format, Data: []byte("png"), ETag: `"etag"`}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:  "大きさが数値でない場合",
+			query: "?size=large",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardHandler := NewCardHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/cards/12345/identicon.png"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Fatalf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			if got := w.Header().Get("Content-Type"); got != "image/png" {
+				t.Errorf("Content-Typeが違う: %s", got)
+			}
+			if got := w.Body.String(); got != "png" {
+				t.Errorf("レスポンスボディが違う: %s", got)
+			}
+		})
+	}
+}
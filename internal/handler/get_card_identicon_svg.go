@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// カードのIdenticonをSVGで取得
+// (GET /cards/{githubId}/identicon.svg)
+func (h *Handler) GetCardIdenticonSvg(ctx context.Context, request api.GetCardIdenticonSvgRequestObject) (api.GetCardIdenticonSvgResponseObject, error) {
+	params := request.Params
+	opts := convertImageOptions(params.Size, params.Padding, params.Background)
+
+	img, err := h.cardService.GetIdenticonImage(ctx, request.GithubId, domain.ImageFormatSVG, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get identicon image: %w", err)
+	}
+
+	if matchesETag(params.IfNoneMatch, img.ETag) {
+		return api.GetCardIdenticonSvg304Response{Headers: api.NotModifiedResponseHeaders{ETag: img.ETag}}, nil
+	}
+
+	return api.GetCardIdenticonSvg200ImagesvgXmlResponse{
+		Body:          bytes.NewReader(img.Data),
+		ContentLength: int64(len(img.Data)),
+		Headers: api.GetCardIdenticonSvg200ResponseHeaders{
+			CacheControl: imageCacheControl,
+			ETag:         img.ETag,
+		},
+	}, nil
+}
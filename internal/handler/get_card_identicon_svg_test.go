@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// カードのIdenticonをSVGで取得するテスト
+func TestGetCardIdenticonSvg(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	const etag = `"abc123"`
+	newMock := func() *service.MockCardService {
+		return &service.MockCardService{
+			GetIdenticonImageFunc: func(ctx context.Context, githubID string, format domain.ImageFormat, opts domain.ImageOptions) (*domain.Image, error) {
+				if githubID != "12345" || format != domain.ImageFormatSVG {
+					return nil, fmt.Errorf("unexpected args: githubID=%s format=%s", githubID, format)
+				}
+				if opts.Size != 128 || opts.Padding == nil || *opts.Padding != 8 || opts.Background != "ffffff" {
+					return nil, fmt.Errorf("unexpected options: %+v", opts)
+				}
+				return &domain.Image{Format: format, Data: []byte("<svg></svg>"), ETag: etag}, nil
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		ifNoneMatch string
+		setupMock   func() *service.MockCardService
+		wantCode    int
+		wantBody    string
+	}{
+		{
+			name:      "SVGを取得できる",
+			setupMock: newMock,
+			wantCode:  http.StatusOK,
+			wantBody:  "<svg></svg>",
+		},
+		{
+			name:        "ETagが一致する場合は304を返す",
+			ifNoneMatch: `W/"other", ` + etag,
+			setupMock:   newMock,
+			wantCode:    http.StatusNotModified,
+			wantBody:    "",
+		},
+		{
+			name: "カードが存在しない場合",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					GetIdenticonImageFunc: func(ctx context.Context, githubID string, format domain.ImageFormat, opts domain.ImageOptions) (*domain.Image, error) {
+						return nil, fmt.Errorf("card not found: %w", domain.ErrNotFound)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardHandler := NewCardHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/cards/12345/identicon.svg?size=128&padding=8&background=ffffff", nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Fatalf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+			if tt.wantCode == http.StatusInternalServerError {
+				return
+			}
+			if got := w.Header().Get("ETag"); got != etag {
+				t.Errorf("ETagが違う: 期待=%s, 実際=%s", etag, got)
+			}
+			if got := w.Body.String(); got != tt.wantBody {
+				t.Errorf("レスポンスボディが違う: 期待=%s, 実際=%s", tt.wantBody, got)
+			}
+			if tt.wantCode == http.StatusOK {
+				if got := w.Header().Get("Content-Type"); got != "image/svg+xml" {
+					t.Errorf("Content-Typeが違う: %s", got)
+				}
+				if got := w.Header().Get("Cache-Control"); got != imageCacheControl {
+					t.Errorf("Cache-Controlが違う: %s", got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// カード全体の画像を取得
+// (GET /cards/{githubId}/image)
+func (h *Handler) GetCardImage(ctx context.Context, request api.GetCardImageRequestObject) (api.GetCardImageResponseObject, error) {
+	params := request.Params
+
+	format := domain.ImageFormatSVG
+	if params.Format != nil {
+		format = domain.ImageFormat(*params.Format)
+	}
+
+	// コントリビュート数は保存済みの記録がある場合だけ描く
+	var totalContribution *int
+	if params.Contributions != nil && *params.Contributions && h.statsService != nil {
+		stats, err := h.statsService.GetStatsSnapshot(ctx, request.GithubId)
+		switch {
+		case err == nil:
+			totalContribution = &stats.TotalContribution
+		case !errors.Is(err, domain.ErrNotFound):
+			return nil, fmt.Errorf("failed to get stats snapshot: %w", err)
+		}
+	}
+
+	img, err := h.cardService.GetCardImage(ctx, request.GithubId, format, totalContribution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card image: %w", err)
+	}
+
+	if matchesETag(params.IfNoneMatch, img.ETag) {
+		return api.GetCardImage304Response{Headers: api.NotModifiedResponseHeaders{ETag: img.ETag}}, nil
+	}
+
+	headers := api.GetCardImage200ResponseHeaders{
+		CacheControl: imageCacheControl,
+		ETag:         img.ETag,
+	}
+	if format == domain.ImageFormatPNG {
+		return api.GetCardImage200ImagepngResponse{
+			Body:          bytes.NewReader(img.Data),
+			ContentLength: int64(len(img.Data)),
+			Headers:       headers,
+		}, nil
+	}
+	return api.GetCardImage200ImagesvgXmlResponse{
+		Body:          bytes.NewReader(img.Data),
+		ContentLength: int64(len(img.Data)),
+		Headers:       headers,
+	}, nil
+}
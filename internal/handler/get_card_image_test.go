@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// カード全体の画像を取得するテスト
+func TestGetCardImage(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	total := 42
+	snapshot := func(ctx context.Context, githubID string) (*domain.Stats, error) {
+		return &domain.Stats{TotalContribution: total}, nil
+	}
+
+	tests := []struct {
+		name            string
+		query           string
+		ifNoneMatch     string
+		getSnapshot     func(ctx context.Context, githubID string) (*domain.Stats, error)
+		wantFormat      domain.ImageFormat
+		wantTotal       *int
+		wantCode        int
+		wantContentType string
+	}{
+		{
+			name:            "既定ではSVGを取得できる",
+			getSnapshot:     snapshot,
+			wantFormat:      domain.ImageFormatSVG,
+			wantCode:        http.StatusOK,
+			wantContentType: "image/svg+xml",
+		},
+		{
+			name:            "PNGを取得できる",
+			query:           "?format=png",
+			wantFormat:      domain.ImageFormatPNG,
+			wantCode:        http.StatusOK,
+			wantContentType: "image/png",
+		},
+		{
+			name:            "保存済みの記録があればコントリビュート数を渡す",
+			query:           "?contributions=true",
+			getSnapshot:     snapshot,
+			wantFormat:      domain.ImageFormatSVG,
+			wantTotal:       &total,
+			wantCode:        http.StatusOK,
+			wantContentType: "image/svg+xml",
+		},
+		{
+			name:  "保存済みの記録がなければコントリビュート数を渡さない",
+			query: "?contributions=true",
+			getSnapshot: func(ctx context.Context, githubID string) (*domain.Stats, error) {
+				return nil, fmt.Errorf("contribution snapshots not found: %w", domain.ErrNotFound)
+			},
+			wantFormat:      domain.ImageFormatSVG,
+			wantCode:        http.StatusOK,
+			wantContentType: "image/svg+xml",
+		},
+		{
+			name:  "記録の取得に失敗した場合",
+			query: "?contributions=true",
+			getSnapshot: func(ctx context.Context, githubID string) (*domain.Stats, error) {
+				return nil, fmt.Errorf("database error")
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:        "ETagが一致する場合は304",
+			ifNoneMatch: `"etag"`,
+			wantFormat:  domain.ImageFormatSVG,
+			wantCode:    http.StatusNotModified,
+		},
+		{
+			name:     "contributionsが真偽値でない場合",
+			query:    "?contributions=maybe",
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardService := &service.MockCardService{
+				GetCardImageFunc: func(ctx context.Context, githubID string, format domain.ImageFormat, totalContribution *int) (*domain.Image, error) {
+					if format != tt.wantFormat {
+						return nil, fmt.Errorf("unexpected format: %s", format)
+					}
+					if (totalContribution == nil) != (tt.wantTotal == nil) || (totalContribution != nil && *totalContribution != *tt.wantTotal) {
+						return nil, fmt.Errorf("unexpected total contribution: %v", totalContribution)
+					}
+					return &domain.Image{Format: format, Data: []byte(format), ETag: `"etag"`}, nil
+				},
+			}
+			statsService := &service.MockStatsService{GetStatsSnapshotFunc: tt.getSnapshot}
+			h := NewHandler(cardService, nil, statsService)
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(h, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/cards/12345/image"+tt.query, nil)
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Fatalf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			if got := w.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Content-Typeが違う: %s", got)
+			}
+			if got := w.Header().Get("ETag"); got != `"etag"` {
+				t.Errorf("ETagが違う: %s", got)
+			}
+			if got := w.Body.String(); got != string(tt.wantFormat) {
+				t.Errorf("レスポンスボディが違う: %s", got)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 // カード一覧取得
@@ -15,15 +16,27 @@ func (h *Handler) GetCards(ctx context.Context, request api.GetCardsRequestObjec
 		return nil, fmt.Errorf("unauthorized: %w", err)
 	}
 
-	cards, err := h.cardService.GetAllCards(ctx, githubID)
+	params := request.Params
+	filter := domain.CardFilter{}
+	if params.Q != nil {
+		filter.Query = *params.Q
+	}
+	if params.Language != nil {
+		filter.Language = *params.Language
+	}
+	if params.CommunityId != nil {
+		filter.CommunityID = *params.CommunityId
+	}
+
+	page, err := h.cardService.ListCards(ctx, githubID, filter, convertPageRequest(params.Limit, params.Cursor, params.Sort, params.Order))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cards: %w", err)
 	}
 
-	cardsAPI := make([]api.Card, len(cards))
-	for i, card := range cards {
-		cardsAPI[i] = convertCardToAPI(card)
+	cardsAPI := make([]api.CollectedCard, len(page.CollectedCards))
+	for i, collectedCard := range page.CollectedCards {
+		cardsAPI[i] = convertCollectedCardToAPI(collectedCard)
 	}
 
-	return api.GetCards200JSONResponse{Cards: cardsAPI}, nil
+	return api.GetCards200JSONResponse{Cards: cardsAPI, NextCursor: convertNextCursor(page.NextCursor)}, nil
 }
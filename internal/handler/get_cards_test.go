@@ -3,9 +3,11 @@ package handler
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	api "github.com/furarico/octo-deck-api/generated"
 	"github.com/furarico/octo-deck-api/internal/domain"
@@ -19,6 +21,7 @@ func TestGetCards(t *testing.T) {
 
 	tests := []struct {
 		name      string
+		query     string
 		setupMock func() *service.MockCardService
 		wantCode  int
 		validate  func(t *testing.T, w *httptest.ResponseRecorder)
@@ -27,9 +30,9 @@ func TestGetCards(t *testing.T) {
 			name: "正常にカード一覧を取得できる",
 			setupMock: func() *service.MockCardService {
 				return &service.MockCardService{
-					GetAllCardsFunc: func(ctx context.Context, githubID string) ([]domain.Card, error) {
-						return []domain.Card{
-							{
+					ListCardsFunc: func(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+						return &domain.CollectedCardPage{CollectedCards: []domain.CollectedCard{
+							newTestCollectedCard(domain.Card{
 								ID:       domain.NewCardID(),
 								GithubID: "user1",
 								UserName: "user1",
@@ -37,8 +40,8 @@ func TestGetCards(t *testing.T) {
 								IconUrl:  "https://example.com/user1.png",
 								Color:    "#111111",
 								Blocks:   domain.Blocks{},
-							},
-							{
+							}),
+							newTestCollectedCard(domain.Card{
 								ID:       domain.NewCardID(),
 								GithubID: "user2",
 								UserName: "user2",
@@ -46,8 +49,8 @@ func TestGetCards(t *testing.T) {
 								IconUrl:  "https://example.com/user2.png",
 								Color:    "#222222",
 								Blocks:   domain.Blocks{},
-							},
-							{
+							}),
+							newTestCollectedCard(domain.Card{
 								ID:       domain.NewCardID(),
 								GithubID: "user3",
 								UserName: "user3",
@@ -55,15 +58,15 @@ func TestGetCards(t *testing.T) {
 								IconUrl:  "https://example.com/user3.png",
 								Color:    "#333333",
 								Blocks:   domain.Blocks{},
-							},
-						}, nil
+							}),
+						}}, nil
 					},
 				}
 			},
 			wantCode: http.StatusOK,
 			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
 				var response struct {
-					Cards []api.Card `json:"cards"`
+					Cards []api.CollectedCard `json:"cards"`
 				}
 				err := json.Unmarshal(w.Body.Bytes(), &response)
 				if err != nil {
@@ -72,7 +75,16 @@ func TestGetCards(t *testing.T) {
 
 				// カード数をチェック
 				if len(response.Cards) != 3 {
-					t.Errorf("カード数が違う: 期待=3, 実際=%d", len(response.Cards))
+					t.Fatalf("カード数が違う: 期待=3, 実際=%d", len(response.Cards))
+				}
+
+				// 集めたときの状況が含まれていることをチェック
+				card := response.Cards[0]
+				if card.GithubId != "user1" || card.Source != api.CollectSourceQr || card.CollectedAt.IsZero() {
+					t.Errorf("カードの内容が違う: %+v", card)
+				}
+				if card.Note == nil || *card.Note != "met at meetup" {
+					t.Errorf("noteが違う: %v", card.Note)
 				}
 			},
 		},
@@ -80,8 +92,8 @@ func TestGetCards(t *testing.T) {
 			name: "空の結果を正常に返せる",
 			setupMock: func() *service.MockCardService {
 				return &service.MockCardService{
-					GetAllCardsFunc: func(ctx context.Context, githubID string) ([]domain.Card, error) {
-						return []domain.Card{}, nil
+					ListCardsFunc: func(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+						return &domain.CollectedCardPage{CollectedCards: []domain.CollectedCard{}}, nil
 					},
 				}
 			},
@@ -101,6 +113,58 @@ func TestGetCards(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:  "クエリパラメータがページの条件として渡され、次のページのカーソルを返す",
+			query: "?limit=1&cursor=abc&sort=user_name&order=asc",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					ListCardsFunc: func(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+						want := domain.PageRequest{Limit: 1, Cursor: "abc", SortKey: domain.SortKeyUserName, Order: domain.SortOrderAsc}
+						if page != want {
+							return nil, fmt.Errorf("unexpected page request: %+v", page)
+						}
+						return &domain.CollectedCardPage{
+							CollectedCards: []domain.CollectedCard{newTestCollectedCard(domain.Card{ID: domain.NewCardID(), GithubID: "user1"})},
+							NextCursor:     "next",
+						}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response api.GetCards200JSONResponse
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Fatalf("JSONパースに失敗しました: %v", err)
+				}
+				if response.NextCursor == nil || *response.NextCursor != "next" {
+					t.Errorf("nextCursorが違う: 期待=next, 実際=%v", response.NextCursor)
+				}
+			},
+		},
+		{
+			name:  "検索条件のクエリパラメータが絞り込み条件として渡される",
+			query: "?q=octo&language=Go&communityId=community-1",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					ListCardsFunc: func(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+						want := domain.CardFilter{Query: "octo", Language: "Go", CommunityID: "community-1"}
+						if filter != want {
+							return nil, fmt.Errorf("unexpected filter: %+v", filter)
+						}
+						return &domain.CollectedCardPage{CollectedCards: []domain.CollectedCard{}}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:  "limitが数値でない場合は400を返す",
+			query: "?limit=abc",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{}
+			},
+			wantCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -115,7 +179,7 @@ func TestGetCards(t *testing.T) {
 			api.RegisterHandlers(router, strictHandler)
 
 			w := httptest.NewRecorder()
-			req, _ := http.NewRequest("GET", "/cards", nil)
+			req, _ := http.NewRequest("GET", "/cards"+tt.query, nil)
 			router.ServeHTTP(w, req)
 			if w.Code != tt.wantCode {
 				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
@@ -127,3 +191,18 @@ func TestGetCards(t *testing.T) {
 		})
 	}
 }
+
+// テスト用のデッキのカードを作成するヘルパー関数
+func newTestCollectedCard(card domain.Card) domain.CollectedCard {
+	return domain.CollectedCard{
+		ID:                domain.NewCollectedCardID(),
+		CollectorGithubID: "test_user",
+		CardID:            card.ID,
+		CollectedAt:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		CollectDetail: domain.CollectDetail{
+			Source: domain.CollectSourceQR,
+			Note:   "met at meetup",
+		},
+		Card: &card,
+	}
+}
@@ -15,15 +15,16 @@ func (h *Handler) GetCommunities(ctx context.Context, request api.GetCommunities
 		return nil, fmt.Errorf("unauthorized: %w", err)
 	}
 
-	communities, err := h.communityService.GetAllCommunities(ctx, githubID)
+	params := request.Params
+	page, err := h.communityService.ListCommunities(ctx, githubID, convertPageRequest[string](params.Limit, params.Cursor, nil, params.Order))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get communities: %w", err)
 	}
 
-	communitiesAPI := make([]api.Community, len(communities))
-	for i, community := range communities {
+	communitiesAPI := make([]api.Community, len(page.Communities))
+	for i, community := range page.Communities {
 		communitiesAPI[i] = convertCommunityToAPI(community)
 	}
 
-	return api.GetCommunities200JSONResponse{Communities: communitiesAPI}, nil
+	return api.GetCommunities200JSONResponse{Communities: communitiesAPI, NextCursor: convertNextCursor(page.NextCursor)}, nil
 }
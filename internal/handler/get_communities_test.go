@@ -28,8 +28,8 @@ func TestGetCommunities(t *testing.T) {
 			name: "正常にコミュニティ一覧を取得できる",
 			setupMock: func() *service.MockCommunityService {
 				return &service.MockCommunityService{
-					GetAllCommunitiesFunc: func(ctx context.Context, githubID string) ([]domain.Community, error) {
-						return []domain.Community{
+					ListCommunitiesFunc: func(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error) {
+						return &domain.CommunityPage{Communities: []domain.Community{
 							{
 								ID:   domain.NewCommunityID(),
 								Name: "Test Community 1",
@@ -38,7 +38,7 @@ func TestGetCommunities(t *testing.T) {
 								ID:   domain.NewCommunityID(),
 								Name: "Test Community 2",
 							},
-						}, nil
+						}}, nil
 					},
 				}
 			},
@@ -60,8 +60,8 @@ func TestGetCommunities(t *testing.T) {
 			name: "コミュニティが空の場合",
 			setupMock: func() *service.MockCommunityService {
 				return &service.MockCommunityService{
-					GetAllCommunitiesFunc: func(ctx context.Context, githubID string) ([]domain.Community, error) {
-						return []domain.Community{}, nil
+					ListCommunitiesFunc: func(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error) {
+						return &domain.CommunityPage{Communities: []domain.Community{}}, nil
 					},
 				}
 			},
@@ -83,7 +83,7 @@ func TestGetCommunities(t *testing.T) {
 			name: "サービスでエラーが発生した場合",
 			setupMock: func() *service.MockCommunityService {
 				return &service.MockCommunityService{
-					GetAllCommunitiesFunc: func(ctx context.Context, githubID string) ([]domain.Community, error) {
+					ListCommunitiesFunc: func(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error) {
 						return nil, fmt.Errorf("database error")
 					},
 				}
@@ -10,15 +10,16 @@ import (
 // 指定したコミュニティのカード一覧取得
 // (GET /communities/{id}/cards)
 func (h *Handler) GetCommunityCards(ctx context.Context, request api.GetCommunityCardsRequestObject) (api.GetCommunityCardsResponseObject, error) {
-	cards, err := h.communityService.GetCommunityCards(ctx, request.Id)
+	params := request.Params
+	page, err := h.communityService.ListCommunityCards(ctx, request.Id, convertPageRequest(params.Limit, params.Cursor, params.Sort, params.Order))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get community cards: %w", err)
 	}
 
-	cardsAPI := make([]api.Card, len(cards))
-	for i, card := range cards {
+	cardsAPI := make([]api.Card, len(page.Cards))
+	for i, card := range page.Cards {
 		cardsAPI[i] = convertCardToAPI(card)
 	}
 
-	return api.GetCommunityCards200JSONResponse{Cards: cardsAPI}, nil
+	return api.GetCommunityCards200JSONResponse{Cards: cardsAPI, NextCursor: convertNextCursor(page.NextCursor)}, nil
 }
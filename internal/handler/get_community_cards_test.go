@@ -28,8 +28,8 @@ func TestGetCommunityCards(t *testing.T) {
 			name: "正常にコミュニティのカード一覧を取得できる",
 			setupMock: func() *service.MockCommunityService {
 				return &service.MockCommunityService{
-					GetCommunityCardsFunc: func(ctx context.Context, id string) ([]domain.Card, error) {
-						return []domain.Card{
+					ListCommunityCardsFunc: func(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error) {
+						return &domain.CardPage{Cards: []domain.Card{
 							{
 								ID:       domain.NewCardID(),
 								GithubID: "1111",
@@ -48,7 +48,7 @@ func TestGetCommunityCards(t *testing.T) {
 								Color:    domain.Color("#FFFFFF"),
 								Blocks:   domain.Blocks{},
 							},
-						}, nil
+						}}, nil
 					},
 				}
 			},
@@ -77,8 +77,8 @@ func TestGetCommunityCards(t *testing.T) {
 			name: "カードが空の場合",
 			setupMock: func() *service.MockCommunityService {
 				return &service.MockCommunityService{
-					GetCommunityCardsFunc: func(ctx context.Context, id string) ([]domain.Card, error) {
-						return []domain.Card{}, nil
+					ListCommunityCardsFunc: func(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error) {
+						return &domain.CardPage{Cards: []domain.Card{}}, nil
 					},
 				}
 			},
@@ -100,7 +100,7 @@ func TestGetCommunityCards(t *testing.T) {
 			name: "サービスでエラーが発生した場合",
 			setupMock: func() *service.MockCommunityService {
 				return &service.MockCommunityService{
-					GetCommunityCardsFunc: func(ctx context.Context, id string) ([]domain.Card, error) {
+					ListCommunityCardsFunc: func(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error) {
 						return nil, fmt.Errorf("database error")
 					},
 				}
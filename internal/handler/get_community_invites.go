@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// コミュニティの招待コード一覧取得
+// (GET /communities/{id}/invites)
+func (h *Handler) GetCommunityInvites(ctx context.Context, request api.GetCommunityInvitesRequestObject) (api.GetCommunityInvitesResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	// オーナーのみ招待コードを確認できる
+	if err := h.communityService.AuthorizeMember(ctx, request.Id, githubID, domain.CommunityRoleOwner); err != nil {
+		return nil, err
+	}
+
+	invites, err := h.communityService.GetActiveInvites(ctx, request.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invites: %w", err)
+	}
+
+	apiInvites := make([]api.CommunityInvite, len(invites))
+	for i, invite := range invites {
+		apiInvites[i] = convertCommunityInviteToAPI(invite)
+	}
+
+	return api.GetCommunityInvites200JSONResponse{Invites: apiInvites}, nil
+}
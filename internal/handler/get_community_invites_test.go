@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// コミュニティの招待コード一覧取得のテスト
+func TestGetCommunityInvites(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		setupMock func() *service.MockCommunityService
+		wantCode  int
+		wantCount int
+	}{
+		{
+			name: "正常に招待コード一覧を取得できる",
+			setupMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					GetActiveInvitesFunc: func(ctx context.Context, communityID string) ([]domain.CommunityInvite, error) {
+						return []domain.CommunityInvite{
+							{ID: domain.NewCommunityInviteID(), Code: "ABCD2345"},
+							{ID: domain.NewCommunityInviteID(), Code: "EFGH6789"},
+						}, nil
+					},
+				}
+			},
+			wantCode:  http.StatusOK,
+			wantCount: 2,
+		},
+		{
+			name: "取得処理でエラーが発生した場合",
+			setupMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					GetActiveInvitesFunc: func(ctx context.Context, communityID string) ([]domain.CommunityInvite, error) {
+						return nil, fmt.Errorf("database error")
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			communityHandler := NewCommunityHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(communityHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/communities/test-id/invites", nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.wantCode == http.StatusOK {
+				var response struct {
+					Invites []api.CommunityInvite `json:"invites"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if len(response.Invites) != tt.wantCount {
+					t.Errorf("招待コードの数が違う: 期待=%d, 実際=%d", tt.wantCount, len(response.Invites))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+)
+
+// カード交換を取得
+// (GET /exchanges/{token})
+func (h *Handler) GetExchange(ctx context.Context, request api.GetExchangeRequestObject) (api.GetExchangeResponseObject, error) {
+	exchange, err := h.cardService.GetExchange(ctx, request.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange: %w", err)
+	}
+
+	return api.GetExchange200JSONResponse{Exchange: convertCardExchangeToAPI(*exchange)}, nil
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// カード交換取得のテスト
+func TestGetExchange(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		setupMock func() *service.MockCardService
+		wantCode  int
+		validate  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "受け取り済みの申し出を取得できる",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					GetExchangeFunc: func(ctx context.Context, token string) (*domain.CardExchange, error) {
+						if token != "token-1" {
+							return nil, fmt.Errorf("unexpected token: %s", token)
+						}
+						return newTestCardExchange(token, domain.CardExchangeStatusAccepted), nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Exchange api.CardExchange `json:"exchange"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if response.Exchange.Status != "accepted" {
+					t.Errorf("statusが違う: 期待=accepted, 実際=%s", response.Exchange.Status)
+				}
+				if response.Exchange.AcceptedCard == nil || response.Exchange.AcceptedCard.GithubId != "other_user" {
+					t.Errorf("acceptedCardが設定されていない")
+				}
+				if response.Exchange.AcceptedAt == nil {
+					t.Errorf("acceptedAtが設定されていない")
+				}
+			},
+		},
+		{
+			name: "申し出が存在しない場合",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					GetExchangeFunc: func(ctx context.Context, token string) (*domain.CardExchange, error) {
+						return nil, fmt.Errorf("failed to get exchange: %w", domain.ErrNotFound)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardHandler := NewCardHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/exchanges/token-1", nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+)
+
+// カード交換一覧取得
+// (GET /exchanges)
+func (h *Handler) GetExchanges(ctx context.Context, request api.GetExchangesRequestObject) (api.GetExchangesResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	exchanges, err := h.cardService.ListExchanges(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exchanges: %w", err)
+	}
+
+	apiExchanges := make([]api.CardExchange, 0, len(exchanges))
+	for _, exchange := range exchanges {
+		apiExchanges = append(apiExchanges, convertCardExchangeToAPI(exchange))
+	}
+
+	return api.GetExchanges200JSONResponse{Exchanges: apiExchanges}, nil
+}
@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// カード交換一覧取得のテスト
+func TestGetExchanges(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		setupMock func() *service.MockCardService
+		wantCode  int
+		wantCount int
+	}{
+		{
+			name: "自分のカード交換一覧を取得できる",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					ListExchangesFunc: func(ctx context.Context, githubID string) ([]domain.CardExchange, error) {
+						if githubID != "test_user" {
+							return nil, fmt.Errorf("unexpected githubID: %s", githubID)
+						}
+						return []domain.CardExchange{
+							*newTestCardExchange("token-1", domain.CardExchangeStatusPending),
+							*newTestCardExchange("token-2", domain.CardExchangeStatusAccepted),
+						}, nil
+					},
+				}
+			},
+			wantCode:  http.StatusOK,
+			wantCount: 2,
+		},
+		{
+			name: "カード交換がない場合は空配列を返す",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{}
+			},
+			wantCode:  http.StatusOK,
+			wantCount: 0,
+		},
+		{
+			name: "サービスでエラーが発生した場合",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					ListExchangesFunc: func(ctx context.Context, githubID string) ([]domain.CardExchange, error) {
+						return nil, fmt.Errorf("database error")
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardHandler := NewCardHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/exchanges", nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+			if w.Code != http.StatusOK {
+				return
+			}
+
+			var response struct {
+				Exchanges []api.CardExchange `json:"exchanges"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("JSONパースに失敗しました: %v", err)
+			}
+			if response.Exchanges == nil {
+				t.Errorf("exchangesがnullになっている")
+			}
+			if len(response.Exchanges) != tt.wantCount {
+				t.Errorf("件数が違う: 期待=%d, 実際=%d", tt.wantCount, len(response.Exchanges))
+			}
+		})
+	}
+}
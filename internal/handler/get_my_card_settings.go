@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+)
+
+// 自分のカードの設定を取得
+// (GET /cards/me/settings)
+func (h *Handler) GetMyCardSettings(ctx context.Context, request api.GetMyCardSettingsRequestObject) (api.GetMyCardSettingsResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	settings, err := h.cardService.GetMyCardSettings(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get my card settings: %w", err)
+	}
+
+	return api.GetMyCardSettings200JSONResponse{Settings: convertCardSettingsToAPI(*settings)}, nil
+}
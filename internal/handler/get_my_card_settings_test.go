@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// 自分のカードの設定取得のテスト
+func TestGetMyCardSettings(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		setupMock func() *service.MockCardService
+		wantCode  int
+		validate  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "正常に設定を取得できる",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					GetMyCardSettingsFunc: func(ctx context.Context, githubID string) (*domain.CardSettings, error) {
+						if githubID != "test_user" {
+							return nil, fmt.Errorf("unexpected githubID: %s", githubID)
+						}
+						return &domain.CardSettings{IncludePrivateRepositories: true}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Settings api.CardSettings `json:"settings"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if !response.Settings.IncludePrivateRepositories {
+					t.Errorf("includePrivateRepositoriesが違う: 期待=true, 実際=false")
+				}
+			},
+		},
+		{
+			name: "設定の取得に失敗した場合",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					GetMyCardSettingsFunc: func(ctx context.Context, githubID string) (*domain.CardSettings, error) {
+						return nil, fmt.Errorf("database error")
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardHandler := NewCardHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/cards/me/settings", nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+)
+
+// 自分のカードの共有ペイロードを発行
+// (GET /cards/me/share)
+func (h *Handler) GetMyCardShare(ctx context.Context, request api.GetMyCardShareRequestObject) (api.GetMyCardShareResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	cardShare, err := h.cardService.ShareMyCard(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to share my card: %w", err)
+	}
+
+	return api.GetMyCardShare200JSONResponse{Share: convertCardShareToAPI(*cardShare)}, nil
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// 自分のカードの共有ペイロード発行のテスト
+func TestGetMyCardShare(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		setupMock func() *service.MockCardService
+		wantCode  int
+		validate  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "署名付きペイロードを発行できる",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					ShareMyCardFunc: func(ctx context.Context, githubID string) (*domain.CardShare, error) {
+						if githubID != "test_user" {
+							return nil, fmt.Errorf("unexpected githubID: %s", githubID)
+						}
+						return &domain.CardShare{
+							GithubID:  githubID,
+							IssuedAt:  time.Now(),
+							ExpiresAt: time.Now().Add(10 * time.Minute),
+							Payload:   "v1.body.signature",
+						}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Share api.CardShare `json:"share"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if response.Share.Payload != "v1.body.signature" {
+					t.Errorf("payloadが違う: 期待=v1.body.signature, 実際=%s", response.Share.Payload)
+				}
+				if response.Share.ExpiresAt.IsZero() {
+					t.Errorf("expiresAtが設定されていない")
+				}
+			},
+		},
+		{
+			name: "自分のカードがない場合",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					ShareMyCardFunc: func(ctx context.Context, githubID string) (*domain.CardShare, error) {
+						return nil, fmt.Errorf("my card not found: %w", domain.ErrNotFound)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardHandler := NewCardHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/cards/me/share", nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 // 自分の統計情報取得
@@ -20,8 +21,17 @@ func (h *Handler) GetMyStats(ctx context.Context, request api.GetMyStatsRequestO
 		return nil, err
 	}
 
+	// クエリパラメータから集計する条件を作成
+	query := domain.StatsQuery{
+		From: request.Params.From,
+		To:   request.Params.To,
+	}
+	if request.Params.Timezone != nil {
+		query.Timezone = *request.Params.Timezone
+	}
+
 	// 統計情報を取得
-	stats, err := h.statsService.GetUserStats(ctx, githubID, githubClient)
+	stats, err := h.statsService.GetUserStats(ctx, githubID, query, githubClient)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"context"
+
+	api "github.com/furarico/octo-deck-api/generated"
+)
+
+// 自分の統計情報の履歴取得
+func (h *Handler) GetMyStatsHistory(ctx context.Context, request api.GetMyStatsHistoryRequestObject) (api.GetMyStatsHistoryResponseObject, error) {
+	// GitHub IDを取得
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 保存済みの記録から期間の統計情報を求める
+	history, err := h.statsService.GetContributionHistory(ctx, githubID, request.Params.From.Time, request.Params.To.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetMyStatsHistory200JSONResponse{
+		History: convertContributionHistoryToAPI(history),
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 // ユーザーの統計情報取得
@@ -17,8 +18,17 @@ func (h *Handler) GetUserStats(ctx context.Context, request api.GetUserStatsRequ
 		return nil, err
 	}
 
+	// クエリパラメータから集計する条件を作成
+	query := domain.StatsQuery{
+		From: request.Params.From,
+		To:   request.Params.To,
+	}
+	if request.Params.Timezone != nil {
+		query.Timezone = *request.Params.Timezone
+	}
+
 	// 統計情報を取得
-	stats, err := h.statsService.GetUserStats(ctx, githubID, githubClient)
+	stats, err := h.statsService.GetUserStats(ctx, githubID, query, githubClient)
 	if err != nil {
 		return nil, err
 	}
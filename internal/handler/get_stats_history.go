@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"context"
+
+	api "github.com/furarico/octo-deck-api/generated"
+)
+
+// ユーザーの統計情報の履歴取得
+func (h *Handler) GetUserStatsHistory(ctx context.Context, request api.GetUserStatsHistoryRequestObject) (api.GetUserStatsHistoryResponseObject, error) {
+	// 保存済みの記録から期間の統計情報を求める
+	history, err := h.statsService.GetContributionHistory(ctx, request.GithubId, request.Params.From.Time, request.Params.To.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.GetUserStatsHistory200JSONResponse{
+		History: convertContributionHistoryToAPI(history),
+	}, nil
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// ユーザーの統計情報の履歴取得のテスト
+func TestGetUserStatsHistory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		query     string
+		setupMock func() *service.MockStatsService
+		wantCode  int
+		validate  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "正常に統計情報の履歴を取得できる",
+			query: "?from=2022-01-01&to=2024-12-31",
+			setupMock: func() *service.MockStatsService {
+				return &service.MockStatsService{
+					GetContributionHistoryFunc: func(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionHistory, error) {
+						if githubID != "123" || from.Year() != 2022 || to.Year() != 2024 {
+							return nil, fmt.Errorf("unexpected args: %s %v %v", githubID, from, to)
+						}
+						return &domain.ContributionHistory{
+							From: from,
+							To:   to,
+							Contributions: []domain.Contribution{
+								{Date: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Count: 5},
+								{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Count: 3},
+							},
+							TotalContribution: 8,
+							Detail: &domain.ContributionDetailSnapshot{
+								From:               time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+								To:                 time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+								TotalContribution:  8,
+								ContributionDetail: domain.ContributionDetail{CommitCount: 6},
+							},
+						}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					History api.ContributionHistory `json:"history"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Fatalf("JSONパースに失敗しました: %v", err)
+				}
+				if len(response.History.Contributions) != 2 || response.History.TotalContribution != 8 {
+					t.Errorf("履歴が違う: %+v", response.History)
+				}
+				if response.History.ContributionDetail == nil || response.History.ContributionDetail.ContributionDetail.CommitCount != 6 {
+					t.Errorf("内訳が違う: %+v", response.History.ContributionDetail)
+				}
+			},
+		},
+		{
+			name:      "期間を指定しない場合は400を返す",
+			query:     "",
+			setupMock: service.NewMockStatsService,
+			wantCode:  http.StatusBadRequest,
+		},
+		{
+			name:  "期間が不正な場合は400を返す",
+			query: "?from=2024-12-31&to=2024-01-01",
+			setupMock: func() *service.MockStatsService {
+				return &service.MockStatsService{
+					GetContributionHistoryFunc: func(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionHistory, error) {
+						return nil, fmt.Errorf("%w: from must not be after to", domain.ErrInvalidArgument)
+					},
+				}
+			},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statsHandler := NewStatsHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(statsHandler, []api.StrictMiddlewareFunc{ErrorHandlerMiddleware})
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", "/stats/123/history"+tt.query, nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d, body=%s", tt.wantCode, w.Code, w.Body.String())
+			}
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
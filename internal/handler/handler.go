@@ -2,7 +2,8 @@ package handler
 
 import (
 	"context"
-	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/furarico/octo-deck-api/internal/domain"
@@ -24,29 +25,49 @@ const (
 
 // CardServiceInterface はハンドラーが必要とするサービスのインターフェース
 type CardServiceInterface interface {
-	GetAllCards(ctx context.Context, githubID string) ([]domain.Card, error)
+	ListCards(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error)
 	GetCardByGitHubID(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error)
+	GetIdenticonImage(ctx context.Context, githubID string, format domain.ImageFormat, opts domain.ImageOptions) (*domain.Image, error)
+	GetCardImage(ctx context.Context, githubID string, format domain.ImageFormat, totalContribution *int) (*domain.Image, error)
 	GetMyCard(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error)
 	GetOrCreateMyCard(ctx context.Context, githubID string, nodeID string, githubClient service.GitHubClient) (*domain.Card, error)
-	AddCardToDeck(ctx context.Context, collectorGithubID string, targetGithubID string, githubClient service.GitHubClient) (*domain.Card, error)
+	AddCardToDeck(ctx context.Context, collectorGithubID string, payload string, detail domain.CollectDetail, githubClient service.GitHubClient) (*domain.Card, error)
 	RemoveCardFromDeck(ctx context.Context, collectorGithubID string, targetGithubID string, githubClient service.GitHubClient) (*domain.Card, error)
+	ShareMyCard(ctx context.Context, githubID string) (*domain.CardShare, error)
+	GetMyCardSettings(ctx context.Context, githubID string) (*domain.CardSettings, error)
+	UpdateMyCardSettings(ctx context.Context, githubID string, settings domain.CardSettings, githubClient service.GitHubClient) (*domain.Card, error)
 	RefreshAllCards(ctx context.Context, githubClient service.GitHubClient) ([]domain.Card, error)
+	CreateExchange(ctx context.Context, githubID string, expiresIn time.Duration) (*domain.CardExchange, error)
+	GetExchange(ctx context.Context, token string) (*domain.CardExchange, error)
+	ListExchanges(ctx context.Context, githubID string) ([]domain.CardExchange, error)
+	AcceptExchange(ctx context.Context, token string, githubID string, detail domain.CollectDetail) (*domain.CardExchange, error)
 }
 
 // StatsServiceInterface はハンドラーが必要とする統計サービスのインターフェース
 type StatsServiceInterface interface {
-	GetUserStats(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Stats, error)
+	GetUserStats(ctx context.Context, githubID string, query domain.StatsQuery, githubClient service.GitHubClient) (*domain.Stats, error)
+	GetStatsSnapshot(ctx context.Context, githubID string) (*domain.Stats, error)
+	GetContributionHistory(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionHistory, error)
 }
 
 // CommunityServiceInterface はハンドラーが必要とするコミュニティサービスのインターフェース
 type CommunityServiceInterface interface {
-	GetAllCommunities(ctx context.Context, githubID string) ([]domain.Community, error)
+	ListCommunities(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error)
 	GetCommunityByID(ctx context.Context, id string) (*domain.Community, error)
 	GetCommunityWithHighlightedCard(ctx context.Context, id string) (*domain.Community, *domain.HighlightedCard, error)
 	RefreshHighlightedCard(ctx context.Context, id string, githubClient service.GitHubClient) (*domain.Community, *domain.HighlightedCard, error)
-	GetCommunityCards(ctx context.Context, id string) ([]domain.Card, error)
-	CreateCommunityWithPeriod(ctx context.Context, name string, startDateTime, endDateTime time.Time) (*domain.Community, error)
-	DeleteCommunity(ctx context.Context, id string) error
+	ListCommunityCards(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error)
+	CreateCommunityWithPeriod(ctx context.Context, name string, ownerGithubID string, startDateTime, endDateTime time.Time) (*domain.Community, error)
+	UpdateCommunity(ctx context.Context, id string, name *string, startDateTime, endDateTime *time.Time) (*domain.Community, error)
+	DeleteCommunity(ctx context.Context, id string, permanent bool) error
+	RestoreCommunity(ctx context.Context, id string, githubID string) (*domain.Community, error)
+	AuthorizeMember(ctx context.Context, communityID string, githubID string, required domain.CommunityRole) error
+	TransferOwnership(ctx context.Context, communityID string, currentOwnerGithubID string, newOwnerGithubID string) (*domain.Community, error)
+	UpdateMemberRole(ctx context.Context, communityID string, targetGithubID string, role domain.CommunityRole) (*domain.CommunityMember, error)
+	CreateInvite(ctx context.Context, communityID string, createdByGithubID string, expiresIn time.Duration, singleUse bool) (*domain.CommunityInvite, error)
+	GetActiveInvites(ctx context.Context, communityID string) ([]domain.CommunityInvite, error)
+	RevokeInvite(ctx context.Context, communityID string, code string) (*domain.CommunityInvite, error)
+	JoinByInvite(ctx context.Context, code string, cardID string) (*domain.Community, error)
 	AddCardToCommunity(ctx context.Context, communityID string, cardID string) error
 	RemoveCardFromCommunity(ctx context.Context, communityID string, cardID string) error
 }
@@ -90,7 +111,7 @@ func getGitHubClient(ctx context.Context) (service.GitHubClient, error) {
 	reqCtx := getRequestContext(ctx)
 	client, ok := reqCtx.Value(GitHubClientKey).(service.GitHubClient)
 	if !ok {
-		return nil, errors.New("github_client not found in context")
+		return nil, fmt.Errorf("github_client not found in context: %w", domain.ErrUnauthorized)
 	}
 	return client, nil
 }
@@ -100,7 +121,7 @@ func getGitHubID(ctx context.Context) (string, error) {
 	reqCtx := getRequestContext(ctx)
 	id, ok := reqCtx.Value(GitHubIDKey).(string)
 	if !ok || id == "" {
-		return "", errors.New("github_id not found in context")
+		return "", fmt.Errorf("github_id not found in context: %w", domain.ErrUnauthorized)
 	}
 	return id, nil
 }
@@ -110,7 +131,25 @@ func getGitHubNodeID(ctx context.Context) (string, error) {
 	reqCtx := getRequestContext(ctx)
 	nodeID, ok := reqCtx.Value(GitHubNodeIDKey).(string)
 	if !ok || nodeID == "" {
-		return "", errors.New("github_node_id not found in context")
+		return "", fmt.Errorf("github_node_id not found in context: %w", domain.ErrUnauthorized)
 	}
 	return nodeID, nil
 }
+
+// 画像のキャッシュの有効期間。期間が過ぎた後もETagで再検証できる
+const imageCacheControl = "public, max-age=3600"
+
+// matchesETag は If-None-Match ヘッダーが画像のETagと一致するかを返す
+// カンマ区切りの複数の値・弱いETag（W/）・"*" に対応する
+func matchesETag(ifNoneMatch *string, etag string) bool {
+	if ifNoneMatch == nil {
+		return false
+	}
+	for _, candidate := range strings.Split(*ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -77,7 +77,7 @@ func TestGetGitHubClient(t *testing.T) {
 				return context.Background()
 			},
 			wantErr:    true,
-			wantErrMsg: "github_client not found in context",
+			wantErrMsg: "github_client not found in context: unauthorized",
 		},
 		{
 			name: "型が一致しない場合はエラー",
@@ -86,7 +86,7 @@ func TestGetGitHubClient(t *testing.T) {
 				return context.WithValue(ctx, GitHubClientKey, "invalid_type")
 			},
 			wantErr:    true,
-			wantErrMsg: "github_client not found in context",
+			wantErrMsg: "github_client not found in context: unauthorized",
 		},
 		{
 			name: "gin.Contextから正常に取得できる",
@@ -152,7 +152,7 @@ func TestGetGitHubID(t *testing.T) {
 				return context.Background()
 			},
 			wantErr:    true,
-			wantErrMsg: "github_id not found in context",
+			wantErrMsg: "github_id not found in context: unauthorized",
 		},
 		{
 			name: "空文字列の場合はエラー",
@@ -161,7 +161,7 @@ func TestGetGitHubID(t *testing.T) {
 				return context.WithValue(ctx, GitHubIDKey, "")
 			},
 			wantErr:    true,
-			wantErrMsg: "github_id not found in context",
+			wantErrMsg: "github_id not found in context: unauthorized",
 		},
 		{
 			name: "型が一致しない場合はエラー",
@@ -170,7 +170,7 @@ func TestGetGitHubID(t *testing.T) {
 				return context.WithValue(ctx, GitHubIDKey, 12345)
 			},
 			wantErr:    true,
-			wantErrMsg: "github_id not found in context",
+			wantErrMsg: "github_id not found in context: unauthorized",
 		},
 		{
 			name: "gin.Contextから正常に取得できる",
@@ -236,7 +236,7 @@ func TestGetGitHubNodeID(t *testing.T) {
 				return context.Background()
 			},
 			wantErr:    true,
-			wantErrMsg: "github_node_id not found in context",
+			wantErrMsg: "github_node_id not found in context: unauthorized",
 		},
 		{
 			name: "空文字列の場合はエラー",
@@ -245,7 +245,7 @@ func TestGetGitHubNodeID(t *testing.T) {
 				return context.WithValue(ctx, GitHubNodeIDKey, "")
 			},
 			wantErr:    true,
-			wantErrMsg: "github_node_id not found in context",
+			wantErrMsg: "github_node_id not found in context: unauthorized",
 		},
 		{
 			name: "型が一致しない場合はエラー",
@@ -254,7 +254,7 @@ func TestGetGitHubNodeID(t *testing.T) {
 				return context.WithValue(ctx, GitHubNodeIDKey, 12345)
 			},
 			wantErr:    true,
-			wantErrMsg: "github_node_id not found in context",
+			wantErrMsg: "github_node_id not found in context: unauthorized",
 		},
 		{
 			name: "gin.Contextから正常に取得できる",
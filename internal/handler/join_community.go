@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/google/uuid"
+)
+
+// 招待コードでコミュニティに参加
+// (POST /communities/join)
+func (h *Handler) JoinCommunity(ctx context.Context, request api.JoinCommunityRequestObject) (api.JoinCommunityResponseObject, error) {
+	githubClient, err := getGitHubClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	if request.Body == nil || request.Body.Code == "" {
+		return nil, fmt.Errorf("%w: code is required", domain.ErrInvalidArgument)
+	}
+
+	// github_idから自分のカードを取得
+	card, err := h.cardService.GetMyCard(ctx, githubID, githubClient)
+	if err != nil {
+		return nil, fmt.Errorf("card not found: %w", err)
+	}
+
+	// 招待コードを使ってコミュニティにカードを追加
+	cardID := uuid.UUID(card.ID).String()
+	community, err := h.communityService.JoinByInvite(ctx, request.Body.Code, cardID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to join community: %w", err)
+	}
+
+	return api.JoinCommunity200JSONResponse{Community: convertCommunityToAPI(*community)}, nil
+}
@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// 招待コードでコミュニティに参加するテスト
+func TestJoinCommunity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name               string
+		body               string
+		setupCardMock      func() *service.MockCardService
+		setupCommunityMock func() *service.MockCommunityService
+		wantCode           int
+		validate           func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "正常に招待コードでコミュニティに参加できる",
+			body: `{"code": "ABCD2345"}`,
+			setupCardMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					GetMyCardFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
+						return &domain.Card{ID: domain.NewCardID(), GithubID: "test_user"}, nil
+					},
+				}
+			},
+			setupCommunityMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					JoinByInviteFunc: func(ctx context.Context, code string, cardID string) (*domain.Community, error) {
+						return &domain.Community{ID: domain.NewCommunityID(), Name: "Hackathon"}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Community api.Community `json:"community"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if response.Community.Name != "Hackathon" {
+					t.Errorf("コミュニティ名が違う: 期待=Hackathon, 実際=%s", response.Community.Name)
+				}
+			},
+		},
+		{
+			name: "招待コードが空の場合",
+			body: `{"code": ""}`,
+			setupCardMock: func() *service.MockCardService {
+				return &service.MockCardService{}
+			},
+			setupCommunityMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+		{
+			name: "招待コードが使えない場合",
+			body: `{"code": "ABCD2345"}`,
+			setupCardMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					GetMyCardFunc: func(ctx context.Context, githubID string, githubClient service.GitHubClient) (*domain.Card, error) {
+						return &domain.Card{ID: domain.NewCardID(), GithubID: "test_user"}, nil
+					},
+				}
+			},
+			setupCommunityMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					JoinByInviteFunc: func(ctx context.Context, code string, cardID string) (*domain.Community, error) {
+						return nil, fmt.Errorf("%w: invite code is expired", domain.ErrInvalidArgument)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewHandler(tt.setupCardMock(), tt.setupCommunityMock(), nil)
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(handler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/communities/join", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 // コミュニティのHighlightedCardを更新
@@ -15,6 +16,16 @@ func (h *Handler) RefreshCommunity(ctx context.Context, request api.RefreshCommu
 		return nil, fmt.Errorf("failed to get github client: %w", err)
 	}
 
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	// オーナーまたは管理者のみ更新できる
+	if err := h.communityService.AuthorizeMember(ctx, request.Id, githubID, domain.CommunityRoleAdmin); err != nil {
+		return nil, err
+	}
+
 	community, highlightedCard, err := h.communityService.RefreshHighlightedCard(ctx, request.Id, githubClient)
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh community: %w", err)
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+)
+
+// 削除したコミュニティを復元
+// (POST /communities/{id}/restore)
+func (h *Handler) RestoreCommunity(ctx context.Context, request api.RestoreCommunityRequestObject) (api.RestoreCommunityResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	// 削除されたコミュニティはメンバーとして扱えないので、オーナーの確認はService側で行う
+	community, err := h.communityService.RestoreCommunity(ctx, request.Id, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore community: %w", err)
+	}
+
+	return api.RestoreCommunity200JSONResponse{Community: convertCommunityToAPI(*community)}, nil
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// 削除したコミュニティの復元のテスト
+func TestRestoreCommunity(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		setupMock func() *service.MockCommunityService
+		wantCode  int
+		validate  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "正常にコミュニティを復元できる",
+			setupMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					RestoreCommunityFunc: func(ctx context.Context, id string, githubID string) (*domain.Community, error) {
+						return &domain.Community{
+							ID:            domain.NewCommunityID(),
+							Name:          "Test Community",
+							OwnerGithubID: githubID,
+						}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Community api.Community `json:"community"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if response.Community.Name != "Test Community" {
+					t.Errorf("コミュニティ名が違う: 期待=Test Community, 実際=%s", response.Community.Name)
+				}
+			},
+		},
+		{
+			name: "復元期間を過ぎている場合",
+			setupMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					RestoreCommunityFunc: func(ctx context.Context, id string, githubID string) (*domain.Community, error) {
+						return nil, fmt.Errorf("restore period has expired: %w", domain.ErrNotFound)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			communityHandler := NewCommunityHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(communityHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("POST", "/communities/test-id/restore", nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// コミュニティの招待コードを取り消し
+// (DELETE /communities/{id}/invites/{code})
+func (h *Handler) RevokeCommunityInvite(ctx context.Context, request api.RevokeCommunityInviteRequestObject) (api.RevokeCommunityInviteResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	// オーナーのみ招待コードを取り消せる
+	if err := h.communityService.AuthorizeMember(ctx, request.Id, githubID, domain.CommunityRoleOwner); err != nil {
+		return nil, err
+	}
+
+	invite, err := h.communityService.RevokeInvite(ctx, request.Id, request.Code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke invite: %w", err)
+	}
+
+	return api.RevokeCommunityInvite200JSONResponse{Invite: convertCommunityInviteToAPI(*invite)}, nil
+}
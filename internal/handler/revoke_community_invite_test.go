@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// コミュニティの招待コード取り消しのテスト
+func TestRevokeCommunityInvite(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		setupMock func() *service.MockCommunityService
+		wantCode  int
+	}{
+		{
+			name: "正常に招待コードを取り消せる",
+			setupMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					RevokeInviteFunc: func(ctx context.Context, communityID string, code string) (*domain.CommunityInvite, error) {
+						return &domain.CommunityInvite{ID: domain.NewCommunityInviteID(), Code: code}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "招待コードが見つからない場合",
+			setupMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					RevokeInviteFunc: func(ctx context.Context, communityID string, code string) (*domain.CommunityInvite, error) {
+						return nil, fmt.Errorf("invite not found: %w", domain.ErrNotFound)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			communityHandler := NewCommunityHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(communityHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("DELETE", "/communities/test-id/invites/ABCD2345", nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// コミュニティのオーナー権限を移譲
+// (PUT /communities/{id}/owner)
+func (h *Handler) TransferCommunityOwnership(ctx context.Context, request api.TransferCommunityOwnershipRequestObject) (api.TransferCommunityOwnershipResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	if request.Body == nil || request.Body.GithubId == "" {
+		return nil, fmt.Errorf("%w: githubId is required", domain.ErrInvalidArgument)
+	}
+
+	// オーナーのみ移譲できる
+	if err := h.communityService.AuthorizeMember(ctx, request.Id, githubID, domain.CommunityRoleOwner); err != nil {
+		return nil, err
+	}
+
+	community, err := h.communityService.TransferOwnership(ctx, request.Id, githubID, request.Body.GithubId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transfer ownership: %w", err)
+	}
+
+	return api.TransferCommunityOwnership200JSONResponse{Community: convertCommunityToAPI(*community)}, nil
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// コミュニティを編集
+// (PATCH /communities/{id})
+func (h *Handler) UpdateCommunity(ctx context.Context, request api.UpdateCommunityRequestObject) (api.UpdateCommunityResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	if request.Body == nil {
+		return nil, fmt.Errorf("%w: request body is required", domain.ErrInvalidArgument)
+	}
+
+	// オーナーまたは管理者のみ編集できる
+	if err := h.communityService.AuthorizeMember(ctx, request.Id, githubID, domain.CommunityRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	community, err := h.communityService.UpdateCommunity(
+		ctx,
+		request.Id,
+		request.Body.Name,
+		request.Body.StartDateTime,
+		request.Body.EndDateTime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update community: %w", err)
+	}
+
+	return api.UpdateCommunity200JSONResponse{Community: convertCommunityToAPI(*community)}, nil
+}
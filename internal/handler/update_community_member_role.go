@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// コミュニティのメンバーの役割を変更
+// (PUT /communities/{id}/members/{githubId}/role)
+func (h *Handler) UpdateCommunityMemberRole(ctx context.Context, request api.UpdateCommunityMemberRoleRequestObject) (api.UpdateCommunityMemberRoleResponseObject, error) {
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	if request.Body == nil {
+		return nil, fmt.Errorf("%w: request body is required", domain.ErrInvalidArgument)
+	}
+
+	// オーナーのみ役割を変更できる
+	if err := h.communityService.AuthorizeMember(ctx, request.Id, githubID, domain.CommunityRoleOwner); err != nil {
+		return nil, err
+	}
+
+	member, err := h.communityService.UpdateMemberRole(ctx, request.Id, request.GithubId, domain.CommunityRole(request.Body.Role))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update member role: %w", err)
+	}
+
+	return api.UpdateCommunityMemberRole200JSONResponse{Member: convertCommunityMemberToAPI(*member)}, nil
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// コミュニティのメンバーの役割変更のテスト
+func TestUpdateCommunityMemberRole(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		setupMock func() *service.MockCommunityService
+		wantCode  int
+		validate  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "正常にメンバーを管理者に昇格できる",
+			setupMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					UpdateMemberRoleFunc: func(ctx context.Context, communityID string, targetGithubID string, role domain.CommunityRole) (*domain.CommunityMember, error) {
+						return &domain.CommunityMember{
+							Card: domain.Card{ID: domain.NewCardID(), GithubID: targetGithubID},
+							Role: role,
+						}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Member api.CommunityMember `json:"member"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if response.Member.Role != string(domain.CommunityRoleAdmin) {
+					t.Errorf("役割が違う: 期待=admin, 実際=%s", response.Member.Role)
+				}
+			},
+		},
+		{
+			name: "オーナーでない場合",
+			setupMock: func() *service.MockCommunityService {
+				return &service.MockCommunityService{
+					AuthorizeMemberFunc: func(ctx context.Context, communityID string, githubID string, required domain.CommunityRole) error {
+						return fmt.Errorf("%w: owner role is required", domain.ErrForbidden)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.setupMock()
+			communityHandler := NewCommunityHandler(mockService)
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(communityHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", "/communities/test-id/members/member_user/role", bytes.NewBufferString(`{"role": "admin"}`))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
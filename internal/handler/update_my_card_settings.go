@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// 自分のカードの設定を変更
+// (PUT /cards/me/settings)
+func (h *Handler) UpdateMyCardSettings(ctx context.Context, request api.UpdateMyCardSettingsRequestObject) (api.UpdateMyCardSettingsResponseObject, error) {
+	githubClient, err := getGitHubClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	githubID, err := getGitHubID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unauthorized: %w", err)
+	}
+
+	if request.Body == nil {
+		return nil, fmt.Errorf("%w: request body is required", domain.ErrInvalidArgument)
+	}
+
+	settings := domain.CardSettings{
+		IncludePrivateRepositories: request.Body.IncludePrivateRepositories,
+	}
+	card, err := h.cardService.UpdateMyCardSettings(ctx, githubID, settings, githubClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update my card settings: %w", err)
+	}
+
+	return api.UpdateMyCardSettings200JSONResponse{
+		Settings: convertCardSettingsToAPI(card.Settings),
+		Card:     convertCardToAPI(*card),
+	}, nil
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/furarico/octo-deck-api/generated"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// 自分のカードの設定変更のテスト
+func TestUpdateMyCardSettings(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name      string
+		setupMock func() *service.MockCardService
+		wantCode  int
+		validate  func(t *testing.T, w *httptest.ResponseRecorder)
+	}{
+		{
+			name: "正常に設定を変更して言語を取り直したカードを返す",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					UpdateMyCardSettingsFunc: func(ctx context.Context, githubID string, settings domain.CardSettings, githubClient service.GitHubClient) (*domain.Card, error) {
+						if !settings.IncludePrivateRepositories {
+							return nil, fmt.Errorf("unexpected settings: %+v", settings)
+						}
+						return &domain.Card{
+							GithubID:         githubID,
+							MostUsedLanguage: domain.Language{LanguageName: "Rust", Color: "#dea584"},
+							TopLanguages: []domain.LanguageBreakdown{
+								{LanguageName: "Rust", Bytes: 2000, Percentage: 100, Color: "#dea584"},
+							},
+							Settings: settings,
+						}, nil
+					},
+				}
+			},
+			wantCode: http.StatusOK,
+			validate: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var response struct {
+					Settings api.CardSettings `json:"settings"`
+					Card     api.Card         `json:"card"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+					t.Errorf("JSONパースに失敗しました: %v", err)
+				}
+				if !response.Settings.IncludePrivateRepositories {
+					t.Errorf("includePrivateRepositoriesが違う: 期待=true, 実際=false")
+				}
+				if len(response.Card.TopLanguages) != 1 || response.Card.TopLanguages[0].Name != "Rust" {
+					t.Errorf("topLanguagesが違う: %+v", response.Card.TopLanguages)
+				}
+			},
+		},
+		{
+			name: "自分のカードがない場合",
+			setupMock: func() *service.MockCardService {
+				return &service.MockCardService{
+					UpdateMyCardSettingsFunc: func(ctx context.Context, githubID string, settings domain.CardSettings, githubClient service.GitHubClient) (*domain.Card, error) {
+						return nil, fmt.Errorf("my card not found: %w", domain.ErrNotFound)
+					},
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			validate: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardHandler := NewCardHandler(tt.setupMock())
+			router := gin.New()
+			router.Use(setTestContext)
+			strictHandler := api.NewStrictHandler(cardHandler, nil)
+			api.RegisterHandlers(router, strictHandler)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("PUT", "/cards/me/settings", bytes.NewBufferString(`{"includePrivateRepositories": true}`))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, w)
+			}
+		})
+	}
+}
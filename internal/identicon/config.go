@@ -0,0 +1,41 @@
+package identicon
+
+import (
+	"fmt"
+
+	"github.com/furarico/octo-deck-api/internal/config"
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// Config はIdenticonの生成の設定
+type Config struct {
+	// CurrentVersion は新しく作るカードに使う生成方法のバージョン
+	// 既存のカードは明示的にアップグレードしない限り保存されたバージョンのまま
+	CurrentVersion domain.IdenticonVersion
+}
+
+// LoadConfig は環境変数からIdenticonの生成の設定を読み込む
+func LoadConfig() (Config, error) {
+	cfg := Config{
+		CurrentVersion: domain.IdenticonVersionClassic,
+	}
+
+	version, err := config.Int("IDENTICON_VERSION", int(cfg.CurrentVersion))
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.CurrentVersion = domain.IdenticonVersion(version)
+
+	if err := cfg.validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+func (c Config) validate() error {
+	if c.CurrentVersion <= 0 {
+		return fmt.Errorf("IDENTICON_VERSION must be positive")
+	}
+	return nil
+}
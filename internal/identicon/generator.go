@@ -1,135 +1,61 @@
 package identicon
 
 import (
-	"crypto/md5"
-	"encoding/hex"
 	"fmt"
-	"strconv"
 
 	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
-const (
-	patternSize    = 5
-	patternHashLen = 15
-	minHashLength  = 32
-)
-
-// Generator はIdenticonを生成する実装
-type Generator struct{}
-
-func NewGenerator() *Generator {
-	return &Generator{}
+// Algorithm は1つのバージョンのIdenticonの生成方法
+// 同じGitHub IDからは常に同じIdenticonを生成する
+type Algorithm interface {
+	Generate(githubID string) (domain.Color, domain.Blocks, error)
 }
 
-func (g *Generator) Generate(githubID string) (domain.Color, domain.Blocks, error) {
-	hash := md5.Sum([]byte(githubID))
-	hashStr := hex.EncodeToString(hash[:])
-
-	pattern, err := createPattern(hashStr)
-	if err != nil {
-		return "", domain.Blocks{}, fmt.Errorf("failed to create pattern: %w", err)
-	}
-
-	color, err := createColor(hashStr)
-	if err != nil {
-		return "", domain.Blocks{}, fmt.Errorf("failed to create color: %w", err)
+// BuiltinAlgorithms は組み込みの生成方法をバージョンごとに返す
+// 一度公開したバージョンの生成方法は変更せず、変えたい場合は新しいバージョンを追加する
+func BuiltinAlgorithms() map[domain.IdenticonVersion]Algorithm {
+	return map[domain.IdenticonVersion]Algorithm{
+		domain.IdenticonVersionClassic: ClassicAlgorithm{},
+		domain.IdenticonVersionGrid7:   GridAlgorithm{Size: 7, Palette: DefaultPalette},
+		domain.IdenticonVersionWinter:  GridAlgorithm{Size: 7, Palette: WinterPalette},
 	}
-
-	var blocks domain.Blocks
-	for i := 0; i < patternSize; i++ {
-		for j := 0; j < patternSize; j++ {
-			blocks[i][j] = pattern[i][j] == 1
-		}
-	}
-
-	return color, blocks, nil
 }
 
-func createPattern(hashValue string) ([][]int, error) {
-	if len(hashValue) < patternHashLen {
-		return nil, fmt.Errorf("hash must be at least %d characters", patternHashLen)
-	}
-
-	pat := make([][]int, patternSize)
-	for i := range pat {
-		pat[i] = make([]int, patternSize)
-	}
-
-	for i := 0; i < patternHashLen; i++ {
-		val, err := strconv.ParseInt(string(hashValue[i]), 16, 32)
-		if err != nil {
-			return nil, fmt.Errorf("invalid hex character at position %d: %w", i, err)
-		}
-
-		bit := 1 - int(val%2)
-		row := i % patternSize
-		col := i / patternSize
-
-		switch col {
-		case 0:
-			pat[row][2] = bit
-		case 1:
-			pat[row][1], pat[row][3] = bit, bit
-		case 2:
-			pat[row][0], pat[row][4] = bit, bit
-		}
-	}
-
-	return pat, nil
+// Generator はバージョンごとの生成方法を切り替えてIdenticonを生成する実装
+type Generator struct {
+	algorithms map[domain.IdenticonVersion]Algorithm
+	current    domain.IdenticonVersion
 }
 
-func hslToRGB(h, s, l float64) (r, g, b float64) {
-	var max, min float64
-	if l < 50 {
-		max = 2.55 * (l + l*(s/100))
-		min = 2.55 * (l - l*(s/100))
-	} else {
-		max = 2.55 * (l + (100-l)*(s/100))
-		min = 2.55 * (l - (100-l)*(s/100))
+// NewGenerator は生成方法を登録したGeneratorを作る
+// 新しく作るカードには cfg.CurrentVersion の生成方法を使う
+func NewGenerator(cfg Config, algorithms map[domain.IdenticonVersion]Algorithm) (*Generator, error) {
+	if _, ok := algorithms[cfg.CurrentVersion]; !ok {
+		return nil, fmt.Errorf("identicon version %d is not registered", cfg.CurrentVersion)
 	}
 
-	switch {
-	case h < 60:
-		return max, (h/60)*(max-min) + min, min
-	case h < 120:
-		return ((120-h)/60)*(max-min) + min, max, min
-	case h < 180:
-		return min, max, ((h-120)/60)*(max-min) + min
-	case h < 240:
-		return min, ((240-h)/60)*(max-min) + min, max
-	case h < 300:
-		return ((h-240)/60)*(max-min) + min, min, max
-	default:
-		return max, min, ((360-h)/60)*(max-min) + min
-	}
+	return &Generator{
+		algorithms: algorithms,
+		current:    cfg.CurrentVersion,
+	}, nil
 }
 
-func createColor(hashValue string) (domain.Color, error) {
-	if len(hashValue) < minHashLength {
-		return "", fmt.Errorf("hash must be at least %d characters", minHashLength)
+// Generate は指定されたバージョンの生成方法でIdenticonを生成する
+func (g *Generator) Generate(githubID string, version domain.IdenticonVersion) (domain.Color, domain.Blocks, error) {
+	algorithm, ok := g.algorithms[version]
+	if !ok {
+		return "", nil, fmt.Errorf("%w: unknown identicon version: %d", domain.ErrInvalidArgument, version)
 	}
 
-	hueInt, err := strconv.ParseInt(hashValue[25:28], 16, 64)
+	color, blocks, err := algorithm.Generate(githubID)
 	if err != nil {
-		return "", fmt.Errorf("invalid hue hex: %w", err)
+		return "", nil, fmt.Errorf("failed to generate identicon version %d: %w", version, err)
 	}
-	hue := float64(hueInt) / 4095 * 360
-
-	satInt, err := strconv.ParseInt(hashValue[28:30], 16, 64)
-	if err != nil {
-		return "", fmt.Errorf("invalid saturation hex: %w", err)
-	}
-	sat := 65 - float64(satInt)/255*20
-
-	lumInt, err := strconv.ParseInt(hashValue[30:32], 16, 64)
-	if err != nil {
-		return "", fmt.Errorf("invalid luminosity hex: %w", err)
-	}
-	lum := 75 - float64(lumInt)/255*20
-
-	r, g, b := hslToRGB(hue, sat, lum)
+	return color, blocks, nil
+}
 
-	colorHex := fmt.Sprintf("#%02x%02x%02x", int(r), int(g), int(b))
-	return domain.Color(colorHex), nil
+// CurrentVersion は新しく作るカードに使うバージョンを返す
+func (g *Generator) CurrentVersion() domain.IdenticonVersion {
+	return g.current
 }
@@ -1,11 +1,21 @@
 package identicon
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
+func newTestGenerator(t *testing.T) *Generator {
+	t.Helper()
+	g, err := NewGenerator(Config{CurrentVersion: domain.IdenticonVersionClassic}, BuiltinAlgorithms())
+	if err != nil {
+		t.Fatalf("NewGenerator() error = %v", err)
+	}
+	return g
+}
+
 func TestGenerator_Generate(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -29,15 +39,18 @@ func TestGenerator_Generate(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			g := NewGenerator()
-			color, blocks, err := g.Generate(tt.githubID)
+			g := newTestGenerator(t)
+			color, blocks, err := g.Generate(tt.githubID, domain.IdenticonVersionClassic)
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
 
 			// blocksの検証
-			for i := 0; i < 5; i++ {
-				for j := 0; j < 5; j++ {
+			if len(blocks) != len(tt.expectedBlocks) {
+				t.Fatalf("len(blocks) = %d, want %d", len(blocks), len(tt.expectedBlocks))
+			}
+			for i := range tt.expectedBlocks {
+				for j := range tt.expectedBlocks[i] {
 					if blocks[i][j] != tt.expectedBlocks[i][j] {
 						t.Errorf("blocks[%d][%d] = %v, want %v", i, j, blocks[i][j], tt.expectedBlocks[i][j])
 					}
@@ -51,3 +64,77 @@ func TestGenerator_Generate(t *testing.T) {
 		})
 	}
 }
+
+// バージョンごとに決まった大きさと色の候補で、同じGitHub IDからは同じIdenticonを生成する
+func TestGenerator_GenerateVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  domain.IdenticonVersion
+		wantSize int
+		palette  Palette
+	}{
+		{name: "7x7", version: domain.IdenticonVersionGrid7, wantSize: 7, palette: DefaultPalette},
+		{name: "冬のイベント", version: domain.IdenticonVersionWinter, wantSize: 7, palette: WinterPalette},
+	}
+
+	g := newTestGenerator(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			color, blocks, err := g.Generate("136790650", tt.version)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if len(blocks) != tt.wantSize {
+				t.Fatalf("len(blocks) = %d, want %d", len(blocks), tt.wantSize)
+			}
+			for i, row := range blocks {
+				if len(row) != tt.wantSize {
+					t.Fatalf("len(blocks[%d]) = %d, want %d", i, len(row), tt.wantSize)
+				}
+				for j := range row {
+					if row[j] != row[tt.wantSize-1-j] {
+						t.Errorf("blocks[%d] is not mirrored: %v", i, row)
+						break
+					}
+				}
+			}
+
+			found := false
+			for _, c := range tt.palette {
+				if c == color {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("color %s is not in the palette", color)
+			}
+
+			againColor, againBlocks, err := g.Generate("136790650", tt.version)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if againColor != color {
+				t.Errorf("color changed between calls: %s, %s", color, againColor)
+			}
+			for i := range blocks {
+				for j := range blocks[i] {
+					if blocks[i][j] != againBlocks[i][j] {
+						t.Fatalf("blocks changed between calls")
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_UnknownVersion(t *testing.T) {
+	g := newTestGenerator(t)
+	if _, _, err := g.Generate("136790650", 99); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Errorf("Generate() error = %v, want %v", err, domain.ErrInvalidArgument)
+	}
+
+	if _, err := NewGenerator(Config{CurrentVersion: 99}, BuiltinAlgorithms()); err == nil {
+		t.Error("NewGenerator() with unregistered current version should fail")
+	}
+}
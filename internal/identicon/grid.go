@@ -0,0 +1,62 @@
+package identicon
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// Palette はIdenticonの色の候補
+type Palette []domain.Color
+
+// DefaultPalette はバージョン2で使う色の候補
+// 白い背景でも暗い背景でも見やすい彩度と明るさの色を選んでいる
+var DefaultPalette = Palette{
+	"#e5534b", "#f69d50", "#daaa3f", "#57ab5a",
+	"#39c5cf", "#539bf5", "#8058d7", "#b083f0",
+	"#e275ad", "#c96198", "#6cb6ff", "#46954a",
+}
+
+// WinterPalette は冬のイベント用の色の候補
+var WinterPalette = Palette{
+	"#6cb6ff", "#96d0ff", "#39c5cf", "#4184e4",
+	"#b083f0", "#dcbdfb", "#c6e6ff", "#2f81f7",
+}
+
+// GridAlgorithm はSHA-256から一辺が Size の左右対称の模様を作り、色を Palette から選ぶ生成方法
+type GridAlgorithm struct {
+	Size    int
+	Palette Palette
+}
+
+func (a GridAlgorithm) Generate(githubID string) (domain.Color, domain.Blocks, error) {
+	if a.Size <= 0 || a.Size > domain.MaxBlocksSize {
+		return "", nil, fmt.Errorf("grid size must be between 1 and %d", domain.MaxBlocksSize)
+	}
+	if len(a.Palette) == 0 {
+		return "", nil, fmt.Errorf("palette must not be empty")
+	}
+
+	hash := sha256.Sum256([]byte(githubID))
+
+	// 左半分（中央の列を含む）をハッシュのビットで決め、右半分に反転して写す
+	half := (a.Size + 1) / 2
+	if a.Size*half > 8*(len(hash)-4) {
+		return "", nil, fmt.Errorf("grid size %d is too large for the hash", a.Size)
+	}
+	blocks := domain.NewBlocks(a.Size)
+	for i := 0; i < a.Size; i++ {
+		for j := 0; j < half; j++ {
+			bit := i*half + j
+			filled := hash[bit/8]&(1<<(bit%8)) != 0
+			blocks[i][j] = filled
+			blocks[i][a.Size-1-j] = filled
+		}
+	}
+
+	// 色は模様に使っていない末尾の4バイトから選ぶ
+	index := binary.BigEndian.Uint32(hash[len(hash)-4:]) % uint32(len(a.Palette))
+	return a.Palette[index], blocks, nil
+}
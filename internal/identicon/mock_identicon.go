@@ -5,12 +5,31 @@ import (
 )
 
 type MockIdenticonGenerator struct {
-	GenerateFunc func(githubID string) (domain.Color, domain.Blocks, error)
+	GenerateFunc       func(githubID string, version domain.IdenticonVersion) (domain.Color, domain.Blocks, error)
+	CurrentVersionFunc func() domain.IdenticonVersion
 }
 
-func (g *MockIdenticonGenerator) Generate(githubID string) (domain.Color, domain.Blocks, error) {
+func (g *MockIdenticonGenerator) Generate(githubID string, version domain.IdenticonVersion) (domain.Color, domain.Blocks, error) {
 	if g.GenerateFunc != nil {
-		return g.GenerateFunc(githubID)
+		return g.GenerateFunc(githubID, version)
 	}
-	return domain.Color("#000000"), domain.Blocks{}, nil
+	return domain.Color("#000000"), domain.NewBlocks(5), nil
+}
+
+func (g *MockIdenticonGenerator) CurrentVersion() domain.IdenticonVersion {
+	if g.CurrentVersionFunc != nil {
+		return g.CurrentVersionFunc()
+	}
+	return domain.IdenticonVersionClassic
+}
+
+type MockRenderer struct {
+	RenderFunc func(color domain.Color, blocks domain.Blocks, format domain.ImageFormat, opts domain.ImageOptions) ([]byte, error)
+}
+
+func (r *MockRenderer) Render(color domain.Color, blocks domain.Blocks, format domain.ImageFormat, opts domain.ImageOptions) ([]byte, error) {
+	if r.RenderFunc != nil {
+		return r.RenderFunc(color, blocks, format, opts)
+	}
+	return []byte("image"), nil
 }
@@ -0,0 +1,124 @@
+package identicon
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strconv"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// Renderer はIdenticonをSVG・PNGの画像として描画する実装
+type Renderer struct{}
+
+func NewRenderer() *Renderer {
+	return &Renderer{}
+}
+
+// Render は色とブロックからIdenticonを描画する
+// opts は domain.ImageOptions.WithDefaults で既定値を埋めたものを渡す
+func (r *Renderer) Render(fg domain.Color, blocks domain.Blocks, format domain.ImageFormat, opts domain.ImageOptions) ([]byte, error) {
+	switch format {
+	case domain.ImageFormatSVG:
+		return renderSVG(fg, blocks, opts)
+	case domain.ImageFormatPNG:
+		return renderPNG(fg, blocks, opts)
+	default:
+		return nil, fmt.Errorf("%w: unsupported image format: %s", domain.ErrInvalidArgument, format)
+	}
+}
+
+// cellBounds は一辺が n 個のブロックのうち i 番目のブロックの開始位置と終了位置（px）を返す
+// 端数が出ないように整数で区切り、隣り合うブロックの間に隙間ができないようにする
+func cellBounds(i, n int, opts domain.ImageOptions) (int, int) {
+	padding := paddingOf(opts)
+	inner := opts.Size - 2*padding
+	return padding + i*inner/n, padding + (i+1)*inner/n
+}
+
+func paddingOf(opts domain.ImageOptions) int {
+	if opts.Padding == nil {
+		return 0
+	}
+	return *opts.Padding
+}
+
+func renderSVG(fg domain.Color, blocks domain.Blocks, opts domain.ImageOptions) ([]byte, error) {
+	fill, err := domain.ParseColor(string(fg))
+	if err != nil {
+		return nil, err
+	}
+
+	size := strconv.Itoa(opts.Size)
+	var buf bytes.Buffer
+	buf.WriteString(`<svg xmlns="http://www.w3.org/2000/svg" width="` + size + `" height="` + size + `" viewBox="0 0 ` + size + ` ` + size + `" shape-rendering="crispEdges">`)
+	if opts.Background != "" {
+		fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="%s"/>`, opts.Size, opts.Size, opts.Background)
+	}
+	fmt.Fprintf(&buf, `<g fill="%s">`, fill)
+	for i, row := range blocks {
+		y0, y1 := cellBounds(i, len(blocks), opts)
+		for j, filled := range row {
+			if !filled {
+				continue
+			}
+			x0, x1 := cellBounds(j, len(blocks), opts)
+			fmt.Fprintf(&buf, `<rect x="%d" y="%d" width="%d" height="%d"/>`, x0, y0, x1-x0, y1-y0)
+		}
+	}
+	buf.WriteString(`</g></svg>`)
+
+	return buf.Bytes(), nil
+}
+
+func renderPNG(fg domain.Color, blocks domain.Blocks, opts domain.ImageOptions) ([]byte, error) {
+	fill, err := parseColor(fg)
+	if err != nil {
+		return nil, err
+	}
+
+	img := image.NewNRGBA(image.Rect(0, 0, opts.Size, opts.Size))
+	if opts.Background != "" {
+		bg, err := parseColor(opts.Background)
+		if err != nil {
+			return nil, err
+		}
+		draw.Draw(img, img.Bounds(), image.NewUniform(bg), image.Point{}, draw.Src)
+	}
+
+	src := image.NewUniform(fill)
+	for i, row := range blocks {
+		y0, y1 := cellBounds(i, len(blocks), opts)
+		for j, filled := range row {
+			if !filled {
+				continue
+			}
+			x0, x1 := cellBounds(j, len(blocks), opts)
+			draw.Draw(img, image.Rect(x0, y0, x1, y1), src, image.Point{}, draw.Src)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("failed to encode png: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// parseColor は "#rrggbb" 形式の色を描画用の色に変換する
+func parseColor(c domain.Color) (color.NRGBA, error) {
+	normalized, err := domain.ParseColor(string(c))
+	if err != nil {
+		return color.NRGBA{}, err
+	}
+
+	v, err := strconv.ParseUint(string(normalized[1:]), 16, 32)
+	if err != nil {
+		return color.NRGBA{}, fmt.Errorf("invalid color %s: %w", c, err)
+	}
+	return color.NRGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}, nil
+}
@@ -0,0 +1,130 @@
+package identicon
+
+import (
+	"bytes"
+	"errors"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// 左上と中央だけが塗られたテスト用のブロック
+var testBlocks = domain.Blocks{
+	{true, false, false, false, false},
+	{false, false, false, false, false},
+	{false, false, true, false, false},
+	{false, false, false, false, false},
+	{false, false, false, false, false},
+}
+
+func newTestImageOptions(t *testing.T, opts domain.ImageOptions) domain.ImageOptions {
+	t.Helper()
+	opts, err := opts.WithDefaults()
+	if err != nil {
+		t.Fatalf("WithDefaults() error = %v", err)
+	}
+	return opts
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestRenderer_RenderSVG(t *testing.T) {
+	tests := []struct {
+		name         string
+		opts         domain.ImageOptions
+		wantContains []string
+		wantMissing  []string
+	}{
+		{
+			name: "余白と背景を指定して描画できる",
+			opts: domain.ImageOptions{Size: 70, Padding: intPtr(10), Background: "FFF"},
+			wantContains: []string{
+				`width="70" height="70"`,
+				`<rect width="70" height="70" fill="#ffffff"/>`,
+				`<g fill="#8058d7">`,
+				`<rect x="10" y="10" width="10" height="10"/>`,
+				`<rect x="30" y="30" width="10" height="10"/>`,
+			},
+		},
+		{
+			name:         "背景を省略すると透明になる",
+			opts:         domain.ImageOptions{Size: 50, Padding: intPtr(0)},
+			wantContains: []string{`<rect x="0" y="0" width="10" height="10"/>`},
+			wantMissing:  []string{`<rect width=`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := NewRenderer().Render("#8058d7", testBlocks, domain.ImageFormatSVG, newTestImageOptions(t, tt.opts))
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			svg := string(data)
+			for _, want := range tt.wantContains {
+				if !strings.Contains(svg, want) {
+					t.Errorf("SVGに %s が含まれていない: %s", want, svg)
+				}
+			}
+			for _, missing := range tt.wantMissing {
+				if strings.Contains(svg, missing) {
+					t.Errorf("SVGに %s が含まれている: %s", missing, svg)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderer_RenderPNG(t *testing.T) {
+	opts := newTestImageOptions(t, domain.ImageOptions{Size: 70, Padding: intPtr(10), Background: "#ffffff"})
+	data, err := NewRenderer().Render("#8058d7", testBlocks, domain.ImageFormatPNG, opts)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("png.Decode() error = %v", err)
+	}
+	if img.Bounds().Dx() != 70 || img.Bounds().Dy() != 70 {
+		t.Fatalf("画像の大きさが違う: %v", img.Bounds())
+	}
+
+	white := color.NRGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	purple := color.NRGBA{R: 0x80, G: 0x58, B: 0xd7, A: 0xff}
+	tests := []struct {
+		name string
+		x, y int
+		want color.NRGBA
+	}{
+		{name: "余白は背景色", x: 5, y: 5, want: white},
+		{name: "左上のブロックは塗られている", x: 15, y: 15, want: purple},
+		{name: "塗られていないブロックは背景色", x: 25, y: 15, want: white},
+		{name: "中央のブロックは塗られている", x: 35, y: 35, want: purple},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := color.NRGBAModel.Convert(img.At(tt.x, tt.y)).(color.NRGBA)
+			if got != tt.want {
+				t.Errorf("(%d, %d) = %v, want %v", tt.x, tt.y, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderer_Render_Error(t *testing.T) {
+	opts := newTestImageOptions(t, domain.ImageOptions{})
+
+	if _, err := NewRenderer().Render("not-a-color", testBlocks, domain.ImageFormatPNG, opts); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Errorf("不正な色: error = %v, want ErrInvalidArgument", err)
+	}
+	if _, err := NewRenderer().Render("#8058d7", testBlocks, "gif", opts); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Errorf("対応していない形式: error = %v, want ErrInvalidArgument", err)
+	}
+}
@@ -2,39 +2,105 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
 
+	"github.com/furarico/octo-deck-api/internal/domain"
 	"github.com/furarico/octo-deck-api/internal/github"
 	"github.com/furarico/octo-deck-api/internal/handler"
+	"github.com/furarico/octo-deck-api/internal/service"
 	"github.com/gin-gonic/gin"
 )
 
+// Options はAuthMiddlewareの設定
+type Options struct {
+	// PublicRoutes に含まれるルート（"/cards/:githubId/image" のようなgin のパス）は認証せずに通す
+	PublicRoutes []string
+	// WrapClient はリクエストごとに作るGitHub APIクライアントを包む（キャッシュなど）。nilの場合はそのまま使う
+	WrapClient func(client service.GitHubClient) service.GitHubClient
+	// TokenCache は検証済みのトークンを保存する。nilの場合はリクエストごとにGitHubで検証する
+	TokenCache *TokenCache
+	// NewClient はトークンからGitHub APIクライアントを作る。nilの場合はトークンごとにレート制限の残りを共有する github.RateLimiters.NewClient を使う
+	NewClient func(token string) service.GitHubClient
+}
+
+// defaultRateLimitersSize は NewClient を指定しない場合に、レート制限の残りを保持するトークンの最大数
+const defaultRateLimitersSize = 10000
+
 // GitHub Appのアクセストークンを検証し、ユーザー情報をContextにセット
-func AuthMiddleware() gin.HandlerFunc {
+func AuthMiddleware(opts Options) gin.HandlerFunc {
+	public := make(map[string]bool, len(opts.PublicRoutes))
+	for _, route := range opts.PublicRoutes {
+		public[route] = true
+	}
+
+	newClient := opts.NewClient
+	if newClient == nil {
+		limiters := github.NewRateLimiters(defaultRateLimitersSize)
+		newClient = func(token string) service.GitHubClient { return limiters.NewClient(token) }
+	}
+
 	return func(c *gin.Context) {
+		if public[c.FullPath()] {
+			c.Next()
+			return
+		}
+
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is required"})
-			c.Abort()
+			handler.AbortWithError(c, http.StatusUnauthorized, "Authorization header is required")
 			return
 		}
 
 		token := strings.TrimPrefix(authHeader, "Bearer ")
 		if token == authHeader {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization format, expected 'Bearer <token>'"})
-			c.Abort()
+			handler.AbortWithError(c, http.StatusUnauthorized, "Invalid authorization format, expected 'Bearer <token>'")
 			return
 		}
 
-		// GitHub APIでユーザー情報を取得
-		ghClient := github.NewClient(token)
-		user, err := ghClient.GetAuthenticatedUser(c.Request.Context())
-		if err != nil {
-			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
-			c.Abort()
-			return
+		ghClient := newClient(token)
+
+		// 検証済みのトークンはキャッシュから使い、GitHubへの問い合わせを省く
+		key := tokenKey(token)
+		var user *github.UserInfo
+		if opts.TokenCache != nil {
+			cached, ok := opts.TokenCache.lookup(c.Request.Context(), key)
+			if ok && cached == nil {
+				handler.AbortWithError(c, http.StatusUnauthorized, "Invalid or expired token")
+				return
+			}
+			user = cached
+			ghClient = &invalidatingClient{inner: ghClient, cache: opts.TokenCache, key: key}
+		}
+		if opts.WrapClient != nil {
+			ghClient = opts.WrapClient(ghClient)
+		}
+
+		if user == nil {
+			// GitHub APIでユーザー情報を取得
+			var err error
+			user, err = ghClient.GetAuthenticatedUser(c.Request.Context())
+			if err != nil {
+				// GitHub側の障害の場合はトークンの問題と区別する
+				if errors.Is(err, domain.ErrRateLimited) {
+					handler.AbortWithRateLimit(c, err, "GitHub API rate limit exceeded")
+					return
+				}
+				if errors.Is(err, domain.ErrUpstreamUnavailable) {
+					handler.AbortWithError(c, http.StatusBadGateway, "Failed to verify token with GitHub")
+					return
+				}
+				if opts.TokenCache != nil && errors.Is(err, domain.ErrUnauthorized) {
+					opts.TokenCache.save(c.Request.Context(), key, nil)
+				}
+				handler.AbortWithError(c, http.StatusUnauthorized, "Invalid or expired token")
+				return
+			}
+			if opts.TokenCache != nil {
+				opts.TokenCache.save(c.Request.Context(), key, user)
+			}
 		}
 
 		// context.Context にユーザー情報とClientをセット
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/github"
+	"github.com/furarico/octo-deck-api/internal/handler"
+	"github.com/furarico/octo-deck-api/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+// 公開ルートは認証なしで通し、それ以外は認証を求めることをテスト
+func TestAuthMiddleware_PublicRoutes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name     string
+		path     string
+		wantCode int
+	}{
+		{
+			name:     "公開ルートはAuthorizationヘッダーがなくても通る",
+			path:     "/cards/12345/image",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "公開ルート以外はAuthorizationヘッダーが必要",
+			path:     "/cards/12345",
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(AuthMiddleware(Options{PublicRoutes: []string{"/cards/:githubId/image"}}))
+			ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+			router.GET("/cards/:githubId/image", ok)
+			router.GET("/cards/:githubId", ok)
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest("GET", tt.path, nil)
+			router.ServeHTTP(w, req)
+
+			if w.Code != tt.wantCode {
+				t.Errorf("ステータスコードが違う: 期待=%d, 実際=%d", tt.wantCode, w.Code)
+			}
+		})
+	}
+}
+
+// 検証済みのトークンはキャッシュし、GitHubへの問い合わせを省くことをテスト
+func TestAuthMiddleware_TokenCache(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var verifyCalls int
+	var downstreamErr error
+	newClient := func(token string) service.GitHubClient {
+		return &github.MockClient{
+			GetAuthenticatedUserFunc: func(ctx context.Context) (*github.UserInfo, error) {
+				verifyCalls++
+				if token != "valid" {
+					return nil, domain.ErrUnauthorized
+				}
+				return &github.UserInfo{ID: 12345, NodeID: "U_12345", Login: "octocat", Name: "The Octocat"}, nil
+			},
+			GetUserByIDFunc: func(ctx context.Context, id int64) (*github.UserInfo, error) {
+				return &github.UserInfo{ID: id}, downstreamErr
+			},
+		}
+	}
+
+	cache := NewTokenCache(TokenCacheConfig{Size: 10, TTL: time.Minute, NegativeTTL: time.Minute})
+	router := gin.New()
+	router.Use(AuthMiddleware(Options{TokenCache: cache, NewClient: newClient}))
+	router.GET("/me", func(c *gin.Context) {
+		ctx := c.Request.Context()
+		client := ctx.Value(handler.GitHubClientKey).(service.GitHubClient)
+		if _, err := client.GetUserByID(ctx, 1); err != nil {
+			c.Status(http.StatusUnauthorized)
+			return
+		}
+		c.String(http.StatusOK, "%s:%s", ctx.Value(handler.GitHubIDKey), ctx.Value(handler.GitHubLoginKey))
+	})
+
+	request := func(token string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest("GET", "/me", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// 2回目のリクエストはキャッシュしたユーザーを使う
+	for i := 0; i < 2; i++ {
+		w := request("valid")
+		if w.Code != http.StatusOK || w.Body.String() != "12345:octocat" {
+			t.Fatalf("response = %d %q, want 200 %q", w.Code, w.Body.String(), "12345:octocat")
+		}
+	}
+	if verifyCalls != 1 {
+		t.Errorf("GitHubでの検証回数 = %d, want 1", verifyCalls)
+	}
+
+	// 無効なトークンもキャッシュし、GitHubに問い合わせずに拒否する
+	for i := 0; i < 2; i++ {
+		if w := request("invalid"); w.Code != http.StatusUnauthorized {
+			t.Fatalf("ステータスコードが違う: 期待=%d, 実際=%d", http.StatusUnauthorized, w.Code)
+		}
+	}
+	if verifyCalls != 2 {
+		t.Errorf("GitHubでの検証回数 = %d, want 2", verifyCalls)
+	}
+
+	// 後続のGitHub APIの呼び出しが401になったらキャッシュを捨てて検証し直す
+	downstreamErr = domain.ErrUnauthorized
+	if w := request("valid"); w.Code != http.StatusUnauthorized {
+		t.Fatalf("ステータスコードが違う: 期待=%d, 実際=%d", http.StatusUnauthorized, w.Code)
+	}
+	downstreamErr = nil
+	if w := request("valid"); w.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが違う: 期待=%d, 実際=%d", http.StatusOK, w.Code)
+	}
+	if verifyCalls != 3 {
+		t.Errorf("GitHubでの検証回数 = %d, want 3", verifyCalls)
+	}
+
+	// 期限が切れたら検証し直す
+	cache.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+	if w := request("valid"); w.Code != http.StatusOK {
+		t.Fatalf("ステータスコードが違う: 期待=%d, 実際=%d", http.StatusOK, w.Code)
+	}
+	if verifyCalls != 4 {
+		t.Errorf("GitHubでの検証回数 = %d, want 4", verifyCalls)
+	}
+}
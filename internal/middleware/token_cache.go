@@ -0,0 +1,181 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/config"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/furarico/octo-deck-api/internal/github"
+	"github.com/furarico/octo-deck-api/internal/githubcache"
+	"github.com/furarico/octo-deck-api/internal/service"
+)
+
+// TokenCacheConfig は検証済みのトークンのキャッシュの設定
+type TokenCacheConfig struct {
+	// Size は保存するトークンの最大数
+	Size int
+	// TTL は検証できたトークンを再検証せずに使う期間
+	TTL time.Duration
+	// NegativeTTL は無効だったトークンを再検証せずに拒否する期間
+	NegativeTTL time.Duration
+}
+
+// LoadTokenCacheConfig は環境変数からトークンのキャッシュの設定を読み込む
+func LoadTokenCacheConfig() (TokenCacheConfig, error) {
+	cfg := TokenCacheConfig{
+		Size:        10000,
+		TTL:         5 * time.Minute,
+		NegativeTTL: time.Minute,
+	}
+
+	var err error
+	if cfg.Size, err = config.Int("AUTH_TOKEN_CACHE_SIZE", cfg.Size); err != nil {
+		return TokenCacheConfig{}, err
+	}
+	if cfg.TTL, err = config.Duration("AUTH_TOKEN_CACHE_TTL", cfg.TTL); err != nil {
+		return TokenCacheConfig{}, err
+	}
+	if cfg.NegativeTTL, err = config.Duration("AUTH_TOKEN_CACHE_NEGATIVE_TTL", cfg.NegativeTTL); err != nil {
+		return TokenCacheConfig{}, err
+	}
+
+	if cfg.Size <= 0 {
+		return TokenCacheConfig{}, fmt.Errorf("AUTH_TOKEN_CACHE_SIZE must be positive")
+	}
+	if cfg.TTL < 0 || cfg.NegativeTTL < 0 {
+		return TokenCacheConfig{}, fmt.Errorf("AUTH_TOKEN_CACHE_TTL and AUTH_TOKEN_CACHE_NEGATIVE_TTL must not be negative")
+	}
+
+	return cfg, nil
+}
+
+// TokenCache は検証済みのトークンと、そのトークンのユーザーを保存する
+// トークンそのものは保存せず、SHA-256のハッシュをキーにする
+type TokenCache struct {
+	store  *githubcache.LRUStore
+	config TokenCacheConfig
+	now    func() time.Time
+}
+
+func NewTokenCache(cfg TokenCacheConfig) *TokenCache {
+	return &TokenCache{
+		store:  githubcache.NewLRUStore(cfg.Size),
+		config: cfg,
+		now:    time.Now,
+	}
+}
+
+// tokenKey はトークンのハッシュからキャッシュのキーを求める
+func tokenKey(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// lookup はトークンのキャッシュを探す
+// 無効なトークンとして保存されている場合は、ユーザーがnilで見つかったことを返す
+func (c *TokenCache) lookup(ctx context.Context, key string) (*github.UserInfo, bool) {
+	entry, err := c.store.Get(ctx, key)
+	if err != nil || entry.IsExpired(c.now()) {
+		return nil, false
+	}
+
+	var user *github.UserInfo
+	if err := json.Unmarshal(entry.Value, &user); err != nil {
+		return nil, false
+	}
+	return user, true
+}
+
+// save はトークンのユーザーを保存する。user がnilの場合は無効なトークンとして保存する
+func (c *TokenCache) save(ctx context.Context, key string, user *github.UserInfo) {
+	ttl := c.config.TTL
+	if user == nil {
+		ttl = c.config.NegativeTTL
+	}
+
+	data, err := json.Marshal(user)
+	if err != nil {
+		return
+	}
+
+	now := c.now()
+	_ = c.store.Set(ctx, &domain.CacheEntry{
+		Key:       key,
+		Value:     data,
+		FetchedAt: now,
+		ExpiresAt: now.Add(ttl),
+	})
+}
+
+// invalidate はトークンのキャッシュを捨て、次のリクエストでGitHubに検証し直す
+func (c *TokenCache) invalidate(ctx context.Context, key string) {
+	_ = c.store.Delete(ctx, key)
+}
+
+// invalidatingClient はGitHub APIがトークンを拒否（401）したときにトークンのキャッシュを捨てる
+// キャッシュした後に取り消されたトークンを使い続けないようにする
+type invalidatingClient struct {
+	inner service.GitHubClient
+	cache *TokenCache
+	key   string
+}
+
+func (c *invalidatingClient) check(ctx context.Context, err error) {
+	if errors.Is(err, domain.ErrUnauthorized) {
+		c.cache.invalidate(ctx, c.key)
+	}
+}
+
+func (c *invalidatingClient) GetAuthenticatedUser(ctx context.Context) (*github.UserInfo, error) {
+	user, err := c.inner.GetAuthenticatedUser(ctx)
+	c.check(ctx, err)
+	return user, err
+}
+
+func (c *invalidatingClient) GetUserByID(ctx context.Context, id int64) (*github.UserInfo, error) {
+	user, err := c.inner.GetUserByID(ctx, id)
+	c.check(ctx, err)
+	return user, err
+}
+
+func (c *invalidatingClient) GetUsersByIDs(ctx context.Context, ids []int64) (map[int64]*github.UserInfo, error) {
+	users, err := c.inner.GetUsersByIDs(ctx, ids)
+	c.check(ctx, err)
+	return users, err
+}
+
+func (c *invalidatingClient) GetUserStats(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
+	stats, err := c.inner.GetUserStats(ctx, githubID, from, to)
+	c.check(ctx, err)
+	return stats, err
+}
+
+func (c *invalidatingClient) GetMostUsedLanguage(ctx context.Context, login string) (github.LanguageInfo, error) {
+	info, err := c.inner.GetMostUsedLanguage(ctx, login)
+	c.check(ctx, err)
+	return info, err
+}
+
+func (c *invalidatingClient) GetMostUsedLanguages(ctx context.Context, logins []string) (map[string]github.LanguageInfo, error) {
+	languages, err := c.inner.GetMostUsedLanguages(ctx, logins)
+	c.check(ctx, err)
+	return languages, err
+}
+
+func (c *invalidatingClient) GetMyLanguages(ctx context.Context) (github.LanguageInfo, error) {
+	info, err := c.inner.GetMyLanguages(ctx)
+	c.check(ctx, err)
+	return info, err
+}
+
+func (c *invalidatingClient) GetUsersFullInfoByNodeIDs(ctx context.Context, nodeIDs []string, from, to time.Time) ([]github.UserFullInfo, error) {
+	infos, err := c.inner.GetUsersFullInfoByNodeIDs(ctx, nodeIDs, from, to)
+	c.check(ctx, err)
+	return infos, err
+}
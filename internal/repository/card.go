@@ -2,11 +2,15 @@ package repository
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/furarico/octo-deck-api/internal/database"
 	"github.com/furarico/octo-deck-api/internal/domain"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type cardRepository struct {
@@ -17,29 +21,125 @@ func NewCardRepository(db *gorm.DB) *cardRepository {
 	return &cardRepository{db: db}
 }
 
-// FindAll はGitHubIDから自分が集めたカードを全て取得する
-func (r *cardRepository) FindAll(ctx context.Context, githubID string) ([]domain.Card, error) {
-	var collectedCards []database.CollectedCard
-	if err := r.db.WithContext(ctx).
-		Preload("Card").
-		Where("collector_github_id = ?", githubID).
-		Find(&collectedCards).Error; err != nil {
+// deckSortColumns はデッキのカード一覧で使える並び替えのカラム
+var deckSortColumns = map[domain.SortKey]keysetColumn{
+	domain.SortKeyCollectedAt: {expr: "collected_cards.collected_at", sqlType: "timestamptz"},
+	domain.SortKeyUserName:    {expr: "COALESCE(cards.user_name, '')", sqlType: "text"},
+	domain.SortKeyLanguage:    {expr: "COALESCE(cards.most_used_language_name, '')", sqlType: "text"},
+}
+
+// cardPageRow はページ単位で取得したカードの行
+type cardPageRow struct {
+	database.Card `gorm:"embedded"`
+	SortValue     string
+	CursorID      uuid.UUID
+}
+
+// collectedCardPageRow はページ単位で取得したデッキのカードの行
+type collectedCardPageRow struct {
+	database.Card        `gorm:"embedded"`
+	CollectedID          uuid.UUID
+	CollectedAt          time.Time
+	Source               string
+	CollectedCommunityID *uuid.UUID
+	Note                 string
+	SortValue            string
+	CursorID             uuid.UUID
+}
+
+// Search はGitHubIDから自分が集めたカードを条件で絞り込み、ページ単位で取得する
+func (r *cardRepository) Search(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+	column, ok := deckSortColumns[page.SortKey]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported sort key: %s", domain.ErrInvalidArgument, page.SortKey)
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(&database.CollectedCard{}).
+		Joins("JOIN cards ON cards.id = collected_cards.card_id").
+		Where("collected_cards.collector_github_id = ?", githubID)
+
+	if filter.Query != "" {
+		pattern := "%" + escapeLike(filter.Query) + "%"
+		query = query.Where("(cards.user_name ILIKE ? OR cards.full_name ILIKE ?)", pattern, pattern)
+	}
+	if filter.Language != "" {
+		query = query.Where("lower(cards.most_used_language_name) = lower(?)", filter.Language)
+	}
+	if filter.CommunityID != "" {
+		communityUUID, err := parseUUID(filter.CommunityID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid community id: %w", err)
+		}
+		// カードの持ち主と自分がともに参加している、削除されていないコミュニティに絞り込む
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM community_cards owner_cc
+			JOIN community_cards my_cc ON my_cc.community_id = owner_cc.community_id
+			JOIN cards my_card ON my_card.id = my_cc.card_id
+			JOIN communities ON communities.id = owner_cc.community_id AND communities.deleted_at IS NULL
+			WHERE owner_cc.card_id = cards.id AND owner_cc.community_id = ? AND my_card.github_id = ?
+		)`, communityUUID, githubID)
+	}
+
+	query, err := paginate(query, page,
+		"cards.*, collected_cards.id AS collected_id, collected_cards.collected_at, collected_cards.source, "+
+			"collected_cards.community_id AS collected_community_id, collected_cards.note",
+		column, "cards.id",
+	)
+	if err != nil {
 		return nil, err
 	}
 
-	var result []domain.Card
-	for _, cc := range collectedCards {
-		result = append(result, *cc.Card.ToDomain())
+	var rows []collectedCardPageRow
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, translateError(err)
 	}
 
-	return result, nil
+	rows, cursor := trimPage(page, rows, func(row collectedCardPageRow) pageRow {
+		return pageRow{SortValue: row.SortValue, CursorID: row.CursorID}
+	})
+	collectedCards := make([]domain.CollectedCard, 0, len(rows))
+	for _, row := range rows {
+		collectedCard := database.CollectedCard{
+			ID:                row.CollectedID,
+			CollectorGithubID: githubID,
+			CardID:            row.Card.ID,
+			CollectedAt:       row.CollectedAt,
+			Source:            row.Source,
+			CommunityID:       row.CollectedCommunityID,
+			Note:              row.Note,
+			Card:              row.Card,
+		}
+		collectedCards = append(collectedCards, *collectedCard.ToDomain())
+	}
+
+	return &domain.CollectedCardPage{
+		CollectedCards: collectedCards,
+		NextCursor:     cursor,
+	}, nil
+}
+
+// toCardPage は取得した行をlimit件に切り詰めてページにする
+func toCardPage(page domain.PageRequest, rows []cardPageRow) *domain.CardPage {
+	rows, cursor := trimPage(page, rows, func(row cardPageRow) pageRow {
+		return pageRow{SortValue: row.SortValue, CursorID: row.CursorID}
+	})
+	cards := make([]domain.Card, 0, len(rows))
+	for _, row := range rows {
+		cards = append(cards, *row.Card.ToDomain())
+	}
+
+	return &domain.CardPage{
+		Cards:      cards,
+		NextCursor: cursor,
+	}
 }
 
 // FindByGitHubID はGitHub IDでカードを取得する
 func (r *cardRepository) FindByGitHubID(ctx context.Context, githubID string) (*domain.Card, error) {
 	var dbCard database.Card
 	if err := r.db.WithContext(ctx).First(&dbCard, "github_id = ?", githubID).Error; err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	return dbCard.ToDomain(), nil
@@ -49,7 +149,7 @@ func (r *cardRepository) FindByGitHubID(ctx context.Context, githubID string) (*
 func (r *cardRepository) FindMyCard(ctx context.Context, githubID string) (*domain.Card, error) {
 	var dbCard database.Card
 	if err := r.db.WithContext(ctx).First(&dbCard, "github_id = ?", githubID).Error; err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	return dbCard.ToDomain(), nil
@@ -58,36 +158,50 @@ func (r *cardRepository) FindMyCard(ctx context.Context, githubID string) (*doma
 // Create は新しいカードを作成する
 func (r *cardRepository) Create(ctx context.Context, card *domain.Card) error {
 	dbCard := database.CardFromDomain(card)
-	return r.db.WithContext(ctx).Create(dbCard).Error
+	return translateError(r.db.WithContext(ctx).Create(dbCard).Error)
 }
 
 // AddToCollectedCards はカードをデッキに追加する
-func (r *cardRepository) AddToCollectedCards(ctx context.Context, collectorGithubID string, cardID domain.CardID) error {
-	collectedCard := &database.CollectedCard{
-		CollectorGithubID: collectorGithubID,
-		CardID:            uuid.UUID(cardID),
-	}
-	return r.db.WithContext(ctx).Create(collectedCard).Error
+func (r *cardRepository) AddToCollectedCards(ctx context.Context, collectedCard *domain.CollectedCard) error {
+	dbCollectedCard := database.CollectedCardFromDomain(collectedCard)
+	return translateError(r.db.WithContext(ctx).Create(dbCollectedCard).Error)
 }
 
 // RemoveFromCollectedCards はカードをデッキから削除する
 func (r *cardRepository) RemoveFromCollectedCards(ctx context.Context, collectorGithubID string, cardID domain.CardID) error {
-	return r.db.WithContext(ctx).
+	return translateError(r.db.WithContext(ctx).
 		Where("collector_github_id = ? AND card_id = ?", collectorGithubID, uuid.UUID(cardID)).
-		Delete(&database.CollectedCard{}).Error
+		Delete(&database.CollectedCard{}).Error)
 }
 
 // Update はカード情報を更新する
 func (r *cardRepository) Update(ctx context.Context, card *domain.Card) error {
 	dbCard := database.CardFromDomain(card)
-	return r.db.WithContext(ctx).Model(&database.Card{}).Where("id = ?", dbCard.ID).Updates(dbCard).Error
+	return translateError(r.db.WithContext(ctx).Model(&database.Card{}).Where("id = ?", dbCard.ID).Updates(dbCard).Error)
+}
+
+// UpdateSettings はカードの設定を更新する
+// Update は値がゼロの項目を更新しないので、設定は項目を指定して更新する
+func (r *cardRepository) UpdateSettings(ctx context.Context, cardID domain.CardID, settings domain.CardSettings) error {
+	result := r.db.WithContext(ctx).Model(&database.Card{}).
+		Where("id = ?", uuid.UUID(cardID)).
+		Updates(map[string]any{
+			"include_private_repositories": settings.IncludePrivateRepositories,
+		})
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("card not found: %w", domain.ErrNotFound)
+	}
+	return nil
 }
 
 // FindAllCardsInDB はデータベース内の全カードを取得する
 func (r *cardRepository) FindAllCardsInDB(ctx context.Context) ([]domain.Card, error) {
 	var dbCards []database.Card
 	if err := r.db.WithContext(ctx).Find(&dbCards).Error; err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	result := make([]domain.Card, 0, len(dbCards))
@@ -97,3 +211,128 @@ func (r *cardRepository) FindAllCardsInDB(ctx context.Context) ([]domain.Card, e
 
 	return result, nil
 }
+
+// FindStaleCards は refreshedBefore より前に更新された、または一度も更新していないカードを古い順に limit 件取得する
+func (r *cardRepository) FindStaleCards(ctx context.Context, refreshedBefore time.Time, limit int) ([]domain.Card, error) {
+	var dbCards []database.Card
+	if err := r.db.WithContext(ctx).
+		Where("refreshed_at IS NULL OR refreshed_at < ?", refreshedBefore).
+		Order("refreshed_at NULLS FIRST").
+		Order("id").
+		Limit(limit).
+		Find(&dbCards).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	result := make([]domain.Card, 0, len(dbCards))
+	for _, dbCard := range dbCards {
+		result = append(result, *dbCard.ToDomain())
+	}
+
+	return result, nil
+}
+
+// CreateExchange はカード交換の申し出を作成する
+func (r *cardRepository) CreateExchange(ctx context.Context, exchange *domain.CardExchange) error {
+	dbExchange := database.CardExchangeFromDomain(exchange)
+	return translateError(r.db.WithContext(ctx).Create(dbExchange).Error)
+}
+
+// FindExchangeByToken はトークンからカード交換の申し出を取得する
+func (r *cardRepository) FindExchangeByToken(ctx context.Context, token string) (*domain.CardExchange, error) {
+	var dbExchange database.CardExchange
+	if err := r.db.WithContext(ctx).
+		Preload("OfferedCard").
+		Preload("AcceptedCard").
+		First(&dbExchange, "token = ?", token).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	return dbExchange.ToDomain(), nil
+}
+
+// FindExchanges は自分が申し出た、または受け取ったカード交換を新しい順に取得する
+func (r *cardRepository) FindExchanges(ctx context.Context, githubID string) ([]domain.CardExchange, error) {
+	var dbExchanges []database.CardExchange
+	if err := r.db.WithContext(ctx).
+		Preload("OfferedCard").
+		Preload("AcceptedCard").
+		Where("offered_by_github_id = ? OR accepted_by_github_id = ?", githubID, githubID).
+		Order("created_at DESC").
+		Find(&dbExchanges).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	result := make([]domain.CardExchange, 0, len(dbExchanges))
+	for _, dbExchange := range dbExchanges {
+		result = append(result, *dbExchange.ToDomain())
+	}
+
+	return result, nil
+}
+
+// AcceptExchange はカード交換の申し出を受け取り、お互いのデッキに相手のカードを追加する
+// 申し出の更新と両方向のデッキへの追加は1つのトランザクションで行う
+// 既にデッキにあるカードはそのままにする
+func (r *cardRepository) AcceptExchange(ctx context.Context, token string, acceptedByGithubID string, acceptedCardID domain.CardID, detail domain.CollectDetail, now time.Time) error {
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var dbExchange database.CardExchange
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			First(&dbExchange, "token = ?", token).Error; err != nil {
+			return err
+		}
+
+		switch dbExchange.ToDomain().StatusAt(now) {
+		case domain.CardExchangeStatusAccepted:
+			return fmt.Errorf("%w: exchange has already been accepted", domain.ErrAlreadyExists)
+		case domain.CardExchangeStatusExpired:
+			return fmt.Errorf("exchange has expired: %w", domain.ErrNotFound)
+		}
+		if dbExchange.OfferedByGithubID == acceptedByGithubID {
+			return fmt.Errorf("%w: cannot accept your own exchange", domain.ErrInvalidArgument)
+		}
+
+		var communityID *uuid.UUID
+		if detail.CommunityID != nil {
+			id := uuid.UUID(*detail.CommunityID)
+			communityID = &id
+		}
+		// メモは受け取った人が自分のデッキに付けるものなので、申し出た人の側には付けない
+		collectedCards := []database.CollectedCard{
+			{
+				CollectorGithubID: acceptedByGithubID,
+				CardID:            dbExchange.OfferedCardID,
+				CollectedAt:       now,
+				Source:            string(detail.Source),
+				CommunityID:       communityID,
+				Note:              detail.Note,
+			},
+			{
+				CollectorGithubID: dbExchange.OfferedByGithubID,
+				CardID:            uuid.UUID(acceptedCardID),
+				CollectedAt:       now,
+				Source:            string(detail.Source),
+				CommunityID:       communityID,
+			},
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&collectedCards).Error; err != nil {
+			return err
+		}
+
+		cardID := uuid.UUID(acceptedCardID)
+		return tx.Model(&dbExchange).Updates(map[string]any{
+			"status":                string(domain.CardExchangeStatusAccepted),
+			"accepted_by_github_id": acceptedByGithubID,
+			"accepted_card_id":      cardID,
+			"accepted_at":           now,
+		}).Error
+	}))
+}
+
+// likeEscaper は LIKE のパターンで特別な意味を持つ文字をエスケープする
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// escapeLike は文字列を LIKE のパターンにそのまま埋め込めるようにエスケープする
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -3,7 +3,10 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/furarico/octo-deck-api/internal/database"
 	"github.com/furarico/octo-deck-api/internal/domain"
@@ -54,6 +57,15 @@ func TestCardRepository_Create(t *testing.T) {
 			card:    createTestCard("67890", "U_67890"),
 			wantErr: false,
 		},
+		{
+			name: "Identiconのバージョンを保存できる",
+			card: func() *domain.Card {
+				card := createTestCard("13579", "U_13579")
+				card.IdenticonVersion = domain.IdenticonVersionGrid7
+				return card
+			}(),
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -86,6 +98,14 @@ func TestCardRepository_Create(t *testing.T) {
 				if dbCard.UserName != tt.card.UserName {
 					t.Errorf("UserName = %v, want %v", dbCard.UserName, tt.card.UserName)
 				}
+				// バージョンを指定しない場合は最初の生成方法として保存する
+				wantVersion := tt.card.IdenticonVersion
+				if wantVersion == 0 {
+					wantVersion = domain.IdenticonVersionClassic
+				}
+				if domain.IdenticonVersion(dbCard.IdenticonVersion) != wantVersion {
+					t.Errorf("IdenticonVersion = %v, want %v", dbCard.IdenticonVersion, wantVersion)
+				}
 			}
 		})
 	}
@@ -200,8 +220,8 @@ func TestCardRepository_FindMyCard(t *testing.T) {
 	}
 }
 
-// CardRepositoryのFindAllメソッドをテスト
-func TestCardRepository_FindAll(t *testing.T) {
+// CardRepositoryのSearchメソッドで絞り込み条件なしに取得できることをテスト
+func TestCardRepository_Search(t *testing.T) {
 	db := SetupTestDB(t)
 
 	tests := []struct {
@@ -290,15 +310,269 @@ func TestCardRepository_FindAll(t *testing.T) {
 			ctx := context.Background()
 
 			repo := NewCardRepository(db)
-			cards, err := repo.FindAll(ctx, collectorID)
+			page, err := repo.Search(ctx, collectorID, domain.CardFilter{}, domain.PageRequest{
+				Limit:   domain.DefaultPageLimit,
+				SortKey: domain.SortKeyCollectedAt,
+				Order:   domain.SortOrderDesc,
+			})
 
 			if (err != nil) != tt.wantErr {
-				t.Errorf("FindAll() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Search() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
-			if len(cards) != tt.wantCardCount {
-				t.Errorf("FindAll() returned %d cards, want %d", len(cards), tt.wantCardCount)
+			if len(page.CollectedCards) != tt.wantCardCount {
+				t.Errorf("Search() returned %d cards, want %d", len(page.CollectedCards), tt.wantCardCount)
+			}
+			if page.NextCursor != "" {
+				t.Errorf("Search() NextCursor = %s, want empty", page.NextCursor)
+			}
+		})
+	}
+}
+
+// CardRepositoryのSearchメソッドでカーソルを使って全ページを取得できることをテスト
+func TestCardRepository_Search_Cursor(t *testing.T) {
+	db := SetupTestDB(t)
+
+	setup := func(t *testing.T) string {
+		t.Helper()
+		CleanupTestData(t, db)
+
+		collectorID := "collector"
+		names := []string{"charlie", "alice", "echo", "bob", "delta"}
+		for i, name := range names {
+			card := createTestCard(name, "U_"+name)
+			card.UserName = name
+			card.MostUsedLanguage = domain.Language{LanguageName: "Go"}
+			dbCard := database.CardFromDomain(card)
+			db.Create(dbCard)
+			db.Create(&database.CollectedCard{
+				ID:                uuid.New(),
+				CollectorGithubID: collectorID,
+				CardID:            dbCard.ID,
+				CollectedAt:       time.Date(2025, 1, i+1, 0, 0, 0, 0, time.UTC),
+			})
+		}
+		return collectorID
+	}
+
+	// fetchAll は limit 件ずつカーソルをたどって全てのユーザー名を取得する
+	fetchAll := func(t *testing.T, collectorID string, sortKey domain.SortKey, order domain.SortOrder) []string {
+		t.Helper()
+		repo := NewCardRepository(db)
+		page := domain.PageRequest{Limit: 2, SortKey: sortKey, Order: order}
+
+		var names []string
+		for i := 0; i < 10; i++ {
+			result, err := repo.Search(context.Background(), collectorID, domain.CardFilter{}, page)
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if len(result.CollectedCards) > page.Limit {
+				t.Fatalf("Search() returned %d cards, want <= %d", len(result.CollectedCards), page.Limit)
+			}
+			for _, collectedCard := range result.CollectedCards {
+				names = append(names, collectedCard.Card.UserName)
+			}
+			if result.NextCursor == "" {
+				return names
+			}
+			page.Cursor = result.NextCursor
+		}
+		t.Fatal("NextCursor が空にならない")
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		sortKey domain.SortKey
+		order   domain.SortOrder
+		want    []string
+	}{
+		{
+			name:    "デッキに追加した日時の新しい順に取得できる",
+			sortKey: domain.SortKeyCollectedAt,
+			order:   domain.SortOrderDesc,
+			want:    []string{"delta", "bob", "echo", "alice", "charlie"},
+		},
+		{
+			name:    "ユーザー名の昇順に取得できる",
+			sortKey: domain.SortKeyUserName,
+			order:   domain.SortOrderAsc,
+			want:    []string{"alice", "bob", "charlie", "delta", "echo"},
+		},
+		{
+			name:    "言語が同じ場合もIDで並びが決まり重複や抜けがない",
+			sortKey: domain.SortKeyLanguage,
+			order:   domain.SortOrderAsc,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collectorID := setup(t)
+			got := fetchAll(t, collectorID, tt.sortKey, tt.order)
+
+			if len(got) != 5 {
+				t.Fatalf("取得したカード数 = %d, want 5", len(got))
+			}
+			seen := map[string]bool{}
+			for _, name := range got {
+				if seen[name] {
+					t.Errorf("%s が重複して取得されています", name)
+				}
+				seen[name] = true
+			}
+			if tt.want != nil && strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("並び順 = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("並び替えの条件が異なるカーソルはエラーになる", func(t *testing.T) {
+		collectorID := setup(t)
+		repo := NewCardRepository(db)
+
+		first, err := repo.Search(context.Background(), collectorID, domain.CardFilter{}, domain.PageRequest{Limit: 2, SortKey: domain.SortKeyUserName, Order: domain.SortOrderAsc})
+		if err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+
+		_, err = repo.Search(context.Background(), collectorID, domain.CardFilter{}, domain.PageRequest{Limit: 2, Cursor: first.NextCursor, SortKey: domain.SortKeyCollectedAt, Order: domain.SortOrderAsc})
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Search() error = %v, want %v", err, domain.ErrInvalidArgument)
+		}
+
+		_, err = repo.Search(context.Background(), collectorID, domain.CardFilter{}, domain.PageRequest{Limit: 2, Cursor: "invalid", SortKey: domain.SortKeyUserName, Order: domain.SortOrderAsc})
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("Search() error = %v, want %v", err, domain.ErrInvalidArgument)
+		}
+	})
+}
+
+// CardRepositoryのSearchメソッドで絞り込めることをテスト
+func TestCardRepository_Search_Filter(t *testing.T) {
+	db := SetupTestDB(t)
+	CleanupTestData(t, db)
+	ctx := context.Background()
+
+	collectorID := "collector"
+	createCard := func(githubID, userName, fullName, language string) *database.Card {
+		card := createTestCard(githubID, "U_"+githubID)
+		card.UserName = userName
+		card.FullName = fullName
+		card.MostUsedLanguage = domain.Language{LanguageName: language}
+		dbCard := database.CardFromDomain(card)
+		db.Create(dbCard)
+		return dbCard
+	}
+	myCard := createCard(collectorID, "collector", "Collector", "Go")
+	octocat := createCard("octocat", "octocat", "The Octocat", "Go")
+	monalisa := createCard("monalisa", "monalisa", "Mona Lisa Octo", "TypeScript")
+	hubot := createCard("hubot", "hubot", "Hubot 100%", "Rust")
+	for _, card := range []*database.Card{octocat, monalisa, hubot} {
+		db.Create(&database.CollectedCard{ID: uuid.New(), CollectorGithubID: collectorID, CardID: card.ID})
+	}
+
+	// 自分と octocat が参加しているコミュニティと、hubot だけが参加しているコミュニティを作成する
+	createCommunity := func(name string, members ...*database.Card) *database.Community {
+		community := createTestCommunity(name)
+		dbCommunity := &database.Community{
+			ID:        uuid.UUID(community.ID),
+			Name:      community.Name,
+			StartedAt: community.StartedAt,
+			EndedAt:   community.EndedAt,
+		}
+		db.Create(dbCommunity)
+		for _, member := range members {
+			db.Create(&database.CommunityCard{CommunityID: dbCommunity.ID, CardID: member.ID})
+		}
+		return dbCommunity
+	}
+	shared := createCommunity("Shared", myCard, octocat)
+	notShared := createCommunity("Not Shared", hubot)
+	deleted := createCommunity("Deleted", myCard, monalisa)
+	db.Delete(deleted)
+
+	tests := []struct {
+		name    string
+		filter  domain.CardFilter
+		want    []string
+		wantErr error
+	}{
+		{
+			name:   "ユーザー名の部分一致で絞り込める",
+			filter: domain.CardFilter{Query: "CAT"},
+			want:   []string{"octocat"},
+		},
+		{
+			name:   "フルネームの部分一致で絞り込める",
+			filter: domain.CardFilter{Query: "octo"},
+			want:   []string{"monalisa", "octocat"},
+		},
+		{
+			name:   "LIKEの特殊文字はそのまま検索される",
+			filter: domain.CardFilter{Query: "100%"},
+			want:   []string{"hubot"},
+		},
+		{
+			name:   "言語で絞り込める",
+			filter: domain.CardFilter{Language: "go"},
+			want:   []string{"octocat"},
+		},
+		{
+			name:   "ともに参加しているコミュニティで絞り込める",
+			filter: domain.CardFilter{CommunityID: shared.ID.String()},
+			want:   []string{"octocat"},
+		},
+		{
+			name:   "自分が参加していないコミュニティでは何も取得しない",
+			filter: domain.CardFilter{CommunityID: notShared.ID.String()},
+			want:   []string{},
+		},
+		{
+			name:   "削除されたコミュニティでは何も取得しない",
+			filter: domain.CardFilter{CommunityID: deleted.ID.String()},
+			want:   []string{},
+		},
+		{
+			name:   "複数の条件はすべて満たすものに絞り込む",
+			filter: domain.CardFilter{Query: "octo", Language: "TypeScript"},
+			want:   []string{"monalisa"},
+		},
+		{
+			name:    "コミュニティIDが不正な場合はエラーになる",
+			filter:  domain.CardFilter{CommunityID: "invalid"},
+			wantErr: domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := NewCardRepository(db)
+			page, err := repo.Search(ctx, collectorID, tt.filter, domain.PageRequest{
+				Limit:   domain.DefaultPageLimit,
+				SortKey: domain.SortKeyUserName,
+				Order:   domain.SortOrderAsc,
+			})
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Search() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+
+			got := make([]string, 0, len(page.CollectedCards))
+			for _, collectedCard := range page.CollectedCards {
+				got = append(got, collectedCard.Card.UserName)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Search() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -327,6 +601,10 @@ func TestCardRepository_Update(t *testing.T) {
 				card.UserName = "updateduser"
 				card.FullName = "Updated User"
 				card.Color = domain.Color("#00FF00")
+				card.TopLanguages = []domain.LanguageBreakdown{
+					{LanguageName: "Go", Bytes: 3000, Percentage: 75, Color: "#00ADD8"},
+					{LanguageName: "Shell", Bytes: 1000, Percentage: 25, Color: "#89e051"},
+				}
 			},
 			wantErr: false,
 		},
@@ -361,6 +639,67 @@ func TestCardRepository_Update(t *testing.T) {
 				if dbCard.FullName != card.FullName {
 					t.Errorf("FullName = %v, want %v", dbCard.FullName, card.FullName)
 				}
+				topLanguages := dbCard.ToDomain().TopLanguages
+				if len(topLanguages) != len(card.TopLanguages) || topLanguages[0] != card.TopLanguages[0] {
+					t.Errorf("TopLanguages = %+v, want %+v", topLanguages, card.TopLanguages)
+				}
+			}
+		})
+	}
+}
+
+// CardRepositoryのUpdateSettingsメソッドをテスト
+func TestCardRepository_UpdateSettings(t *testing.T) {
+	db := SetupTestDB(t)
+
+	tests := []struct {
+		name    string
+		setup   func(db *gorm.DB) domain.CardID
+		wantErr error
+	}{
+		{
+			name: "カードの設定を更新できる",
+			setup: func(db *gorm.DB) domain.CardID {
+				card := createTestCard("settingstest", "U_settingstest")
+				dbCard := database.CardFromDomain(card)
+				db.Create(dbCard)
+				return domain.CardID(dbCard.ID)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "存在しないカードの場合",
+			setup: func(db *gorm.DB) domain.CardID {
+				return domain.NewCardID()
+			},
+			wantErr: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			CleanupTestData(t, db)
+			cardID := tt.setup(db)
+			ctx := context.Background()
+
+			repo := NewCardRepository(db)
+			settings := domain.CardSettings{IncludePrivateRepositories: true}
+			err := repo.UpdateSettings(ctx, cardID, settings)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateSettings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if tt.wantErr == nil {
+				var dbCard database.Card
+				if err := db.First(&dbCard, "id = ?", uuid.UUID(cardID)).Error; err != nil {
+					t.Errorf("更新されたカードが見つかりません: %v", err)
+					return
+				}
+				if dbCard.ToDomain().Settings != settings {
+					t.Errorf("Settings = %+v, want %+v", dbCard.ToDomain().Settings, settings)
+				}
 			}
 		})
 	}
@@ -370,46 +709,126 @@ func TestCardRepository_Update(t *testing.T) {
 func TestCardRepository_AddToCollectedCards(t *testing.T) {
 	db := SetupTestDB(t)
 
+	newCollectedCard := func(t *testing.T, collectorID string, cardID uuid.UUID, detail domain.CollectDetail) *domain.CollectedCard {
+		t.Helper()
+		collectedCard, err := domain.NewCollectedCard(collectorID, domain.CardID(cardID), detail)
+		if err != nil {
+			t.Fatalf("NewCollectedCard() error = %v", err)
+		}
+		return collectedCard
+	}
+
 	tests := []struct {
-		name    string
-		setup   func(db *gorm.DB) (string, domain.CardID)
-		wantErr bool
+		name      string
+		setup     func(t *testing.T, db *gorm.DB) *domain.CollectedCard
+		wantErr   bool
+		wantErrIs error
 	}{
 		{
 			name: "カードをコレクションに追加できる",
-			setup: func(db *gorm.DB) (string, domain.CardID) {
+			setup: func(t *testing.T, db *gorm.DB) *domain.CollectedCard {
 				card := createTestCard("addtest", "U_addtest")
 				dbCard := database.CardFromDomain(card)
 				db.Create(dbCard)
-				return "collector123", domain.CardID(dbCard.ID)
+				return newCollectedCard(t, "collector123", dbCard.ID, domain.CollectDetail{})
 			},
 			wantErr: false,
 		},
+		{
+			name: "集めたときの状況と一緒に追加できる",
+			setup: func(t *testing.T, db *gorm.DB) *domain.CollectedCard {
+				card := createTestCard("detailtest", "U_detailtest")
+				dbCard := database.CardFromDomain(card)
+				db.Create(dbCard)
+
+				community := createTestCommunity("Meetup")
+				db.Create(&database.Community{
+					ID:        uuid.UUID(community.ID),
+					Name:      community.Name,
+					StartedAt: community.StartedAt,
+					EndedAt:   community.EndedAt,
+				})
+				return newCollectedCard(t, "collector123", dbCard.ID, domain.CollectDetail{
+					Source:      domain.CollectSourceQR,
+					CommunityID: &community.ID,
+					Note:        "met at meetup",
+				})
+			},
+			wantErr: false,
+		},
+		{
+			name: "存在しないコミュニティを指定するとエラーになる",
+			setup: func(t *testing.T, db *gorm.DB) *domain.CollectedCard {
+				card := createTestCard("nocommunity", "U_nocommunity")
+				dbCard := database.CardFromDomain(card)
+				db.Create(dbCard)
+
+				communityID := domain.NewCommunityID()
+				return newCollectedCard(t, "collector123", dbCard.ID, domain.CollectDetail{CommunityID: &communityID})
+			},
+			wantErr:   true,
+			wantErrIs: domain.ErrNotFound,
+		},
+		{
+			name: "既にコレクションにあるカードを追加するとエラーになる",
+			setup: func(t *testing.T, db *gorm.DB) *domain.CollectedCard {
+				card := createTestCard("duplicatetest", "U_duplicatetest")
+				dbCard := database.CardFromDomain(card)
+				db.Create(dbCard)
+				db.Create(&database.CollectedCard{
+					CollectorGithubID: "collector123",
+					CardID:            dbCard.ID,
+				})
+				return newCollectedCard(t, "collector123", dbCard.ID, domain.CollectDetail{})
+			},
+			wantErr:   true,
+			wantErrIs: domain.ErrAlreadyExists,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			CleanupTestData(t, db)
-			collectorID, cardID := tt.setup(db)
+			collectedCard := tt.setup(t, db)
 			ctx := context.Background()
 
 			repo := NewCardRepository(db)
-			err := repo.AddToCollectedCards(ctx, collectorID, cardID)
+			err := repo.AddToCollectedCards(ctx, collectedCard)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AddToCollectedCards() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("AddToCollectedCards() error = %v, want %v", err, tt.wantErrIs)
+				return
+			}
+
 			if !tt.wantErr {
-				// 追加されたことを確認
-				var count int64
-				db.Model(&database.CollectedCard{}).
-					Where("collector_github_id = ? AND card_id = ?", collectorID, uuid.UUID(cardID)).
-					Count(&count)
+				// 追加されたカードが集めたときの状況と一緒に取得できることを確認
+				page, err := repo.Search(ctx, collectedCard.CollectorGithubID, domain.CardFilter{}, domain.PageRequest{
+					Limit:   domain.DefaultPageLimit,
+					SortKey: domain.SortKeyCollectedAt,
+					Order:   domain.SortOrderDesc,
+				})
+				if err != nil {
+					t.Fatalf("Search() error = %v", err)
+				}
+				if len(page.CollectedCards) != 1 {
+					t.Fatalf("コレクションにカードが追加されていません")
+				}
 
-				if count != 1 {
-					t.Errorf("コレクションにカードが追加されていません")
+				got := page.CollectedCards[0]
+				if got.CardID != collectedCard.CardID || got.Card == nil || got.Card.ID != collectedCard.CardID {
+					t.Errorf("追加したカードが違う: %+v", got)
+				}
+				if got.Source != collectedCard.Source || got.Note != collectedCard.Note || got.CollectedAt.IsZero() {
+					t.Errorf("集めたときの状況が違う: 期待=%+v, 実際=%+v", collectedCard.CollectDetail, got.CollectDetail)
+				}
+				if (got.CommunityID == nil) != (collectedCard.CommunityID == nil) ||
+					(got.CommunityID != nil && *got.CommunityID != *collectedCard.CommunityID) {
+					t.Errorf("CommunityIDが違う: 期待=%v, 実際=%v", collectedCard.CommunityID, got.CommunityID)
 				}
 			}
 		})
@@ -601,3 +1020,137 @@ func TestCardRepository_PostgresSpecificFeatures(t *testing.T) {
 		}
 	})
 }
+
+func TestCardRepository_AcceptExchange(t *testing.T) {
+	db := SetupTestDB(t)
+	ctx := context.Background()
+	repo := NewCardRepository(db)
+
+	// 申し出た人と受け取る人のカードを作成し、申し出を保存する
+	setup := func(t *testing.T, expiresAt time.Time) (*domain.CardExchange, *domain.Card) {
+		t.Helper()
+		CleanupTestData(t, db)
+
+		offeredCard := createTestCard("offerer", "U_offerer")
+		acceptedCard := createTestCard("accepter", "U_accepter")
+		for _, card := range []*domain.Card{offeredCard, acceptedCard} {
+			if err := repo.Create(ctx, card); err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+		}
+
+		exchange, err := domain.NewCardExchange(offeredCard.GithubID, offeredCard.ID, expiresAt)
+		if err != nil {
+			t.Fatalf("NewCardExchange() error = %v", err)
+		}
+		if err := repo.CreateExchange(ctx, exchange); err != nil {
+			t.Fatalf("CreateExchange() error = %v", err)
+		}
+		return exchange, acceptedCard
+	}
+
+	countCollected := func(t *testing.T, collectorGithubID string, cardID domain.CardID) int64 {
+		t.Helper()
+		var count int64
+		db.Model(&database.CollectedCard{}).
+			Where("collector_github_id = ? AND card_id = ?", collectorGithubID, uuid.UUID(cardID)).
+			Count(&count)
+		return count
+	}
+
+	t.Run("受け取るとお互いのデッキに相手のカードが追加される", func(t *testing.T) {
+		exchange, acceptedCard := setup(t, time.Now().Add(time.Minute))
+
+		detail := domain.CollectDetail{Source: domain.CollectSourceNFC, Note: "hello"}
+		if err := repo.AcceptExchange(ctx, exchange.Token, acceptedCard.GithubID, acceptedCard.ID, detail, time.Now()); err != nil {
+			t.Fatalf("AcceptExchange() error = %v", err)
+		}
+
+		if got := countCollected(t, acceptedCard.GithubID, exchange.OfferedCardID); got != 1 {
+			t.Errorf("受け取った人のデッキに申し出た人のカードがない: %d", got)
+		}
+		if got := countCollected(t, exchange.OfferedByGithubID, acceptedCard.ID); got != 1 {
+			t.Errorf("申し出た人のデッキに受け取った人のカードがない: %d", got)
+		}
+
+		found, err := repo.FindExchangeByToken(ctx, exchange.Token)
+		if err != nil {
+			t.Fatalf("FindExchangeByToken() error = %v", err)
+		}
+		if found.Status != domain.CardExchangeStatusAccepted {
+			t.Errorf("Status = %s, want accepted", found.Status)
+		}
+		if found.AcceptedByGithubID == nil || *found.AcceptedByGithubID != acceptedCard.GithubID {
+			t.Errorf("AcceptedByGithubID = %v, want %s", found.AcceptedByGithubID, acceptedCard.GithubID)
+		}
+		if found.OfferedCard == nil || found.AcceptedCard == nil {
+			t.Errorf("カードが読み込まれていない")
+		}
+
+		exchanges, err := repo.FindExchanges(ctx, acceptedCard.GithubID)
+		if err != nil {
+			t.Fatalf("FindExchanges() error = %v", err)
+		}
+		if len(exchanges) != 1 {
+			t.Errorf("受け取った人の交換一覧の件数 = %d, want 1", len(exchanges))
+		}
+	})
+
+	t.Run("既にデッキにあるカードがあっても受け取れる", func(t *testing.T) {
+		exchange, acceptedCard := setup(t, time.Now().Add(time.Minute))
+		db.Create(&database.CollectedCard{
+			CollectorGithubID: acceptedCard.GithubID,
+			CardID:            uuid.UUID(exchange.OfferedCardID),
+		})
+
+		if err := repo.AcceptExchange(ctx, exchange.Token, acceptedCard.GithubID, acceptedCard.ID, domain.CollectDetail{Source: domain.CollectSourceQR}, time.Now()); err != nil {
+			t.Fatalf("AcceptExchange() error = %v", err)
+		}
+		if got := countCollected(t, exchange.OfferedByGithubID, acceptedCard.ID); got != 1 {
+			t.Errorf("申し出た人のデッキに受け取った人のカードがない: %d", got)
+		}
+	})
+
+	t.Run("受け取り済みの申し出は受け取れない", func(t *testing.T) {
+		exchange, acceptedCard := setup(t, time.Now().Add(time.Minute))
+		detail := domain.CollectDetail{Source: domain.CollectSourceQR}
+		if err := repo.AcceptExchange(ctx, exchange.Token, acceptedCard.GithubID, acceptedCard.ID, detail, time.Now()); err != nil {
+			t.Fatalf("AcceptExchange() error = %v", err)
+		}
+
+		err := repo.AcceptExchange(ctx, exchange.Token, acceptedCard.GithubID, acceptedCard.ID, detail, time.Now())
+		if !errors.Is(err, domain.ErrAlreadyExists) {
+			t.Errorf("AcceptExchange() error = %v, want ErrAlreadyExists", err)
+		}
+	})
+
+	t.Run("有効期限を過ぎた申し出は受け取れずデッキも変わらない", func(t *testing.T) {
+		exchange, acceptedCard := setup(t, time.Now().Add(-time.Minute))
+
+		err := repo.AcceptExchange(ctx, exchange.Token, acceptedCard.GithubID, acceptedCard.ID, domain.CollectDetail{Source: domain.CollectSourceQR}, time.Now())
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("AcceptExchange() error = %v, want ErrNotFound", err)
+		}
+		if got := countCollected(t, acceptedCard.GithubID, exchange.OfferedCardID); got != 0 {
+			t.Errorf("デッキにカードが追加されている: %d", got)
+		}
+	})
+
+	t.Run("自分の申し出は受け取れない", func(t *testing.T) {
+		exchange, _ := setup(t, time.Now().Add(time.Minute))
+
+		err := repo.AcceptExchange(ctx, exchange.Token, exchange.OfferedByGithubID, exchange.OfferedCardID, domain.CollectDetail{Source: domain.CollectSourceQR}, time.Now())
+		if !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Errorf("AcceptExchange() error = %v, want ErrInvalidArgument", err)
+		}
+	})
+
+	t.Run("存在しないトークンは受け取れない", func(t *testing.T) {
+		_, acceptedCard := setup(t, time.Now().Add(time.Minute))
+
+		err := repo.AcceptExchange(ctx, "unknown", acceptedCard.GithubID, acceptedCard.ID, domain.CollectDetail{Source: domain.CollectSourceQR}, time.Now())
+		if !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("AcceptExchange() error = %v, want ErrNotFound", err)
+		}
+	})
+}
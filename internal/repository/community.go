@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/furarico/octo-deck-api/internal/database"
 	"github.com/furarico/octo-deck-api/internal/domain"
@@ -18,31 +19,75 @@ func NewCommunityRepository(db *gorm.DB) *communityRepository {
 	return &communityRepository{db: db}
 }
 
-// FindAll はすべてのコミュニティを取得する
-func (r *communityRepository) FindAll(ctx context.Context, githubID string) ([]domain.Community, error) {
-	var communities []database.Community
-	if err := r.db.WithContext(ctx).
-		Model(&database.Community{}).
-		Joins("JOIN community_cards cc ON cc.community_id = communities.id").
-		Joins("JOIN cards c ON c.id = cc.card_id AND c.github_id = ?", githubID).
-		Distinct().
-		Find(&communities).Error; err != nil {
+// communitySortColumns はコミュニティ一覧で使える並び替えのカラム
+var communitySortColumns = map[domain.SortKey]keysetColumn{
+	domain.SortKeyCreatedAt: {expr: "communities.created_at", sqlType: "timestamptz"},
+}
+
+// communityCardSortColumns はコミュニティのカード一覧で使える並び替えのカラム
+var communityCardSortColumns = map[domain.SortKey]keysetColumn{
+	domain.SortKeyContribution: {expr: "cc.total_contribution", sqlType: "bigint"},
+	domain.SortKeyUserName:     {expr: "COALESCE(cards.user_name, '')", sqlType: "text"},
+	domain.SortKeyLanguage:     {expr: "COALESCE(cards.most_used_language_name, '')", sqlType: "text"},
+}
+
+// communityPageRow はページ単位で取得したコミュニティの行
+type communityPageRow struct {
+	database.Community `gorm:"embedded"`
+	SortValue          string
+	CursorID           uuid.UUID
+}
+
+// FindPage は指定したユーザーが参加しているコミュニティをページ単位で取得する
+func (r *communityRepository) FindPage(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error) {
+	column, ok := communitySortColumns[page.SortKey]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported sort key: %s", domain.ErrInvalidArgument, page.SortKey)
+	}
+
+	query, err := paginate(
+		r.db.WithContext(ctx).
+			Model(&database.Community{}).
+			Where(`EXISTS (
+				SELECT 1 FROM community_cards cc
+				JOIN cards c ON c.id = cc.card_id
+				WHERE cc.community_id = communities.id AND c.github_id = ?
+			)`, githubID),
+		page, "communities.*", column, "communities.id",
+	)
+	if err != nil {
 		return nil, err
 	}
 
-	var result []domain.Community
-	for _, community := range communities {
-		result = append(result, *community.ToDomain())
+	var rows []communityPageRow
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, translateError(err)
 	}
 
-	return result, nil
+	rows, cursor := trimPage(page, rows, func(row communityPageRow) pageRow {
+		return pageRow{SortValue: row.SortValue, CursorID: row.CursorID}
+	})
+	communities := make([]domain.Community, 0, len(rows))
+	for _, row := range rows {
+		communities = append(communities, *row.Community.ToDomain())
+	}
+
+	return &domain.CommunityPage{
+		Communities: communities,
+		NextCursor:  cursor,
+	}, nil
 }
 
 // FindByID は指定されたコミュニティIDの情報を取得する
 func (r *communityRepository) FindByID(ctx context.Context, id string) (*domain.Community, error) {
+	communityUUID, err := parseUUID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community id: %w", err)
+	}
+
 	var community database.Community
-	if err := r.db.WithContext(ctx).First(&community, "id = ?", id).Error; err != nil {
-		return nil, err
+	if err := r.db.WithContext(ctx).First(&community, "id = ?", communityUUID).Error; err != nil {
+		return nil, translateError(err)
 	}
 
 	return community.ToDomain(), nil
@@ -50,6 +95,11 @@ func (r *communityRepository) FindByID(ctx context.Context, id string) (*domain.
 
 // FindByIDWithHighlightedCard は指定されたコミュニティIDの情報をHighlightedCard付きで取得する
 func (r *communityRepository) FindByIDWithHighlightedCard(ctx context.Context, id string) (*domain.Community, error) {
+	communityUUID, err := parseUUID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community id: %w", err)
+	}
+
 	var community database.Community
 	if err := r.db.WithContext(ctx).
 		Preload("BestContributorCard").
@@ -57,8 +107,8 @@ func (r *communityRepository) FindByIDWithHighlightedCard(ctx context.Context, i
 		Preload("BestIssuerCard").
 		Preload("BestPullRequesterCard").
 		Preload("BestReviewerCard").
-		First(&community, "id = ?", id).Error; err != nil {
-		return nil, err
+		First(&community, "id = ?", communityUUID).Error; err != nil {
+		return nil, translateError(err)
 	}
 
 	return community.ToDomain(), nil
@@ -109,18 +159,47 @@ func (r *communityRepository) UpdateHighlightedCard(ctx context.Context, communi
 		updates["best_reviewer_card_id"] = nil
 	}
 
-	return r.db.WithContext(ctx).Model(&database.Community{}).Where("id = ?", communityUUID).Updates(updates).Error
+	updates["highlighted_card_refreshed_at"] = time.Now()
+
+	return translateError(r.db.WithContext(ctx).Model(&database.Community{}).Where("id = ?", communityUUID).Updates(updates).Error)
+}
+
+// FindActive は now が期間に含まれるコミュニティのうち、HighlightedCardを refreshedBefore より前に計算した、
+// または一度も計算していないものを古い順に limit 件取得する
+func (r *communityRepository) FindActive(ctx context.Context, now time.Time, refreshedBefore time.Time, limit int) ([]domain.Community, error) {
+	var dbCommunities []database.Community
+	if err := r.db.WithContext(ctx).
+		Where("started_at <= ? AND ended_at >= ?", now, now).
+		Where("highlighted_card_refreshed_at IS NULL OR highlighted_card_refreshed_at < ?", refreshedBefore).
+		Order("highlighted_card_refreshed_at NULLS FIRST").
+		Order("id").
+		Limit(limit).
+		Find(&dbCommunities).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	result := make([]domain.Community, 0, len(dbCommunities))
+	for _, dbCommunity := range dbCommunities {
+		result = append(result, *dbCommunity.ToDomain())
+	}
+
+	return result, nil
 }
 
 // FindCards は指定したコミュニティIDのカード一覧をトータルコントリビューション数でソートして取得する
 func (r *communityRepository) FindCards(ctx context.Context, id string) ([]domain.Card, error) {
+	communityUUID, err := parseUUID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community id: %w", err)
+	}
+
 	var cards []database.Card
 	if err := r.db.WithContext(ctx).
 		Joins("JOIN community_cards cc ON cc.card_id = cards.id").
-		Where("cc.community_id = ?", id).
+		Where("cc.community_id = ?", communityUUID).
 		Order("cc.total_contribution DESC").
 		Find(&cards).Error; err != nil {
-		return nil, err
+		return nil, translateError(err)
 	}
 
 	var result []domain.Card
@@ -131,21 +210,199 @@ func (r *communityRepository) FindCards(ctx context.Context, id string) ([]domai
 	return result, nil
 }
 
-// Create はコミュニティを作成する
-func (r *communityRepository) Create(ctx context.Context, community *domain.Community) error {
+// FindCardsPage は指定したコミュニティIDのカード一覧をページ単位で取得する
+func (r *communityRepository) FindCardsPage(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error) {
+	communityUUID, err := parseUUID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community id: %w", err)
+	}
+
+	column, ok := communityCardSortColumns[page.SortKey]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported sort key: %s", domain.ErrInvalidArgument, page.SortKey)
+	}
+
+	query, err := paginate(
+		r.db.WithContext(ctx).
+			Model(&database.Card{}).
+			Joins("JOIN community_cards cc ON cc.card_id = cards.id").
+			Where("cc.community_id = ?", communityUUID),
+		page, "cards.*", column, "cards.id",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []cardPageRow
+	if err := query.Scan(&rows).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	return toCardPage(page, rows), nil
+}
+
+// Create はコミュニティを作成し、作成者のカードをオーナーとして参加させる
+func (r *communityRepository) Create(ctx context.Context, community *domain.Community, ownerCardID string) error {
+	ownerCardUUID, err := parseUUID(ownerCardID)
+	if err != nil {
+		return fmt.Errorf("invalid card id: %w", err)
+	}
+
 	dbCommunity := &database.Community{
-		ID:        uuid.UUID(community.ID),
-		Name:      community.Name,
-		StartedAt: community.StartedAt,
-		EndedAt:   community.EndedAt,
+		ID:            uuid.UUID(community.ID),
+		Name:          community.Name,
+		OwnerGithubID: community.OwnerGithubID,
+		StartedAt:     community.StartedAt,
+		EndedAt:       community.EndedAt,
 	}
 
-	return r.db.WithContext(ctx).Create(dbCommunity).Error
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(dbCommunity).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&database.CommunityCard{
+			CommunityID: dbCommunity.ID,
+			CardID:      ownerCardUUID,
+			Role:        string(domain.CommunityRoleOwner),
+		}).Error
+	}))
 }
 
-// Delete はコミュニティを削除する
+// Update はコミュニティの名前と集計期間を更新する
+func (r *communityRepository) Update(ctx context.Context, community *domain.Community) error {
+	result := r.db.WithContext(ctx).
+		Model(&database.Community{}).
+		Where("id = ?", uuid.UUID(community.ID)).
+		Updates(map[string]interface{}{
+			"name":       community.Name,
+			"started_at": community.StartedAt,
+			"ended_at":   community.EndedAt,
+		})
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("community not found: %w", domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+// Delete はコミュニティを論理削除する
+// メンバーや招待コードは復元できるように残しておく
 func (r *communityRepository) Delete(ctx context.Context, id string) error {
-	return r.db.WithContext(ctx).Delete(&database.Community{}, "id = ?", id).Error
+	communityUUID, err := parseUUID(id)
+	if err != nil {
+		return fmt.Errorf("invalid community id: %w", err)
+	}
+
+	return translateError(r.db.WithContext(ctx).Delete(&database.Community{}, "id = ?", communityUUID).Error)
+}
+
+// Purge はコミュニティとメンバー、招待コードを1つのトランザクションで物理削除する
+// 論理削除済みのコミュニティも削除できる
+func (r *communityRepository) Purge(ctx context.Context, id string) error {
+	communityUUID, err := parseUUID(id)
+	if err != nil {
+		return fmt.Errorf("invalid community id: %w", err)
+	}
+
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := purgeCommunityData(tx, []uuid.UUID{communityUUID}); err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Delete(&database.Community{}, "id = ?", communityUUID)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
+	}))
+}
+
+// PurgeDeletedBefore は指定した日時より前に論理削除されたコミュニティをまとめて物理削除し、削除した件数を返す
+func (r *communityRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	var purged int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []uuid.UUID
+		if err := tx.Unscoped().
+			Model(&database.Community{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", before).
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		if err := purgeCommunityData(tx, ids); err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Delete(&database.Community{}, "id IN ?", ids).Error; err != nil {
+			return err
+		}
+
+		purged = len(ids)
+		return nil
+	})
+	if err != nil {
+		return 0, translateError(err)
+	}
+
+	return purged, nil
+}
+
+// purgeCommunityData はコミュニティに紐づくメンバーと招待コードを削除する
+// 外部キーの ON DELETE CASCADE でも削除されるが、制約がない古いスキーマでも孤立した行が残らないように明示的に削除する
+func purgeCommunityData(tx *gorm.DB, communityIDs []uuid.UUID) error {
+	if err := tx.Where("community_id IN ?", communityIDs).Delete(&database.CommunityInvite{}).Error; err != nil {
+		return err
+	}
+	return tx.Where("community_id IN ?", communityIDs).Delete(&database.CommunityCard{}).Error
+}
+
+// FindDeletedByID は論理削除されたコミュニティを取得する
+func (r *communityRepository) FindDeletedByID(ctx context.Context, id string) (*domain.Community, error) {
+	communityUUID, err := parseUUID(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community id: %w", err)
+	}
+
+	var community database.Community
+	if err := r.db.WithContext(ctx).
+		Unscoped().
+		Where("deleted_at IS NOT NULL").
+		First(&community, "id = ?", communityUUID).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	return community.ToDomain(), nil
+}
+
+// Restore は指定した日時より後に論理削除されたコミュニティを復元する
+func (r *communityRepository) Restore(ctx context.Context, id string, deletedAfter time.Time) error {
+	communityUUID, err := parseUUID(id)
+	if err != nil {
+		return fmt.Errorf("invalid community id: %w", err)
+	}
+
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Model(&database.Community{}).
+		Where("id = ? AND deleted_at IS NOT NULL AND deleted_at > ?", communityUUID, deletedAfter).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("deleted community not found: %w", domain.ErrNotFound)
+	}
+
+	return nil
 }
 
 // AddCard はコミュニティにカードを追加する
@@ -164,7 +421,13 @@ func (r *communityRepository) AddCard(ctx context.Context, communityID string, c
 		CardID:      cardUUID,
 	}
 
-	return r.db.WithContext(ctx).Create(communityCard).Error
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 論理削除されたコミュニティには参加できない
+		if err := tx.Select("id").First(&database.Community{}, "id = ?", communityUUID).Error; err != nil {
+			return err
+		}
+		return tx.Create(communityCard).Error
+	}))
 }
 
 // RemoveCard はコミュニティからカードを削除する
@@ -178,9 +441,188 @@ func (r *communityRepository) RemoveCard(ctx context.Context, communityID string
 		return fmt.Errorf("invalid card id: %w", err)
 	}
 
-	return r.db.WithContext(ctx).
+	return translateError(r.db.WithContext(ctx).
+		Where("community_id = ? AND card_id = ?", communityUUID, cardUUID).
+		Delete(&database.CommunityCard{}).Error)
+}
+
+// FindMemberRole は指定したカードのコミュニティ内での役割を取得する
+// コミュニティに参加していない場合は domain.ErrNotFound を返す
+func (r *communityRepository) FindMemberRole(ctx context.Context, communityID string, cardID string) (domain.CommunityRole, error) {
+	communityUUID, err := parseUUID(communityID)
+	if err != nil {
+		return "", fmt.Errorf("invalid community id: %w", err)
+	}
+	cardUUID, err := parseUUID(cardID)
+	if err != nil {
+		return "", fmt.Errorf("invalid card id: %w", err)
+	}
+
+	var communityCard database.CommunityCard
+	if err := r.db.WithContext(ctx).
+		Where("community_id = ? AND card_id = ?", communityUUID, cardUUID).
+		First(&communityCard).Error; err != nil {
+		return "", translateError(err)
+	}
+
+	return domain.CommunityRole(communityCard.Role), nil
+}
+
+// UpdateMemberRole は指定したカードのコミュニティ内での役割を更新する
+func (r *communityRepository) UpdateMemberRole(ctx context.Context, communityID string, cardID string, role domain.CommunityRole) error {
+	communityUUID, err := parseUUID(communityID)
+	if err != nil {
+		return fmt.Errorf("invalid community id: %w", err)
+	}
+	cardUUID, err := parseUUID(cardID)
+	if err != nil {
+		return fmt.Errorf("invalid card id: %w", err)
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&database.CommunityCard{}).
 		Where("community_id = ? AND card_id = ?", communityUUID, cardUUID).
-		Delete(&database.CommunityCard{}).Error
+		Update("role", string(role))
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("community member not found: %w", domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+// TransferOwnership はコミュニティのオーナーを移譲する
+// 新しいオーナーのカードをownerに、元のオーナーのカードをadminにし、communities.owner_github_idを更新する
+func (r *communityRepository) TransferOwnership(ctx context.Context, communityID string, fromCardID string, toCardID string) error {
+	communityUUID, err := parseUUID(communityID)
+	if err != nil {
+		return fmt.Errorf("invalid community id: %w", err)
+	}
+	fromCardUUID, err := parseUUID(fromCardID)
+	if err != nil {
+		return fmt.Errorf("invalid card id: %w", err)
+	}
+	toCardUUID, err := parseUUID(toCardID)
+	if err != nil {
+		return fmt.Errorf("invalid card id: %w", err)
+	}
+
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&database.CommunityCard{}).
+			Where("community_id = ? AND card_id = ?", communityUUID, fromCardUUID).
+			Update("role", string(domain.CommunityRoleAdmin)).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&database.CommunityCard{}).
+			Where("community_id = ? AND card_id = ?", communityUUID, toCardUUID).
+			Update("role", string(domain.CommunityRoleOwner))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		return tx.Model(&database.Community{}).
+			Where("id = ?", communityUUID).
+			Update("owner_github_id", tx.Model(&database.Card{}).Select("github_id").Where("id = ?", toCardUUID)).Error
+	}))
+}
+
+// CreateInvite はコミュニティの招待コードを作成する
+func (r *communityRepository) CreateInvite(ctx context.Context, invite *domain.CommunityInvite) error {
+	return translateError(r.db.WithContext(ctx).Create(database.CommunityInviteFromDomain(invite)).Error)
+}
+
+// FindActiveInvites は指定したコミュニティの使用可能な招待コードを作成日時の新しい順に取得する
+func (r *communityRepository) FindActiveInvites(ctx context.Context, communityID string, now time.Time) ([]domain.CommunityInvite, error) {
+	communityUUID, err := parseUUID(communityID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid community id: %w", err)
+	}
+
+	var invites []database.CommunityInvite
+	if err := r.db.WithContext(ctx).
+		Where("community_id = ? AND revoked_at IS NULL AND expires_at > ?", communityUUID, now).
+		Where("NOT single_use OR used_count = 0").
+		Order("created_at DESC").
+		Find(&invites).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	var result []domain.CommunityInvite
+	for _, invite := range invites {
+		result = append(result, *invite.ToDomain())
+	}
+
+	return result, nil
+}
+
+// FindInviteByCode は招待コードから招待を取得する
+func (r *communityRepository) FindInviteByCode(ctx context.Context, code string) (*domain.CommunityInvite, error) {
+	var invite database.CommunityInvite
+	if err := r.db.WithContext(ctx).First(&invite, "code = ?", code).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	return invite.ToDomain(), nil
+}
+
+// JoinByInvite は招待コードの使用回数を1増やし、招待先のコミュニティにカードを追加する
+// 使用回数の確認とメンバーの追加は1つのトランザクションで行い、追加に失敗した場合はコードを消費しない
+// 使用可能な状態でなくなっていた場合（同時に使われた1回限りのコードなど）は domain.ErrNotFound を返す
+func (r *communityRepository) JoinByInvite(ctx context.Context, code string, cardID string, now time.Time) error {
+	cardUUID, err := parseUUID(cardID)
+	if err != nil {
+		return fmt.Errorf("invalid card id: %w", err)
+	}
+
+	return translateError(r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&database.CommunityInvite{}).
+			Where("code = ? AND revoked_at IS NULL AND expires_at > ?", code, now).
+			Where("NOT single_use OR used_count = 0").
+			Update("used_count", gorm.Expr("used_count + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("active invite not found: %w", domain.ErrNotFound)
+		}
+
+		var invite database.CommunityInvite
+		if err := tx.Select("community_id").First(&invite, "code = ?", code).Error; err != nil {
+			return err
+		}
+		// 論理削除されたコミュニティには参加できない
+		if err := tx.Select("id").First(&database.Community{}, "id = ?", invite.CommunityID).Error; err != nil {
+			return err
+		}
+		return tx.Create(&database.CommunityCard{CommunityID: invite.CommunityID, CardID: cardUUID}).Error
+	}))
+}
+
+// RevokeInvite は指定したコミュニティの招待コードを取り消す
+func (r *communityRepository) RevokeInvite(ctx context.Context, communityID string, code string, now time.Time) error {
+	communityUUID, err := parseUUID(communityID)
+	if err != nil {
+		return fmt.Errorf("invalid community id: %w", err)
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&database.CommunityInvite{}).
+		Where("community_id = ? AND code = ? AND revoked_at IS NULL", communityUUID, code).
+		Update("revoked_at", now)
+	if result.Error != nil {
+		return translateError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("invite not found: %w", domain.ErrNotFound)
+	}
+
+	return nil
 }
 
 // UpdateCommunityCardContributions は指定したコミュニティのカードのコントリビュート数を一括更新する
@@ -200,7 +642,7 @@ func (r *communityRepository) UpdateCommunityCardContributions(ctx context.Conte
 			Model(&database.CommunityCard{}).
 			Where("community_id = ? AND card_id = ?", communityUUID, cardUUID).
 			Update("total_contribution", totalContribution).Error; err != nil {
-			return fmt.Errorf("failed to update community card contribution: %w", err)
+			return fmt.Errorf("failed to update community card contribution: %w", translateError(err))
 		}
 	}
 
@@ -208,6 +650,11 @@ func (r *communityRepository) UpdateCommunityCardContributions(ctx context.Conte
 }
 
 // parseUUID はstringをuuid.UUIDに変換する
+// 変換できない場合は domain.ErrInvalidArgument をラップしたエラーを返す
 func parseUUID(s string) (uuid.UUID, error) {
-	return uuid.Parse(s)
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("%w: %w", domain.ErrInvalidArgument, err)
+	}
+	return id, nil
 }
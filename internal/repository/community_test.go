@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -16,14 +18,22 @@ func createTestCommunity(name string) *domain.Community {
 	now := time.Now()
 	return domain.NewCommunity(
 		name,
+		"owner",
 		now,
 		now.Add(30*24*time.Hour), // 30日後
 		domain.HighlightedCard{},
 	)
 }
 
-// CommunityRepositoryのFindAllメソッドをテスト
-func TestCommunityRepository_FindAll(t *testing.T) {
+// communityPageRequest はコミュニティ一覧を1ページで取得する条件
+var communityPageRequest = domain.PageRequest{
+	Limit:   domain.DefaultPageLimit,
+	SortKey: domain.SortKeyCreatedAt,
+	Order:   domain.SortOrderDesc,
+}
+
+// CommunityRepositoryのFindPageメソッドをテスト
+func TestCommunityRepository_FindPage(t *testing.T) {
 	db := SetupTestDB(t)
 
 	tests := []struct {
@@ -143,15 +153,15 @@ func TestCommunityRepository_FindAll(t *testing.T) {
 			ctx := context.Background()
 
 			repo := NewCommunityRepository(db)
-			communities, err := repo.FindAll(ctx, githubID)
+			page, err := repo.FindPage(ctx, githubID, communityPageRequest)
 
 			if (err != nil) != tt.wantErr {
-				t.Errorf("FindAll() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("FindPage() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
-			if len(communities) != tt.wantCommunityCount {
-				t.Errorf("FindAll() returned %d communities, want %d", len(communities), tt.wantCommunityCount)
+			if len(page.Communities) != tt.wantCommunityCount {
+				t.Errorf("FindPage() returned %d communities, want %d", len(page.Communities), tt.wantCommunityCount)
 			}
 		})
 	}
@@ -162,9 +172,10 @@ func TestCommunityRepository_FindByID(t *testing.T) {
 	db := SetupTestDB(t)
 
 	tests := []struct {
-		name    string
-		setup   func(db *gorm.DB) string // communityIDを返す
-		wantErr bool
+		name      string
+		setup     func(db *gorm.DB) string // communityIDを返す
+		wantErr   bool
+		wantErrIs error
 	}{
 		{
 			name: "存在するIDでコミュニティを取得できる",
@@ -186,7 +197,16 @@ func TestCommunityRepository_FindByID(t *testing.T) {
 			setup: func(db *gorm.DB) string {
 				return uuid.New().String()
 			},
-			wantErr: true,
+			wantErr:   true,
+			wantErrIs: domain.ErrNotFound,
+		},
+		{
+			name: "無効なIDの場合エラーになる",
+			setup: func(db *gorm.DB) string {
+				return "invalid-uuid"
+			},
+			wantErr:   true,
+			wantErrIs: domain.ErrInvalidArgument,
 		},
 	}
 
@@ -204,6 +224,11 @@ func TestCommunityRepository_FindByID(t *testing.T) {
 				return
 			}
 
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("FindByID() error = %v, want %v", err, tt.wantErrIs)
+				return
+			}
+
 			if !tt.wantErr && community != nil {
 				if uuid.UUID(community.ID).String() != communityID {
 					t.Errorf("ID = %v, want %v", uuid.UUID(community.ID).String(), communityID)
@@ -458,6 +483,65 @@ func TestCommunityRepository_FindCards(t *testing.T) {
 	}
 }
 
+// CommunityRepositoryのFindCardsPageメソッドでコントリビュート数順にページをたどれることをテスト
+func TestCommunityRepository_FindCardsPage(t *testing.T) {
+	db := SetupTestDB(t)
+	CleanupTestData(t, db)
+	ctx := context.Background()
+
+	community := createTestCommunity("Paged Community")
+	dbCommunity := &database.Community{
+		ID:        uuid.UUID(community.ID),
+		Name:      community.Name,
+		StartedAt: community.StartedAt,
+		EndedAt:   community.EndedAt,
+	}
+	db.Create(dbCommunity)
+
+	contributions := map[string]int{"card1": 30, "card2": 10, "card3": 30, "card4": 20}
+	for githubID, contribution := range contributions {
+		dbCard := database.CardFromDomain(createTestCard(githubID, "U_"+githubID))
+		db.Create(dbCard)
+		db.Create(&database.CommunityCard{
+			CommunityID:       dbCommunity.ID,
+			CardID:            dbCard.ID,
+			TotalContribution: contribution,
+		})
+	}
+
+	repo := NewCommunityRepository(db)
+	page := domain.PageRequest{Limit: 3, SortKey: domain.SortKeyContribution, Order: domain.SortOrderDesc}
+
+	first, err := repo.FindCardsPage(ctx, dbCommunity.ID.String(), page)
+	if err != nil {
+		t.Fatalf("FindCardsPage() error = %v", err)
+	}
+	if len(first.Cards) != 3 || first.NextCursor == "" {
+		t.Fatalf("FindCardsPage() returned %d cards, cursor=%q, want 3 cards and a cursor", len(first.Cards), first.NextCursor)
+	}
+
+	page.Cursor = first.NextCursor
+	second, err := repo.FindCardsPage(ctx, dbCommunity.ID.String(), page)
+	if err != nil {
+		t.Fatalf("FindCardsPage() error = %v", err)
+	}
+	if len(second.Cards) != 1 || second.NextCursor != "" {
+		t.Fatalf("FindCardsPage() returned %d cards, cursor=%q, want 1 card and no cursor", len(second.Cards), second.NextCursor)
+	}
+
+	var got []int
+	for _, card := range append(first.Cards, second.Cards...) {
+		got = append(got, contributions[card.GithubID])
+	}
+	want := []int{30, 30, 20, 10}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("コントリビュート数の並び = %v, want %v", got, want)
+			break
+		}
+	}
+}
+
 // CommunityRepositoryのCreateメソッドをテスト
 func TestCommunityRepository_Create(t *testing.T) {
 	db := SetupTestDB(t)
@@ -484,8 +568,12 @@ func TestCommunityRepository_Create(t *testing.T) {
 			CleanupTestData(t, db)
 			ctx := context.Background()
 
+			// オーナーのカードを作成
+			ownerCard := database.CardFromDomain(createTestCard("owner", "U_owner"))
+			db.Create(ownerCard)
+
 			repo := NewCommunityRepository(db)
-			err := repo.Create(ctx, tt.community)
+			err := repo.Create(ctx, tt.community, ownerCard.ID.String())
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
@@ -503,6 +591,16 @@ func TestCommunityRepository_Create(t *testing.T) {
 				if dbCommunity.Name != tt.community.Name {
 					t.Errorf("Name = %v, want %v", dbCommunity.Name, tt.community.Name)
 				}
+
+				// オーナーがメンバーとして追加されていることを確認
+				var communityCard database.CommunityCard
+				if err := db.First(&communityCard, "community_id = ? AND card_id = ?", dbCommunity.ID, ownerCard.ID).Error; err != nil {
+					t.Errorf("オーナーのメンバーシップが見つかりません: %v", err)
+					return
+				}
+				if communityCard.Role != string(domain.CommunityRoleOwner) {
+					t.Errorf("Role = %v, want %v", communityCard.Role, domain.CommunityRoleOwner)
+				}
 			}
 		})
 	}
@@ -567,14 +665,141 @@ func TestCommunityRepository_Delete(t *testing.T) {
 	}
 }
 
+// 論理削除、復元、物理削除をテスト
+func TestCommunityRepository_SoftDeleteAndPurge(t *testing.T) {
+	db := SetupTestDB(t)
+
+	// コミュニティとメンバー、招待コードを作成するヘルパー
+	setup := func(t *testing.T) string {
+		t.Helper()
+		CleanupTestData(t, db)
+
+		card := database.CardFromDomain(createTestCard("member", "U_member"))
+		db.Create(card)
+
+		community := createTestCommunity("Soft Delete Target")
+		dbCommunity := &database.Community{
+			ID:        uuid.UUID(community.ID),
+			Name:      community.Name,
+			StartedAt: community.StartedAt,
+			EndedAt:   community.EndedAt,
+		}
+		db.Create(dbCommunity)
+		db.Create(&database.CommunityCard{CommunityID: dbCommunity.ID, CardID: card.ID})
+
+		invite, err := domain.NewCommunityInvite(community.ID, "owner", time.Now().Add(time.Hour), false)
+		if err != nil {
+			t.Fatalf("招待コードの生成に失敗しました: %v", err)
+		}
+		db.Create(database.CommunityInviteFromDomain(invite))
+
+		return dbCommunity.ID.String()
+	}
+
+	countMembers := func(communityID string) int64 {
+		var count int64
+		db.Model(&database.CommunityCard{}).Where("community_id = ?", communityID).Count(&count)
+		return count
+	}
+
+	t.Run("論理削除したコミュニティは取得できないが復元できる", func(t *testing.T) {
+		communityID := setup(t)
+		ctx := context.Background()
+		repo := NewCommunityRepository(db)
+
+		if err := repo.Delete(ctx, communityID); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		if _, err := repo.FindByID(ctx, communityID); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("FindByID() error = %v, want %v", err, domain.ErrNotFound)
+		}
+		page, err := repo.FindPage(ctx, "member", communityPageRequest)
+		if err != nil {
+			t.Fatalf("FindPage() error = %v", err)
+		}
+		if len(page.Communities) != 0 {
+			t.Errorf("FindPage() returned %d communities, want 0", len(page.Communities))
+		}
+		if countMembers(communityID) != 1 {
+			t.Error("論理削除でメンバーが削除されています")
+		}
+
+		deleted, err := repo.FindDeletedByID(ctx, communityID)
+		if err != nil {
+			t.Fatalf("FindDeletedByID() error = %v", err)
+		}
+		if deleted.DeletedAt == nil {
+			t.Error("DeletedAtが設定されていません")
+		}
+
+		// 復元期間を過ぎている場合は復元できない
+		if err := repo.Restore(ctx, communityID, time.Now()); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("Restore() error = %v, want %v", err, domain.ErrNotFound)
+		}
+
+		if err := repo.Restore(ctx, communityID, time.Now().Add(-time.Hour)); err != nil {
+			t.Fatalf("Restore() error = %v", err)
+		}
+		if _, err := repo.FindByID(ctx, communityID); err != nil {
+			t.Errorf("復元したコミュニティが取得できません: %v", err)
+		}
+	})
+
+	t.Run("物理削除するとメンバーと招待コードも削除される", func(t *testing.T) {
+		communityID := setup(t)
+		ctx := context.Background()
+		repo := NewCommunityRepository(db)
+
+		if err := repo.Purge(ctx, communityID); err != nil {
+			t.Fatalf("Purge() error = %v", err)
+		}
+
+		var count int64
+		db.Unscoped().Model(&database.Community{}).Where("id = ?", communityID).Count(&count)
+		if count != 0 {
+			t.Error("コミュニティが削除されていません")
+		}
+		if countMembers(communityID) != 0 {
+			t.Error("メンバーが削除されていません")
+		}
+		db.Model(&database.CommunityInvite{}).Where("community_id = ?", communityID).Count(&count)
+		if count != 0 {
+			t.Error("招待コードが削除されていません")
+		}
+	})
+
+	t.Run("復元期間を過ぎた論理削除済みのコミュニティをまとめて物理削除できる", func(t *testing.T) {
+		communityID := setup(t)
+		ctx := context.Background()
+		repo := NewCommunityRepository(db)
+
+		if err := repo.Delete(ctx, communityID); err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		purged, err := repo.PurgeDeletedBefore(ctx, time.Now().Add(time.Minute))
+		if err != nil {
+			t.Fatalf("PurgeDeletedBefore() error = %v", err)
+		}
+		if purged != 1 {
+			t.Errorf("PurgeDeletedBefore() = %d, want 1", purged)
+		}
+		if countMembers(communityID) != 0 {
+			t.Error("メンバーが削除されていません")
+		}
+	})
+}
+
 // CommunityRepositoryのAddCardメソッドをテスト
 func TestCommunityRepository_AddCard(t *testing.T) {
 	db := SetupTestDB(t)
 
 	tests := []struct {
-		name    string
-		setup   func(db *gorm.DB) (string, string) // communityID, cardIDを返す
-		wantErr bool
+		name      string
+		setup     func(db *gorm.DB) (string, string) // communityID, cardIDを返す
+		wantErr   bool
+		wantErrIs error
 	}{
 		{
 			name: "コミュニティにカードを追加できる",
@@ -610,7 +835,34 @@ func TestCommunityRepository_AddCard(t *testing.T) {
 			setup: func(db *gorm.DB) (string, string) {
 				return uuid.New().String(), "invalid-uuid"
 			},
-			wantErr: true,
+			wantErr:   true,
+			wantErrIs: domain.ErrInvalidArgument,
+		},
+		{
+			name: "既に参加しているコミュニティに追加するとエラーになる",
+			setup: func(db *gorm.DB) (string, string) {
+				card := createTestCard("duplicatecard", "U_duplicatecard")
+				dbCard := database.CardFromDomain(card)
+				db.Create(dbCard)
+
+				community := createTestCommunity("Test Community")
+				dbCommunity := &database.Community{
+					ID:        uuid.UUID(community.ID),
+					Name:      community.Name,
+					StartedAt: community.StartedAt,
+					EndedAt:   community.EndedAt,
+				}
+				db.Create(dbCommunity)
+
+				db.Create(&database.CommunityCard{
+					CommunityID: dbCommunity.ID,
+					CardID:      dbCard.ID,
+				})
+
+				return dbCommunity.ID.String(), dbCard.ID.String()
+			},
+			wantErr:   true,
+			wantErrIs: domain.ErrAlreadyExists,
 		},
 	}
 
@@ -628,6 +880,11 @@ func TestCommunityRepository_AddCard(t *testing.T) {
 				return
 			}
 
+			if tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("AddCard() error = %v, want %v", err, tt.wantErrIs)
+				return
+			}
+
 			if !tt.wantErr {
 				// 追加されたことを確認
 				var count int64
@@ -733,3 +990,136 @@ func TestCommunityRepository_RemoveCard(t *testing.T) {
 		})
 	}
 }
+
+// CommunityRepositoryの招待コード関連メソッドをテスト
+func TestCommunityRepository_Invites(t *testing.T) {
+	db := SetupTestDB(t)
+
+	tests := []struct {
+		name          string
+		singleUse     bool
+		expiresIn     time.Duration
+		revoke        bool
+		useCount      int
+		sameCard      bool
+		wantActive    bool
+		wantUseErrIs  error
+		wantUsedCount int
+	}{
+		{
+			name:          "何度でも使える招待コードは使用後も有効",
+			expiresIn:     time.Hour,
+			useCount:      2,
+			wantActive:    true,
+			wantUsedCount: 2,
+		},
+		{
+			name:          "1回限りの招待コードは2回目に使えない",
+			singleUse:     true,
+			expiresIn:     time.Hour,
+			useCount:      2,
+			wantActive:    false,
+			wantUseErrIs:  domain.ErrNotFound,
+			wantUsedCount: 1,
+		},
+		{
+			name:          "既に参加しているカードで使った場合はコードを消費しない",
+			expiresIn:     time.Hour,
+			useCount:      2,
+			sameCard:      true,
+			wantActive:    true,
+			wantUseErrIs:  domain.ErrAlreadyExists,
+			wantUsedCount: 1,
+		},
+		{
+			name:          "期限切れの招待コードは使えない",
+			expiresIn:     -time.Hour,
+			useCount:      1,
+			wantActive:    false,
+			wantUseErrIs:  domain.ErrNotFound,
+			wantUsedCount: 0,
+		},
+		{
+			name:          "取り消した招待コードは使えない",
+			expiresIn:     time.Hour,
+			revoke:        true,
+			useCount:      1,
+			wantActive:    false,
+			wantUseErrIs:  domain.ErrNotFound,
+			wantUsedCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			CleanupTestData(t, db)
+			ctx := context.Background()
+			repo := NewCommunityRepository(db)
+
+			community := createTestCommunity("Invite Community")
+			db.Create(&database.Community{
+				ID:        uuid.UUID(community.ID),
+				Name:      community.Name,
+				StartedAt: community.StartedAt,
+				EndedAt:   community.EndedAt,
+			})
+			communityID := uuid.UUID(community.ID).String()
+
+			invite, err := domain.NewCommunityInvite(community.ID, "owner", time.Now().Add(tt.expiresIn), tt.singleUse)
+			if err != nil {
+				t.Fatalf("招待コードの生成に失敗しました: %v", err)
+			}
+			if err := repo.CreateInvite(ctx, invite); err != nil {
+				t.Fatalf("CreateInvite() error = %v", err)
+			}
+
+			if tt.revoke {
+				if err := repo.RevokeInvite(ctx, communityID, invite.Code, time.Now()); err != nil {
+					t.Fatalf("RevokeInvite() error = %v", err)
+				}
+			}
+
+			var useErr error
+			var card *domain.Card
+			for i := 0; i < tt.useCount; i++ {
+				if card == nil || !tt.sameCard {
+					card = createTestCard(fmt.Sprintf("invitee%d", i), fmt.Sprintf("U_invitee%d", i))
+					dbCard := database.CardFromDomain(card)
+					db.Create(dbCard)
+					card.ID = domain.CardID(dbCard.ID)
+				}
+				if err := repo.JoinByInvite(ctx, invite.Code, uuid.UUID(card.ID).String(), time.Now()); err != nil {
+					useErr = err
+				}
+			}
+			if tt.wantUseErrIs == nil && useErr != nil {
+				t.Errorf("JoinByInvite() error = %v", useErr)
+			}
+			if tt.wantUseErrIs != nil && !errors.Is(useErr, tt.wantUseErrIs) {
+				t.Errorf("JoinByInvite() error = %v, want %v", useErr, tt.wantUseErrIs)
+			}
+
+			var memberCount int64
+			db.Model(&database.CommunityCard{}).Where("community_id = ?", uuid.UUID(community.ID)).Count(&memberCount)
+			if int(memberCount) != tt.wantUsedCount {
+				t.Errorf("メンバー数 = %d, want %d", memberCount, tt.wantUsedCount)
+			}
+
+			found, err := repo.FindInviteByCode(ctx, invite.Code)
+			if err != nil {
+				t.Fatalf("FindInviteByCode() error = %v", err)
+			}
+			if found.UsedCount != tt.wantUsedCount {
+				t.Errorf("UsedCount = %d, want %d", found.UsedCount, tt.wantUsedCount)
+			}
+
+			active, err := repo.FindActiveInvites(ctx, communityID, time.Now())
+			if err != nil {
+				t.Fatalf("FindActiveInvites() error = %v", err)
+			}
+			if (len(active) == 1) != tt.wantActive {
+				t.Errorf("FindActiveInvites() returned %d invites, wantActive %v", len(active), tt.wantActive)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/database"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// contributionSnapshotBatchSize は日ごとのコントリビューション数を一度に保存する件数
+const contributionSnapshotBatchSize = 500
+
+// contributionSnapshotRepository はユーザーのコントリビューションの記録を保存する
+type contributionSnapshotRepository struct {
+	db *gorm.DB
+}
+
+func NewContributionSnapshotRepository(db *gorm.DB) *contributionSnapshotRepository {
+	return &contributionSnapshotRepository{db: db}
+}
+
+// SaveContributions は日ごとのコントリビューション数を保存する。同じ日の記録がある場合は上書きする
+func (r *contributionSnapshotRepository) SaveContributions(ctx context.Context, githubID string, contributions []domain.Contribution, fetchedAt time.Time) error {
+	if len(contributions) == 0 {
+		return nil
+	}
+
+	dbSnapshots := make([]database.ContributionSnapshot, 0, len(contributions))
+	for _, c := range contributions {
+		dbSnapshots = append(dbSnapshots, database.ContributionSnapshot{
+			GithubID:          githubID,
+			Date:              c.Date,
+			ContributionCount: c.Count,
+			FetchedAt:         fetchedAt,
+		})
+	}
+
+	return translateError(r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "github_id"}, {Name: "date"}},
+			DoUpdates: clause.AssignmentColumns([]string{"contribution_count", "fetched_at"}),
+		}).
+		CreateInBatches(dbSnapshots, contributionSnapshotBatchSize).Error)
+}
+
+// SaveContributionDetail は期間のコントリビューションの内訳を保存する。同じ期間の記録がある場合は上書きする
+func (r *contributionSnapshotRepository) SaveContributionDetail(ctx context.Context, snapshot *domain.ContributionDetailSnapshot) error {
+	return translateError(r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "github_id"}, {Name: "period_from"}, {Name: "period_to"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"total_contribution", "commit_count", "issue_count", "pull_request_count", "review_count", "fetched_at",
+			}),
+		}).
+		Create(database.ContributionDetailSnapshotFromDomain(snapshot)).Error)
+}
+
+// FindContributions は from 以降 to より前の日のコントリビューション数を日付の順に取得する
+func (r *contributionSnapshotRepository) FindContributions(ctx context.Context, githubID string, from, to time.Time) ([]domain.Contribution, error) {
+	var dbSnapshots []database.ContributionSnapshot
+	if err := r.db.WithContext(ctx).
+		Where("github_id = ? AND date >= ? AND date < ?", githubID, from.Format(time.DateOnly), to.Format(time.DateOnly)).
+		Order("date ASC").
+		Find(&dbSnapshots).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	result := make([]domain.Contribution, 0, len(dbSnapshots))
+	for _, s := range dbSnapshots {
+		result = append(result, *s.ToDomain())
+	}
+
+	return result, nil
+}
+
+// FindContributionDetail は from から to の間に収まる内訳の記録のうち、最も長い期間のものを取得する
+// 同じ長さの記録が複数ある場合は新しく取得したものを返す。記録がない場合は domain.ErrNotFound を返す
+func (r *contributionSnapshotRepository) FindContributionDetail(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionDetailSnapshot, error) {
+	var dbSnapshot database.ContributionDetailSnapshot
+	if err := r.db.WithContext(ctx).
+		Where("github_id = ? AND period_from >= ? AND period_to <= ?", githubID, from, to).
+		Order("period_to - period_from DESC, fetched_at DESC").
+		First(&dbSnapshot).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	return dbSnapshot.ToDomain(), nil
+}
+
+// FindPeerTotals はユーザーと同じコミュニティに参加している仲間の、from 以降 to より前の日のコントリビューション数の合計を取得する
+// 削除されたコミュニティと、期間の記録がない仲間は含めない
+func (r *contributionSnapshotRepository) FindPeerTotals(ctx context.Context, githubID string, from, to time.Time) ([]int, error) {
+	var totals []int
+	if err := r.db.WithContext(ctx).Raw(`
+		SELECT SUM(cs.contribution_count)
+		FROM contribution_snapshots cs
+		WHERE cs.github_id <> ?
+		  AND cs.date >= ? AND cs.date < ?
+		  AND cs.github_id IN (
+			SELECT peer.github_id
+			FROM cards me
+			JOIN community_cards mine ON mine.card_id = me.id
+			JOIN communities c ON c.id = mine.community_id AND c.deleted_at IS NULL
+			JOIN community_cards pc ON pc.community_id = mine.community_id
+			JOIN cards peer ON peer.id = pc.card_id
+			WHERE me.github_id = ?
+		  )
+		GROUP BY cs.github_id`,
+		githubID, from.Format(time.DateOnly), to.Format(time.DateOnly), githubID).
+		Scan(&totals).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	return totals, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// 日ごとのコントリビューション数は上書きして保存され、期間で取得できることをテスト
+func TestContributionSnapshotRepository_Contributions(t *testing.T) {
+	db := SetupTestDB(t)
+	CleanupTestData(t, db)
+	ctx := context.Background()
+	repo := NewContributionSnapshotRepository(db)
+	fetchedAt := time.Now()
+
+	if err := repo.SaveContributions(ctx, "12345", []domain.Contribution{
+		{Date: date(2023, 12, 31), Count: 1},
+		{Date: date(2024, 1, 1), Count: 2},
+		{Date: date(2024, 1, 2), Count: 3},
+	}, fetchedAt); err != nil {
+		t.Fatalf("SaveContributions() error = %v", err)
+	}
+	// 同じ日の記録は新しい値で上書きする
+	if err := repo.SaveContributions(ctx, "12345", []domain.Contribution{
+		{Date: date(2024, 1, 2), Count: 5},
+	}, fetchedAt.Add(time.Hour)); err != nil {
+		t.Fatalf("SaveContributions() error = %v", err)
+	}
+
+	got, err := repo.FindContributions(ctx, "12345", date(2024, 1, 1), date(2024, 1, 3))
+	if err != nil {
+		t.Fatalf("FindContributions() error = %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("len(FindContributions()) = %d, want 2", len(got))
+	}
+	if !got[0].Date.Equal(date(2024, 1, 1)) || got[0].Count != 2 || got[1].Count != 5 {
+		t.Errorf("FindContributions() = %+v", got)
+	}
+
+	other, err := repo.FindContributions(ctx, "67890", date(2024, 1, 1), date(2024, 1, 3))
+	if err != nil || len(other) != 0 {
+		t.Errorf("FindContributions(other) = %v, %v, want empty", other, err)
+	}
+}
+
+// 内訳の記録は期間に収まるもののうち最も長い期間のものを取得することをテスト
+func TestContributionSnapshotRepository_ContributionDetail(t *testing.T) {
+	db := SetupTestDB(t)
+	CleanupTestData(t, db)
+	ctx := context.Background()
+	repo := NewContributionSnapshotRepository(db)
+	fetchedAt := time.Now()
+
+	snapshots := []domain.ContributionDetailSnapshot{
+		{GithubID: "12345", From: date(2024, 1, 1), To: date(2024, 1, 3), TotalContribution: 3, FetchedAt: fetchedAt},
+		{GithubID: "12345", From: date(2024, 1, 1), To: date(2024, 2, 1), TotalContribution: 30, FetchedAt: fetchedAt},
+		{GithubID: "12345", From: date(2023, 1, 1), To: date(2024, 1, 1), TotalContribution: 300, FetchedAt: fetchedAt},
+	}
+	for i := range snapshots {
+		if err := repo.SaveContributionDetail(ctx, &snapshots[i]); err != nil {
+			t.Fatalf("SaveContributionDetail() error = %v", err)
+		}
+	}
+	// 同じ期間の記録は上書きする
+	snapshots[1].TotalContribution = 31
+	snapshots[1].ContributionDetail.CommitCount = 20
+	if err := repo.SaveContributionDetail(ctx, &snapshots[1]); err != nil {
+		t.Fatalf("SaveContributionDetail() error = %v", err)
+	}
+
+	got, err := repo.FindContributionDetail(ctx, "12345", date(2024, 1, 1), date(2024, 3, 1))
+	if err != nil {
+		t.Fatalf("FindContributionDetail() error = %v", err)
+	}
+	if got.TotalContribution != 31 || got.ContributionDetail.CommitCount != 20 {
+		t.Errorf("FindContributionDetail() = %+v, want total 31", got)
+	}
+
+	if _, err := repo.FindContributionDetail(ctx, "12345", date(2024, 1, 2), date(2024, 3, 1)); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("FindContributionDetail() error = %v, want %v", err, domain.ErrNotFound)
+	}
+}
+
+// 同じコミュニティの仲間の期間の合計を取得できることをテスト
+func TestContributionSnapshotRepository_FindPeerTotals(t *testing.T) {
+	db := SetupTestDB(t)
+	CleanupTestData(t, db)
+	ctx := context.Background()
+	repo := NewContributionSnapshotRepository(db)
+	cardRepo := NewCardRepository(db)
+	communityRepo := NewCommunityRepository(db)
+
+	me := createTestCard("me", "U_me")
+	peer := createTestCard("peer", "U_peer")
+	stranger := createTestCard("stranger", "U_stranger")
+	for _, card := range []*domain.Card{me, peer, stranger} {
+		if err := cardRepo.Create(ctx, card); err != nil {
+			t.Fatalf("Create(card) error = %v", err)
+		}
+	}
+	community := createTestCommunity("Hackathon")
+	if err := communityRepo.Create(ctx, community, me.ID.String()); err != nil {
+		t.Fatalf("Create(community) error = %v", err)
+	}
+	if err := communityRepo.AddCard(ctx, community.ID.String(), peer.ID.String()); err != nil {
+		t.Fatalf("AddCard() error = %v", err)
+	}
+
+	fetchedAt := time.Now()
+	for githubID, counts := range map[string][]int{"me": {1, 1}, "peer": {2, 3}, "stranger": {10, 10}} {
+		if err := repo.SaveContributions(ctx, githubID, []domain.Contribution{
+			{Date: date(2024, 1, 1), Count: counts[0]},
+			{Date: date(2024, 1, 2), Count: counts[1]},
+		}, fetchedAt); err != nil {
+			t.Fatalf("SaveContributions() error = %v", err)
+		}
+	}
+
+	totals, err := repo.FindPeerTotals(ctx, "me", date(2024, 1, 1), date(2024, 1, 3))
+	if err != nil {
+		t.Fatalf("FindPeerTotals() error = %v", err)
+	}
+	// 自分と、同じコミュニティにいない人は含めない
+	if len(totals) != 1 || totals[0] != 5 {
+		t.Errorf("FindPeerTotals() = %v, want [5]", totals)
+	}
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+// PostgreSQLのエラーコード
+// https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	pgUniqueViolation     = "23505"
+	pgForeignKeyViolation = "23503"
+)
+
+// translateError はGORM / PostgreSQLのエラーをドメインエラーに変換する
+// Service層がgormに依存せずにエラーの種類を判定できるようにする
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: %w", domain.ErrNotFound, err)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case pgUniqueViolation:
+			return fmt.Errorf("%w: %w", domain.ErrAlreadyExists, err)
+		case pgForeignKeyViolation:
+			return fmt.Errorf("%w: %w", domain.ErrNotFound, err)
+		}
+	}
+
+	return err
+}
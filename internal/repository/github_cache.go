@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/database"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// githubCacheRepository はGitHub APIの応答のキャッシュをPostgreSQLに保存する
+// Cloud Runの複数のインスタンスで同じキャッシュを使うために使う
+type githubCacheRepository struct {
+	db *gorm.DB
+}
+
+func NewGitHubCacheRepository(db *gorm.DB) *githubCacheRepository {
+	return &githubCacheRepository{db: db}
+}
+
+// Get はキーに対応するキャッシュを取得する
+// 見つからない場合と期限が切れている場合は domain.ErrNotFound を返す
+func (r *githubCacheRepository) Get(ctx context.Context, key string) (*domain.CacheEntry, error) {
+	var entry database.GitHubCacheEntry
+	if err := r.db.WithContext(ctx).
+		Where("key = ? AND expires_at > ?", key, time.Now()).
+		First(&entry).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	return entry.ToDomain(), nil
+}
+
+// Set はキャッシュを保存する。同じキーのキャッシュがある場合は上書きする
+func (r *githubCacheRepository) Set(ctx context.Context, entry *domain.CacheEntry) error {
+	dbEntry := database.GitHubCacheEntryFromDomain(entry)
+	return translateError(r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "key"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "fetched_at", "expires_at"}),
+		}).
+		Create(dbEntry).Error)
+}
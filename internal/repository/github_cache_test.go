@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// GitHubCacheRepositoryの保存・上書き・期限切れをテスト
+func TestGitHubCacheRepository(t *testing.T) {
+	db := SetupTestDB(t)
+	CleanupTestData(t, db)
+	ctx := context.Background()
+	repo := NewGitHubCacheRepository(db)
+
+	now := time.Now().Truncate(time.Microsecond)
+	entry := &domain.CacheEntry{
+		Key:       "user:12345",
+		Value:     []byte(`{"Login":"octocat"}`),
+		FetchedAt: now,
+		ExpiresAt: now.Add(time.Hour),
+	}
+	if err := repo.Set(ctx, entry); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	got, err := repo.Get(ctx, "user:12345")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	// jsonbに保存するので空白は変わることがある
+	if !strings.Contains(string(got.Value), "octocat") {
+		t.Errorf("Value = %s, want %s", got.Value, entry.Value)
+	}
+	if !got.FetchedAt.Equal(now) {
+		t.Errorf("FetchedAt = %v, want %v", got.FetchedAt, now)
+	}
+
+	// 同じキーで保存すると上書きする
+	entry.Value = []byte(`{"Login":"hubot"}`)
+	if err := repo.Set(ctx, entry); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	got, err = repo.Get(ctx, "user:12345")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if !strings.Contains(string(got.Value), "hubot") {
+		t.Errorf("Value = %s, want overwritten value", got.Value)
+	}
+
+	// 期限が切れたエントリは見つからない
+	expired := &domain.CacheEntry{
+		Key:       "user:67890",
+		Value:     []byte(`{}`),
+		FetchedAt: now.Add(-2 * time.Hour),
+		ExpiresAt: now.Add(-time.Hour),
+	}
+	if err := repo.Set(ctx, expired); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if _, err := repo.Get(ctx, "user:67890"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Get() expired error = %v, want %v", err, domain.ErrNotFound)
+	}
+	if _, err := repo.Get(ctx, "user:missing"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Get() missing error = %v, want %v", err, domain.ErrNotFound)
+	}
+}
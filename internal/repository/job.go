@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/database"
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"gorm.io/gorm"
+)
+
+// jobRepository はバックグラウンドのジョブのロックと実行履歴を保存する
+type jobRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRepository(db *gorm.DB) *jobRepository {
+	return &jobRepository{db: db}
+}
+
+// TryLock はジョブのロックを取得する。他のインスタンスがロックしている場合はfalseを返す
+// ロックは ttl が過ぎると他のインスタンスが取り直せる。時刻はインスタンス間でずれないようにDBの時計を使う
+func (r *jobRepository) TryLock(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error) {
+	result := r.db.WithContext(ctx).Exec(`
+		INSERT INTO job_locks (name, locked_by, locked_until)
+		VALUES (?, ?, now() + make_interval(secs => ?))
+		ON CONFLICT (name) DO UPDATE
+		SET locked_by = EXCLUDED.locked_by, locked_until = EXCLUDED.locked_until
+		WHERE job_locks.locked_until < now()`,
+		name, owner, ttl.Seconds())
+	if result.Error != nil {
+		return false, translateError(result.Error)
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// Unlock はジョブのロックを解放する。他のインスタンスが取り直したロックは解放しない
+func (r *jobRepository) Unlock(ctx context.Context, name string, owner string) error {
+	return translateError(r.db.WithContext(ctx).
+		Where("name = ? AND locked_by = ?", name, owner).
+		Delete(&database.JobLock{}).Error)
+}
+
+// CreateRun はジョブの実行の記録を作成する
+func (r *jobRepository) CreateRun(ctx context.Context, run *domain.JobRun) error {
+	return translateError(r.db.WithContext(ctx).Create(database.JobRunFromDomain(run)).Error)
+}
+
+// UpdateRun はジョブの実行の結果を保存する
+func (r *jobRepository) UpdateRun(ctx context.Context, run *domain.JobRun) error {
+	dbRun := database.JobRunFromDomain(run)
+	return translateError(r.db.WithContext(ctx).
+		Model(&database.JobRun{}).
+		Where("id = ?", dbRun.ID).
+		Select("status", "finished_at", "processed_count", "error").
+		Updates(dbRun).Error)
+}
+
+// FindLastSucceededRun はジョブが最後に成功した実行の記録を取得する
+// 一度も成功していない場合は domain.ErrNotFound を返す
+func (r *jobRepository) FindLastSucceededRun(ctx context.Context, jobName string) (*domain.JobRun, error) {
+	var dbRun database.JobRun
+	if err := r.db.WithContext(ctx).
+		Where("job_name = ? AND status = ?", jobName, domain.JobStatusSucceeded).
+		Order("started_at DESC").
+		First(&dbRun).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	return dbRun.ToDomain(), nil
+}
+
+// FindRuns はジョブの実行の記録を新しい順に limit 件取得する。jobName が空の場合はすべてのジョブの記録を取得する
+func (r *jobRepository) FindRuns(ctx context.Context, jobName string, limit int) ([]domain.JobRun, error) {
+	query := r.db.WithContext(ctx).Order("started_at DESC").Limit(limit)
+	if jobName != "" {
+		query = query.Where("job_name = ?", jobName)
+	}
+
+	var dbRuns []database.JobRun
+	if err := query.Find(&dbRuns).Error; err != nil {
+		return nil, translateError(err)
+	}
+
+	result := make([]domain.JobRun, 0, len(dbRuns))
+	for _, dbRun := range dbRuns {
+		result = append(result, *dbRun.ToDomain())
+	}
+
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// ジョブのロックは他のインスタンスが持っている間は取得できず、期限が過ぎたら取り直せることをテスト
+func TestJobRepository_Lock(t *testing.T) {
+	db := SetupTestDB(t)
+	CleanupTestData(t, db)
+	ctx := context.Background()
+	repo := NewJobRepository(db)
+
+	locked, err := repo.TryLock(ctx, "refresh-cards", "instance-a", time.Minute)
+	if err != nil || !locked {
+		t.Fatalf("TryLock(a) = %v, %v, want true", locked, err)
+	}
+	locked, err = repo.TryLock(ctx, "refresh-cards", "instance-b", time.Minute)
+	if err != nil || locked {
+		t.Fatalf("TryLock(b) = %v, %v, want false", locked, err)
+	}
+
+	// 他のインスタンスのロックは解放しない
+	if err := repo.Unlock(ctx, "refresh-cards", "instance-b"); err != nil {
+		t.Fatalf("Unlock(b) error = %v", err)
+	}
+	if locked, _ := repo.TryLock(ctx, "refresh-cards", "instance-b", time.Minute); locked {
+		t.Errorf("TryLock(b) after Unlock(b) = true, want false")
+	}
+
+	if err := repo.Unlock(ctx, "refresh-cards", "instance-a"); err != nil {
+		t.Fatalf("Unlock(a) error = %v", err)
+	}
+	if locked, err := repo.TryLock(ctx, "refresh-cards", "instance-b", 0); err != nil || !locked {
+		t.Errorf("TryLock(b) after Unlock(a) = %v, %v, want true", locked, err)
+	}
+
+	// 期限が過ぎたロックは取り直せる
+	time.Sleep(10 * time.Millisecond)
+	if locked, err := repo.TryLock(ctx, "refresh-cards", "instance-a", time.Minute); err != nil || !locked {
+		t.Errorf("TryLock(a) after expiry = %v, %v, want true", locked, err)
+	}
+}
+
+// ジョブの実行履歴の保存と取得をテスト
+func TestJobRepository_Runs(t *testing.T) {
+	db := SetupTestDB(t)
+	CleanupTestData(t, db)
+	ctx := context.Background()
+	repo := NewJobRepository(db)
+
+	if _, err := repo.FindLastSucceededRun(ctx, "refresh-cards"); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("FindLastSucceededRun() error = %v, want %v", err, domain.ErrNotFound)
+	}
+
+	startedAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	succeeded := domain.NewJobRun("refresh-cards", "instance-a", startedAt)
+	failed := domain.NewJobRun("refresh-cards", "instance-a", startedAt.Add(30*time.Minute))
+	other := domain.NewJobRun("refresh-communities", "instance-a", startedAt.Add(40*time.Minute))
+	for _, run := range []*domain.JobRun{succeeded, failed, other} {
+		if err := repo.CreateRun(ctx, run); err != nil {
+			t.Fatalf("CreateRun() error = %v", err)
+		}
+	}
+	succeeded.Finish(startedAt.Add(time.Minute), 10, nil)
+	failed.Finish(startedAt.Add(31*time.Minute), 2, errors.New("github api error"))
+	for _, run := range []*domain.JobRun{succeeded, failed} {
+		if err := repo.UpdateRun(ctx, run); err != nil {
+			t.Fatalf("UpdateRun() error = %v", err)
+		}
+	}
+
+	last, err := repo.FindLastSucceededRun(ctx, "refresh-cards")
+	if err != nil {
+		t.Fatalf("FindLastSucceededRun() error = %v", err)
+	}
+	if last.ID != succeeded.ID || last.ProcessedCount != 10 || last.FinishedAt == nil {
+		t.Errorf("last = %+v, want %+v", last, succeeded)
+	}
+
+	runs, err := repo.FindRuns(ctx, "refresh-cards", 10)
+	if err != nil {
+		t.Fatalf("FindRuns() error = %v", err)
+	}
+	if len(runs) != 2 || runs[0].ID != failed.ID || runs[0].Error != "github api error" {
+		t.Errorf("runs = %+v", runs)
+	}
+
+	all, err := repo.FindRuns(ctx, "", 10)
+	if err != nil {
+		t.Fatalf("FindRuns() error = %v", err)
+	}
+	if len(all) != 3 || all[0].ID != other.ID || all[0].Status != domain.JobStatusRunning {
+		t.Errorf("all runs = %+v", all)
+	}
+}
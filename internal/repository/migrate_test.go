@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/furarico/octo-deck-api/internal/database"
+)
+
+// マイグレーションを取り消して再適用できることをテスト
+func TestMigrations_DownAndUp(t *testing.T) {
+	db := SetupTestDB(t)
+
+	if err := database.CheckSchema(db); err != nil {
+		t.Fatalf("CheckSchema() error = %v", err)
+	}
+
+	latest, err := database.LatestVersion()
+	if err != nil {
+		t.Fatalf("LatestVersion() error = %v", err)
+	}
+
+	reverted, err := database.MigrateDown(db, latest)
+	if err != nil {
+		t.Fatalf("MigrateDown() error = %v", err)
+	}
+	if len(reverted) != latest {
+		t.Errorf("MigrateDown() reverted %d migrations, want %d", len(reverted), latest)
+	}
+	if err := database.CheckSchema(db); !errors.Is(err, database.ErrSchemaBehind) {
+		t.Errorf("CheckSchema() error = %v, want %v", err, database.ErrSchemaBehind)
+	}
+
+	applied, err := database.MigrateUp(db)
+	if err != nil {
+		t.Fatalf("MigrateUp() error = %v", err)
+	}
+	if len(applied) != latest {
+		t.Errorf("MigrateUp() applied %d migrations, want %d", len(applied), latest)
+	}
+
+	// 適用済みの場合は何もしない
+	applied, err = database.MigrateUp(db)
+	if err != nil {
+		t.Fatalf("MigrateUp() error = %v", err)
+	}
+	if len(applied) != 0 {
+		t.Errorf("MigrateUp() applied %d migrations, want 0", len(applied))
+	}
+}
@@ -2,32 +2,39 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 type MockCardRepository struct {
-	FindAllFunc                  func(ctx context.Context, githubID string) ([]domain.Card, error)
+	SearchFunc                   func(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error)
 	FindByGitHubIDFunc           func(ctx context.Context, githubID string) (*domain.Card, error)
 	FindMyCardFunc               func(ctx context.Context, githubID string) (*domain.Card, error)
 	FindAllCardsInDBFunc         func(ctx context.Context) ([]domain.Card, error)
+	FindStaleCardsFunc           func(ctx context.Context, refreshedBefore time.Time, limit int) ([]domain.Card, error)
 	CreateFunc                   func(ctx context.Context, card *domain.Card) error
 	UpdateFunc                   func(ctx context.Context, card *domain.Card) error
-	AddToCollectedCardsFunc      func(ctx context.Context, collectorGithubID string, cardID domain.CardID) error
+	UpdateSettingsFunc           func(ctx context.Context, cardID domain.CardID, settings domain.CardSettings) error
+	AddToCollectedCardsFunc      func(ctx context.Context, collectedCard *domain.CollectedCard) error
 	RemoveFromCollectedCardsFunc func(ctx context.Context, collectorGithubID string, cardID domain.CardID) error
+	CreateExchangeFunc           func(ctx context.Context, exchange *domain.CardExchange) error
+	FindExchangeByTokenFunc      func(ctx context.Context, token string) (*domain.CardExchange, error)
+	FindExchangesFunc            func(ctx context.Context, githubID string) ([]domain.CardExchange, error)
+	AcceptExchangeFunc           func(ctx context.Context, token string, acceptedByGithubID string, acceptedCardID domain.CardID, detail domain.CollectDetail, now time.Time) error
 }
 
 func NewMockCardRepository() *MockCardRepository {
 	return &MockCardRepository{}
 }
 
-// FindAll は自分が集めたカードを全て取得する
-func (r *MockCardRepository) FindAll(ctx context.Context, githubID string) ([]domain.Card, error) {
-	if r.FindAllFunc != nil {
-		return r.FindAllFunc(ctx, githubID)
+// Search は自分が集めたカードを条件で絞り込み、ページ単位で取得する
+func (r *MockCardRepository) Search(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+	if r.SearchFunc != nil {
+		return r.SearchFunc(ctx, githubID, filter, page)
 	}
 
-	return []domain.Card{}, nil
+	return &domain.CollectedCardPage{CollectedCards: []domain.CollectedCard{}}, nil
 }
 
 // FindByGitHubID はGitHub IDでカードを取得する
@@ -54,6 +61,14 @@ func (r *MockCardRepository) FindAllCardsInDB(ctx context.Context) ([]domain.Car
 	return []domain.Card{}, nil
 }
 
+// FindStaleCards は更新が古いカードを取得する
+func (r *MockCardRepository) FindStaleCards(ctx context.Context, refreshedBefore time.Time, limit int) ([]domain.Card, error) {
+	if r.FindStaleCardsFunc != nil {
+		return r.FindStaleCardsFunc(ctx, refreshedBefore, limit)
+	}
+	return []domain.Card{}, nil
+}
+
 // Create は新しいカードを作成する
 func (r *MockCardRepository) Create(ctx context.Context, card *domain.Card) error {
 	if r.CreateFunc != nil {
@@ -63,9 +78,9 @@ func (r *MockCardRepository) Create(ctx context.Context, card *domain.Card) erro
 }
 
 // AddToCollectedCards はカードをデッキに追加する
-func (r *MockCardRepository) AddToCollectedCards(ctx context.Context, collectorGithubID string, cardID domain.CardID) error {
+func (r *MockCardRepository) AddToCollectedCards(ctx context.Context, collectedCard *domain.CollectedCard) error {
 	if r.AddToCollectedCardsFunc != nil {
-		return r.AddToCollectedCardsFunc(ctx, collectorGithubID, cardID)
+		return r.AddToCollectedCardsFunc(ctx, collectedCard)
 	}
 	return nil
 }
@@ -85,3 +100,43 @@ func (r *MockCardRepository) Update(ctx context.Context, card *domain.Card) erro
 	}
 	return nil
 }
+
+// UpdateSettings はカードの設定を更新する
+func (r *MockCardRepository) UpdateSettings(ctx context.Context, cardID domain.CardID, settings domain.CardSettings) error {
+	if r.UpdateSettingsFunc != nil {
+		return r.UpdateSettingsFunc(ctx, cardID, settings)
+	}
+	return nil
+}
+
+// CreateExchange はカード交換の申し出を作成する
+func (r *MockCardRepository) CreateExchange(ctx context.Context, exchange *domain.CardExchange) error {
+	if r.CreateExchangeFunc != nil {
+		return r.CreateExchangeFunc(ctx, exchange)
+	}
+	return nil
+}
+
+// FindExchangeByToken はトークンからカード交換の申し出を取得する
+func (r *MockCardRepository) FindExchangeByToken(ctx context.Context, token string) (*domain.CardExchange, error) {
+	if r.FindExchangeByTokenFunc != nil {
+		return r.FindExchangeByTokenFunc(ctx, token)
+	}
+	return &domain.CardExchange{}, nil
+}
+
+// FindExchanges は自分が申し出た、または受け取ったカード交換を取得する
+func (r *MockCardRepository) FindExchanges(ctx context.Context, githubID string) ([]domain.CardExchange, error) {
+	if r.FindExchangesFunc != nil {
+		return r.FindExchangesFunc(ctx, githubID)
+	}
+	return []domain.CardExchange{}, nil
+}
+
+// AcceptExchange はカード交換の申し出を受け取る
+func (r *MockCardRepository) AcceptExchange(ctx context.Context, token string, acceptedByGithubID string, acceptedCardID domain.CardID, detail domain.CollectDetail, now time.Time) error {
+	if r.AcceptExchangeFunc != nil {
+		return r.AcceptExchangeFunc(ctx, token, acceptedByGithubID, acceptedCardID, detail, now)
+	}
+	return nil
+}
@@ -2,33 +2,49 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 type MockCommunityRepository struct {
-	FindAllFunc                            func(ctx context.Context, githubID string) ([]domain.Community, error)
-	FindByIDFunc                           func(ctx context.Context, id string) (*domain.Community, error)
-	FindByIDWithHighlightedCardFunc        func(ctx context.Context, id string) (*domain.Community, error)
-	FindCardsFunc                          func(ctx context.Context, id string) ([]domain.Card, error)
-	CreateFunc                             func(ctx context.Context, community *domain.Community) error
-	DeleteFunc                             func(ctx context.Context, id string) error
-	AddCardFunc                            func(ctx context.Context, communityID string, cardID string) error
-	RemoveCardFunc                         func(ctx context.Context, communityID string, cardID string) error
-	UpdateHighlightedCardFunc              func(ctx context.Context, communityID string, highlightedCard *domain.HighlightedCard) error
-	UpdateCommunityCardContributionsFunc   func(ctx context.Context, communityID string, cardContributions map[string]int) error
+	FindPageFunc                         func(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error)
+	FindByIDFunc                         func(ctx context.Context, id string) (*domain.Community, error)
+	FindByIDWithHighlightedCardFunc      func(ctx context.Context, id string) (*domain.Community, error)
+	FindActiveFunc                       func(ctx context.Context, now time.Time, refreshedBefore time.Time, limit int) ([]domain.Community, error)
+	FindCardsFunc                        func(ctx context.Context, id string) ([]domain.Card, error)
+	FindCardsPageFunc                    func(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error)
+	CreateFunc                           func(ctx context.Context, community *domain.Community, ownerCardID string) error
+	UpdateFunc                           func(ctx context.Context, community *domain.Community) error
+	DeleteFunc                           func(ctx context.Context, id string) error
+	PurgeFunc                            func(ctx context.Context, id string) error
+	PurgeDeletedBeforeFunc               func(ctx context.Context, before time.Time) (int, error)
+	FindDeletedByIDFunc                  func(ctx context.Context, id string) (*domain.Community, error)
+	RestoreFunc                          func(ctx context.Context, id string, deletedAfter time.Time) error
+	AddCardFunc                          func(ctx context.Context, communityID string, cardID string) error
+	RemoveCardFunc                       func(ctx context.Context, communityID string, cardID string) error
+	UpdateHighlightedCardFunc            func(ctx context.Context, communityID string, highlightedCard *domain.HighlightedCard) error
+	UpdateCommunityCardContributionsFunc func(ctx context.Context, communityID string, cardContributions map[string]int) error
+	FindMemberRoleFunc                   func(ctx context.Context, communityID string, cardID string) (domain.CommunityRole, error)
+	UpdateMemberRoleFunc                 func(ctx context.Context, communityID string, cardID string, role domain.CommunityRole) error
+	TransferOwnershipFunc                func(ctx context.Context, communityID string, fromCardID string, toCardID string) error
+	CreateInviteFunc                     func(ctx context.Context, invite *domain.CommunityInvite) error
+	FindActiveInvitesFunc                func(ctx context.Context, communityID string, now time.Time) ([]domain.CommunityInvite, error)
+	FindInviteByCodeFunc                 func(ctx context.Context, code string) (*domain.CommunityInvite, error)
+	JoinByInviteFunc                     func(ctx context.Context, code string, cardID string, now time.Time) error
+	RevokeInviteFunc                     func(ctx context.Context, communityID string, code string, now time.Time) error
 }
 
 func NewMockCommunityRepository() *MockCommunityRepository {
 	return &MockCommunityRepository{}
 }
 
-// FindAll はすべてのコミュニティを取得する
-func (r *MockCommunityRepository) FindAll(ctx context.Context, githubID string) ([]domain.Community, error) {
-	if r.FindAllFunc != nil {
-		return r.FindAllFunc(ctx, githubID)
+// FindPage は参加しているコミュニティをページ単位で取得する
+func (r *MockCommunityRepository) FindPage(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error) {
+	if r.FindPageFunc != nil {
+		return r.FindPageFunc(ctx, githubID, page)
 	}
-	return []domain.Community{}, nil
+	return &domain.CommunityPage{Communities: []domain.Community{}}, nil
 }
 
 // FindByID は指定されたIDのコミュニティを取得する
@@ -39,6 +55,14 @@ func (r *MockCommunityRepository) FindByID(ctx context.Context, id string) (*dom
 	return nil, nil
 }
 
+// FindActive は期間中でHighlightedCardの計算が古いコミュニティを取得する
+func (r *MockCommunityRepository) FindActive(ctx context.Context, now time.Time, refreshedBefore time.Time, limit int) ([]domain.Community, error) {
+	if r.FindActiveFunc != nil {
+		return r.FindActiveFunc(ctx, now, refreshedBefore, limit)
+	}
+	return []domain.Community{}, nil
+}
+
 // FindCards は指定したコミュニティIDのカード一覧を取得する
 func (r *MockCommunityRepository) FindCards(ctx context.Context, id string) ([]domain.Card, error) {
 	if r.FindCardsFunc != nil {
@@ -47,10 +71,26 @@ func (r *MockCommunityRepository) FindCards(ctx context.Context, id string) ([]d
 	return []domain.Card{}, nil
 }
 
+// FindCardsPage は指定したコミュニティIDのカード一覧をページ単位で取得する
+func (r *MockCommunityRepository) FindCardsPage(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error) {
+	if r.FindCardsPageFunc != nil {
+		return r.FindCardsPageFunc(ctx, id, page)
+	}
+	return &domain.CardPage{Cards: []domain.Card{}}, nil
+}
+
 // Create はコミュニティを作成する
-func (r *MockCommunityRepository) Create(ctx context.Context, community *domain.Community) error {
+func (r *MockCommunityRepository) Create(ctx context.Context, community *domain.Community, ownerCardID string) error {
 	if r.CreateFunc != nil {
-		return r.CreateFunc(ctx, community)
+		return r.CreateFunc(ctx, community, ownerCardID)
+	}
+	return nil
+}
+
+// Update はコミュニティを更新する
+func (r *MockCommunityRepository) Update(ctx context.Context, community *domain.Community) error {
+	if r.UpdateFunc != nil {
+		return r.UpdateFunc(ctx, community)
 	}
 	return nil
 }
@@ -102,3 +142,99 @@ func (r *MockCommunityRepository) UpdateCommunityCardContributions(ctx context.C
 	}
 	return nil
 }
+
+// FindMemberRole はカードのコミュニティ内での役割を取得する
+func (r *MockCommunityRepository) FindMemberRole(ctx context.Context, communityID string, cardID string) (domain.CommunityRole, error) {
+	if r.FindMemberRoleFunc != nil {
+		return r.FindMemberRoleFunc(ctx, communityID, cardID)
+	}
+	return domain.CommunityRoleMember, nil
+}
+
+// UpdateMemberRole はカードのコミュニティ内での役割を更新する
+func (r *MockCommunityRepository) UpdateMemberRole(ctx context.Context, communityID string, cardID string, role domain.CommunityRole) error {
+	if r.UpdateMemberRoleFunc != nil {
+		return r.UpdateMemberRoleFunc(ctx, communityID, cardID, role)
+	}
+	return nil
+}
+
+// TransferOwnership はコミュニティのオーナーを移譲する
+func (r *MockCommunityRepository) TransferOwnership(ctx context.Context, communityID string, fromCardID string, toCardID string) error {
+	if r.TransferOwnershipFunc != nil {
+		return r.TransferOwnershipFunc(ctx, communityID, fromCardID, toCardID)
+	}
+	return nil
+}
+
+// CreateInvite はコミュニティの招待コードを作成する
+func (r *MockCommunityRepository) CreateInvite(ctx context.Context, invite *domain.CommunityInvite) error {
+	if r.CreateInviteFunc != nil {
+		return r.CreateInviteFunc(ctx, invite)
+	}
+	return nil
+}
+
+// FindActiveInvites は指定したコミュニティの使用可能な招待コードを取得する
+func (r *MockCommunityRepository) FindActiveInvites(ctx context.Context, communityID string, now time.Time) ([]domain.CommunityInvite, error) {
+	if r.FindActiveInvitesFunc != nil {
+		return r.FindActiveInvitesFunc(ctx, communityID, now)
+	}
+	return []domain.CommunityInvite{}, nil
+}
+
+// FindInviteByCode は招待コードから招待を取得する
+func (r *MockCommunityRepository) FindInviteByCode(ctx context.Context, code string) (*domain.CommunityInvite, error) {
+	if r.FindInviteByCodeFunc != nil {
+		return r.FindInviteByCodeFunc(ctx, code)
+	}
+	return nil, nil
+}
+
+// JoinByInvite は招待コードの使用回数を1増やし、招待先のコミュニティにカードを追加する
+func (r *MockCommunityRepository) JoinByInvite(ctx context.Context, code string, cardID string, now time.Time) error {
+	if r.JoinByInviteFunc != nil {
+		return r.JoinByInviteFunc(ctx, code, cardID, now)
+	}
+	return nil
+}
+
+// RevokeInvite は指定したコミュニティの招待コードを取り消す
+func (r *MockCommunityRepository) RevokeInvite(ctx context.Context, communityID string, code string, now time.Time) error {
+	if r.RevokeInviteFunc != nil {
+		return r.RevokeInviteFunc(ctx, communityID, code, now)
+	}
+	return nil
+}
+
+// Purge はコミュニティとメンバー、招待コードを物理削除する
+func (r *MockCommunityRepository) Purge(ctx context.Context, id string) error {
+	if r.PurgeFunc != nil {
+		return r.PurgeFunc(ctx, id)
+	}
+	return nil
+}
+
+// PurgeDeletedBefore は指定した日時より前に論理削除されたコミュニティを物理削除する
+func (r *MockCommunityRepository) PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error) {
+	if r.PurgeDeletedBeforeFunc != nil {
+		return r.PurgeDeletedBeforeFunc(ctx, before)
+	}
+	return 0, nil
+}
+
+// FindDeletedByID は論理削除されたコミュニティを取得する
+func (r *MockCommunityRepository) FindDeletedByID(ctx context.Context, id string) (*domain.Community, error) {
+	if r.FindDeletedByIDFunc != nil {
+		return r.FindDeletedByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+// Restore は論理削除されたコミュニティを復元する
+func (r *MockCommunityRepository) Restore(ctx context.Context, id string, deletedAfter time.Time) error {
+	if r.RestoreFunc != nil {
+		return r.RestoreFunc(ctx, id, deletedAfter)
+	}
+	return nil
+}
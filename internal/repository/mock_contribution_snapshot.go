@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+type MockContributionSnapshotRepository struct {
+	SaveContributionsFunc      func(ctx context.Context, githubID string, contributions []domain.Contribution, fetchedAt time.Time) error
+	SaveContributionDetailFunc func(ctx context.Context, snapshot *domain.ContributionDetailSnapshot) error
+	FindContributionsFunc      func(ctx context.Context, githubID string, from, to time.Time) ([]domain.Contribution, error)
+	FindContributionDetailFunc func(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionDetailSnapshot, error)
+	FindPeerTotalsFunc         func(ctx context.Context, githubID string, from, to time.Time) ([]int, error)
+}
+
+func NewMockContributionSnapshotRepository() *MockContributionSnapshotRepository {
+	return &MockContributionSnapshotRepository{}
+}
+
+// SaveContributions は日ごとのコントリビューション数を保存する
+func (r *MockContributionSnapshotRepository) SaveContributions(ctx context.Context, githubID string, contributions []domain.Contribution, fetchedAt time.Time) error {
+	if r.SaveContributionsFunc != nil {
+		return r.SaveContributionsFunc(ctx, githubID, contributions, fetchedAt)
+	}
+
+	return nil
+}
+
+// SaveContributionDetail は期間のコントリビューションの内訳を保存する
+func (r *MockContributionSnapshotRepository) SaveContributionDetail(ctx context.Context, snapshot *domain.ContributionDetailSnapshot) error {
+	if r.SaveContributionDetailFunc != nil {
+		return r.SaveContributionDetailFunc(ctx, snapshot)
+	}
+
+	return nil
+}
+
+// FindContributions は期間の日ごとのコントリビューション数を取得する
+func (r *MockContributionSnapshotRepository) FindContributions(ctx context.Context, githubID string, from, to time.Time) ([]domain.Contribution, error) {
+	if r.FindContributionsFunc != nil {
+		return r.FindContributionsFunc(ctx, githubID, from, to)
+	}
+
+	return []domain.Contribution{}, nil
+}
+
+// FindContributionDetail は期間に収まる内訳の記録を取得する
+func (r *MockContributionSnapshotRepository) FindContributionDetail(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionDetailSnapshot, error) {
+	if r.FindContributionDetailFunc != nil {
+		return r.FindContributionDetailFunc(ctx, githubID, from, to)
+	}
+
+	return nil, domain.ErrNotFound
+}
+
+// FindPeerTotals は同じコミュニティの仲間の期間の合計を取得する
+func (r *MockContributionSnapshotRepository) FindPeerTotals(ctx context.Context, githubID string, from, to time.Time) ([]int, error) {
+	if r.FindPeerTotalsFunc != nil {
+		return r.FindPeerTotalsFunc(ctx, githubID, from, to)
+	}
+
+	return []int{}, nil
+}
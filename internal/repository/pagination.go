@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// keysetColumn はカーソルページネーションの並び替えに使うカラム
+type keysetColumn struct {
+	// expr は並び替えに使うSQLの式
+	expr string
+	// sqlType はカーソルに保存した値をSQLで比較するときの型
+	sqlType string
+}
+
+// pageCursor はクライアントに渡すカーソルの中身
+// 最後に返した行の並び替えの値とIDを持ち、次のページはその行より後ろから取得する
+type pageCursor struct {
+	SortKey domain.SortKey   `json:"s"`
+	Order   domain.SortOrder `json:"o"`
+	Value   string           `json:"v"`
+	ID      uuid.UUID        `json:"id"`
+}
+
+// pageRow はページ単位で取得した行に付ける並び替えの値とID
+type pageRow struct {
+	SortValue string
+	CursorID  uuid.UUID
+}
+
+// encodeCursor はカーソルをクライアントに渡す不透明な文字列にする
+func encodeCursor(c pageCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor はクライアントから受け取ったカーソルを読み込む
+// 並び替えの条件がカーソルを発行したときと異なる場合は domain.ErrInvalidArgument を返す
+func decodeCursor(page domain.PageRequest) (*pageCursor, error) {
+	if page.Cursor == "" {
+		return nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(page.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid cursor", domain.ErrInvalidArgument)
+	}
+
+	var c pageCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("%w: invalid cursor", domain.ErrInvalidArgument)
+	}
+	if c.SortKey != page.SortKey || c.Order != page.Order {
+		return nil, fmt.Errorf("%w: cursor was issued for a different sort order", domain.ErrInvalidArgument)
+	}
+
+	return &c, nil
+}
+
+// paginate はクエリにカーソル以降の行を並び替えて取得する条件を追加する
+// 次のページがあるかを判定するために limit より1件多く取得する
+func paginate(query *gorm.DB, page domain.PageRequest, selectExpr string, column keysetColumn, idExpr string) (*gorm.DB, error) {
+	cursor, err := decodeCursor(page)
+	if err != nil {
+		return nil, err
+	}
+
+	direction, op := "ASC", ">"
+	if page.Order == domain.SortOrderDesc {
+		direction, op = "DESC", "<"
+	}
+
+	query = query.Select(fmt.Sprintf("%s, (%s)::text AS sort_value, %s AS cursor_id", selectExpr, column.expr, idExpr))
+	if cursor != nil {
+		query = query.Where(
+			fmt.Sprintf("(%s, %s) %s (CAST(? AS %s), CAST(? AS uuid))", column.expr, idExpr, op, column.sqlType),
+			cursor.Value, cursor.ID,
+		)
+	}
+
+	return query.
+		Order(fmt.Sprintf("%s %s, %s %s", column.expr, direction, idExpr, direction)).
+		Limit(page.Limit + 1), nil
+}
+
+// trimPage は limit より1件多く取得した行をlimit件に切り詰め、次のページのカーソルを作る
+// 行が limit 以下の場合は次のページがないのでカーソルは空文字になる
+func trimPage[T any](page domain.PageRequest, rows []T, cursorOf func(T) pageRow) ([]T, string) {
+	if len(rows) <= page.Limit {
+		return rows, ""
+	}
+
+	last := cursorOf(rows[page.Limit-1])
+	return rows[:page.Limit], encodeCursor(pageCursor{
+		SortKey: page.SortKey,
+		Order:   page.Order,
+		Value:   last.SortValue,
+		ID:      last.CursorID,
+	})
+}
@@ -56,7 +56,7 @@ func SetupTestDB(t *testing.T) *gorm.DB {
 	}
 
 	// マイグレーションを実行
-	if err := database.AutoMigrate(db); err != nil {
+	if _, err := database.MigrateUp(db); err != nil {
 		t.Fatalf("failed to run migrations: %v", err)
 	}
 
@@ -68,7 +68,7 @@ func CleanupTestData(t *testing.T, db *gorm.DB) {
 	t.Helper()
 
 	// 外部キー制約を考慮して削除順序を指定
-	tables := []string{"collected_cards", "community_cards", "communities", "cards"}
+	tables := []string{"collected_cards", "card_exchanges", "community_invites", "community_cards", "communities", "cards", "github_cache_entries", "job_locks", "job_runs", "contribution_snapshots", "contribution_detail_snapshots"}
 	for _, table := range tables {
 		if err := db.Exec("TRUNCATE TABLE " + table + " CASCADE").Error; err != nil {
 			t.Logf("failed to truncate table %s: %v", table, err)
@@ -2,48 +2,96 @@ package service
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/furarico/octo-deck-api/internal/domain"
-	"gorm.io/gorm"
+	"github.com/furarico/octo-deck-api/internal/github"
 )
 
 // CardRepository はServiceが必要とするRepositoryのインターフェース
 type CardRepository interface {
-	FindAll(ctx context.Context, githubID string) ([]domain.Card, error)
+	Search(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error)
 	FindByGitHubID(ctx context.Context, githubID string) (*domain.Card, error)
 	FindMyCard(ctx context.Context, githubID string) (*domain.Card, error)
 	FindAllCardsInDB(ctx context.Context) ([]domain.Card, error)
+	FindStaleCards(ctx context.Context, refreshedBefore time.Time, limit int) ([]domain.Card, error)
 	Create(ctx context.Context, card *domain.Card) error
 	Update(ctx context.Context, card *domain.Card) error
-	AddToCollectedCards(ctx context.Context, collectorGithubID string, cardID domain.CardID) error
+	UpdateSettings(ctx context.Context, cardID domain.CardID, settings domain.CardSettings) error
+	AddToCollectedCards(ctx context.Context, collectedCard *domain.CollectedCard) error
 	RemoveFromCollectedCards(ctx context.Context, collectorGithubID string, cardID domain.CardID) error
+	CreateExchange(ctx context.Context, exchange *domain.CardExchange) error
+	FindExchangeByToken(ctx context.Context, token string) (*domain.CardExchange, error)
+	FindExchanges(ctx context.Context, githubID string) ([]domain.CardExchange, error)
+	AcceptExchange(ctx context.Context, token string, acceptedByGithubID string, acceptedCardID domain.CardID, detail domain.CollectDetail, now time.Time) error
 }
 
 // IdenticonGenerator はServiceが必要とするIdenticon Generatorのインターフェース
+// 同じバージョンであれば何度生成しても同じIdenticonを返す
 type IdenticonGenerator interface {
-	Generate(githubID string) (domain.Color, domain.Blocks, error)
+	Generate(githubID string, version domain.IdenticonVersion) (domain.Color, domain.Blocks, error)
+	CurrentVersion() domain.IdenticonVersion
+}
+
+// IdenticonRenderer はServiceが必要とするIdenticonの画像の描画のインターフェース
+type IdenticonRenderer interface {
+	Render(color domain.Color, blocks domain.Blocks, format domain.ImageFormat, opts domain.ImageOptions) ([]byte, error)
+}
+
+// CardImageRenderer はServiceが必要とするカード全体の画像の描画のインターフェース
+type CardImageRenderer interface {
+	Render(ctx context.Context, content domain.CardImageContent, format domain.ImageFormat) ([]byte, error)
+}
+
+// ShareSigner はServiceが必要とするカードの共有ペイロードの署名のインターフェース
+type ShareSigner interface {
+	Sign(cardShare domain.CardShare) (string, error)
+	Verify(payload string, now time.Time) (*domain.CardShare, error)
 }
 
 type CardService struct {
 	cardRepo           CardRepository
 	identiconGenerator IdenticonGenerator
+	identiconRenderer  IdenticonRenderer
+	cardImageRenderer  CardImageRenderer
+	shareSigner        ShareSigner
+	// systemGitHubClient はユーザーの代わりではなく、サービスとして行う処理に使うClient（GitHub App）
+	systemGitHubClient GitHubClient
 }
 
-func NewCardService(cardRepo CardRepository, identiconGenerator IdenticonGenerator) *CardService {
+// systemGitHubClient がnilの場合、サービスとして行う処理も呼び出したユーザーのトークンで行う
+func NewCardService(cardRepo CardRepository, identiconGenerator IdenticonGenerator, identiconRenderer IdenticonRenderer, cardImageRenderer CardImageRenderer, shareSigner ShareSigner, systemGitHubClient GitHubClient) *CardService {
 	return &CardService{
 		cardRepo:           cardRepo,
 		identiconGenerator: identiconGenerator,
+		identiconRenderer:  identiconRenderer,
+		cardImageRenderer:  cardImageRenderer,
+		shareSigner:        shareSigner,
+		systemGitHubClient: systemGitHubClient,
 	}
 }
 
-// GetAllCards は自分が集めたカードを全て取得する
-func (s *CardService) GetAllCards(ctx context.Context, githubID string) ([]domain.Card, error) {
-	cards, err := s.cardRepo.FindAll(ctx, githubID)
+// ListCards は自分が集めたカードを条件で絞り込み、ページ単位で取得する
+// 並び替えはデッキに追加した日時・ユーザー名・言語から選べる（既定はデッキに追加した日時の新しい順）
+func (s *CardService) ListCards(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+	filter, err := filter.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	page, err = page.WithDefaults(domain.SortOrderDesc, domain.SortKeyCollectedAt, domain.SortKeyUserName, domain.SortKeyLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	cards, err := s.cardRepo.Search(ctx, githubID, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get all cards: %w", err)
+		return nil, fmt.Errorf("failed to list cards: %w", err)
 	}
 
 	return cards, nil
@@ -57,7 +105,7 @@ func (s *CardService) GetCardByGitHubID(ctx context.Context, githubID string, gi
 	}
 
 	if card == nil {
-		return nil, fmt.Errorf("card not found: githubID=%s", githubID)
+		return nil, fmt.Errorf("card not found: githubID=%s: %w", githubID, domain.ErrNotFound)
 	}
 
 	// GitHub APIからユーザー情報を取得して補完
@@ -68,6 +116,81 @@ func (s *CardService) GetCardByGitHubID(ctx context.Context, githubID string, gi
 	return card, nil
 }
 
+// GetIdenticonImage は指定されたGitHub IDのカードのIdenticonを画像として描画する
+// ETag はカードの色・ブロックと描画の設定から求めるので、Identiconが変わらない限り同じになる
+func (s *CardService) GetIdenticonImage(ctx context.Context, githubID string, format domain.ImageFormat, opts domain.ImageOptions) (*domain.Image, error) {
+	opts, err := opts.WithDefaults()
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := s.cardRepo.FindByGitHubID(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card by github id: %w", err)
+	}
+	if card == nil {
+		return nil, fmt.Errorf("card not found: githubID=%s: %w", githubID, domain.ErrNotFound)
+	}
+
+	data, err := s.identiconRenderer.Render(card.Color, card.Blocks, format, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render identicon: %w", err)
+	}
+
+	return &domain.Image{
+		Format: format,
+		Data:   data,
+		ETag:   identiconETag(card, format, opts),
+	}, nil
+}
+
+// identiconETag はIdenticonの画像のETagを求める
+func identiconETag(card *domain.Card, format domain.ImageFormat, opts domain.ImageOptions) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "identicon:%s:%d:%d:%s:%s:%v", format, opts.Size, *opts.Padding, opts.Background, card.Color, card.Blocks)
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// GetCardImage は指定されたGitHub IDのカード全体を画像として描画する
+// GitHub APIは呼ばずにDBに保存されたカードだけで描くので、認証なしで埋め込める
+// totalContribution は保存済みのコントリビューションの記録がある場合だけ渡す
+func (s *CardService) GetCardImage(ctx context.Context, githubID string, format domain.ImageFormat, totalContribution *int) (*domain.Image, error) {
+	card, err := s.cardRepo.FindByGitHubID(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card by github id: %w", err)
+	}
+	if card == nil {
+		return nil, fmt.Errorf("card not found: githubID=%s: %w", githubID, domain.ErrNotFound)
+	}
+
+	content := domain.CardImageContent{Card: *card, TotalContribution: totalContribution}
+	data, err := s.cardImageRenderer.Render(ctx, content, format)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render card image: %w", err)
+	}
+
+	return &domain.Image{
+		Format: format,
+		Data:   data,
+		ETag:   cardImageETag(content, format),
+	}, nil
+}
+
+// cardImageETag はカード全体の画像のETagを求める
+func cardImageETag(content domain.CardImageContent, format domain.ImageFormat) string {
+	card := content.Card
+	total := "-"
+	if content.TotalContribution != nil {
+		total = strconv.Itoa(*content.TotalContribution)
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "card:%s:%q:%q:%q:%s:%v:%q:%q:%v:%s",
+		format, card.UserName, card.FullName, card.IconUrl, card.Color, card.Blocks,
+		card.MostUsedLanguage.LanguageName, card.MostUsedLanguage.Color, card.TopLanguages, total)
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
 // GetMyCard は自分のカードを取得する
 func (s *CardService) GetMyCard(ctx context.Context, githubID string, githubClient GitHubClient) (*domain.Card, error) {
 	card, err := s.cardRepo.FindMyCard(ctx, githubID)
@@ -76,7 +199,7 @@ func (s *CardService) GetMyCard(ctx context.Context, githubID string, githubClie
 	}
 
 	if card == nil {
-		return nil, fmt.Errorf("my card not found")
+		return nil, fmt.Errorf("my card not found: %w", domain.ErrNotFound)
 	}
 
 	// GitHub APIからユーザー情報を取得して補完
@@ -90,25 +213,26 @@ func (s *CardService) GetMyCard(ctx context.Context, githubID string, githubClie
 // GetOrCreateMyCard は自分のカードを取得し、存在しない場合は新規作成する
 func (s *CardService) GetOrCreateMyCard(ctx context.Context, githubID string, nodeID string, githubClient GitHubClient) (*domain.Card, error) {
 	card, err := s.cardRepo.FindMyCard(ctx, githubID)
-	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
 		return nil, fmt.Errorf("failed to get my card: %w", err)
 	}
 
 	// カードが存在しない場合は新規作成
-	if card == nil || errors.Is(err, gorm.ErrRecordNotFound) {
+	if card == nil || errors.Is(err, domain.ErrNotFound) {
 		// GitHub APIから自分のユーザー情報を取得
 		userInfo, err := githubClient.GetAuthenticatedUser(ctx)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get authenticated user: %w", err)
 		}
 
-		color, blocks, err := s.identiconGenerator.Generate(githubID)
+		version := s.identiconGenerator.CurrentVersion()
+		color, blocks, err := s.identiconGenerator.Generate(githubID, version)
 		if err != nil {
 			return nil, fmt.Errorf("failed to generate identicon: %w", err)
 		}
 
-		// MostUsedLanguageを取得
-		langName, langColor, err := githubClient.GetMostUsedLanguage(ctx, userInfo.Login)
+		// MostUsedLanguageと言語の内訳を取得
+		langInfo, err := githubClient.GetMostUsedLanguage(ctx, userInfo.Login)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get most used language: %w", err)
 		}
@@ -118,32 +242,162 @@ func (s *CardService) GetOrCreateMyCard(ctx context.Context, githubID string, no
 			nodeID,
 			color,
 			blocks,
-			domain.Language{LanguageName: langName, Color: langColor},
+			version,
+			domain.Language{LanguageName: langInfo.Name, Color: langInfo.Color},
 			userInfo.Login,
 			userInfo.Name,
 			userInfo.AvatarURL,
 		)
+		card.TopLanguages = github.ToDomainLanguageBreakdown(langInfo.Breakdown)
 		if err := s.cardRepo.Create(ctx, card); err != nil {
 			return nil, fmt.Errorf("failed to create card: %w", err)
 		}
+		return card, nil
+	}
+
+	// プライベートリポジトリを含める設定の場合は、持ち主のトークンで取得できるこのときに言語を更新する
+	// 毎回集計するとGitHub APIを多く呼び出すので、前回の集計から privateLanguagesTTL が経った場合だけ更新する
+	if card.Settings.IncludePrivateRepositories && privateLanguagesExpired(card, time.Now()) {
+		if err := s.refreshMyLanguages(ctx, card, githubClient); err != nil {
+			return nil, err
+		}
+	}
+
+	return card, nil
+}
+
+// プライベートリポジトリを含めた言語を取り直すまでの間隔
+const privateLanguagesTTL = 24 * time.Hour
+
+// privateLanguagesExpired はプライベートリポジトリを含めた言語を取り直す必要があるかどうかを返す
+func privateLanguagesExpired(card *domain.Card, now time.Time) bool {
+	return card.PrivateLanguagesRefreshedAt == nil || now.Sub(*card.PrivateLanguagesRefreshedAt) >= privateLanguagesTTL
+}
+
+// カードの共有ペイロードの有効期限
+// その場でQRコードやNFCを読み取ってもらう前提なので短くする
+const shareExpiration = 10 * time.Minute
+
+// ShareMyCard は自分のカードを相手に渡すための署名付きペイロードを発行する
+func (s *CardService) ShareMyCard(ctx context.Context, githubID string) (*domain.CardShare, error) {
+	card, err := s.cardRepo.FindMyCard(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get my card: %w", err)
+	}
+	if card == nil {
+		return nil, fmt.Errorf("my card not found: %w", domain.ErrNotFound)
+	}
+
+	now := time.Now()
+	cardShare := &domain.CardShare{
+		GithubID:  card.GithubID,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(shareExpiration),
+	}
+	cardShare.Payload, err = s.shareSigner.Sign(*cardShare)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign share payload: %w", err)
+	}
+
+	return cardShare, nil
+}
+
+// GetMyCardSettings は自分のカードの設定を取得する
+func (s *CardService) GetMyCardSettings(ctx context.Context, githubID string) (*domain.CardSettings, error) {
+	card, err := s.cardRepo.FindMyCard(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get my card: %w", err)
+	}
+	if card == nil {
+		return nil, fmt.Errorf("my card not found: %w", domain.ErrNotFound)
+	}
+
+	return &card.Settings, nil
+}
+
+// UpdateMyCardSettings は自分のカードの設定を変更し、設定に合わせて言語の内訳を取り直したカードを返す
+func (s *CardService) UpdateMyCardSettings(ctx context.Context, githubID string, settings domain.CardSettings, githubClient GitHubClient) (*domain.Card, error) {
+	card, err := s.cardRepo.FindMyCard(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get my card: %w", err)
+	}
+	if card == nil {
+		return nil, fmt.Errorf("my card not found: %w", domain.ErrNotFound)
+	}
+
+	if err := s.cardRepo.UpdateSettings(ctx, card.ID, settings); err != nil {
+		return nil, fmt.Errorf("failed to update card settings: %w", err)
+	}
+	card.Settings = settings
+
+	if err := s.refreshMyLanguages(ctx, card, githubClient); err != nil {
+		return nil, err
 	}
 
 	return card, nil
 }
 
-// AddCardToDeck はカードをデッキに追加する
-func (s *CardService) AddCardToDeck(ctx context.Context, collectorGithubID string, targetGithubID string, githubClient GitHubClient) (*domain.Card, error) {
+// refreshMyLanguages は持ち主のトークンで自分のカードの言語を取り直して保存する
+// プライベートリポジトリを含める設定の場合だけ、プライベートリポジトリも集計する
+func (s *CardService) refreshMyLanguages(ctx context.Context, card *domain.Card, githubClient GitHubClient) error {
+	var langInfo github.LanguageInfo
+	var err error
+	if card.Settings.IncludePrivateRepositories {
+		langInfo, err = githubClient.GetMyLanguages(ctx)
+	} else {
+		langInfo, err = githubClient.GetMostUsedLanguage(ctx, card.UserName)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get most used language: %w", err)
+	}
+
+	card.MostUsedLanguage = domain.Language{
+		LanguageName: langInfo.Name,
+		Color:        langInfo.Color,
+	}
+	card.TopLanguages = github.ToDomainLanguageBreakdown(langInfo.Breakdown)
+	if card.Settings.IncludePrivateRepositories {
+		now := time.Now()
+		card.PrivateLanguagesRefreshedAt = &now
+	}
+	if err := s.cardRepo.Update(ctx, card); err != nil {
+		return fmt.Errorf("failed to update card: %w", err)
+	}
+
+	return nil
+}
+
+// AddCardToDeck は相手から受け取った署名付きペイロードのカードをデッキに追加する
+// 有効期限が切れたペイロードや改ざんされたペイロードは domain.ErrInvalidArgument を返す
+func (s *CardService) AddCardToDeck(ctx context.Context, collectorGithubID string, payload string, detail domain.CollectDetail, githubClient GitHubClient) (*domain.Card, error) {
+	cardShare, err := s.shareSigner.Verify(payload, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify share payload: %w", err)
+	}
+	targetGithubID := cardShare.GithubID
+
 	// 追加対象のカードを取得
 	card, err := s.cardRepo.FindByGitHubID(ctx, targetGithubID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("card not found: githubID=%s", targetGithubID)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("card not found: githubID=%s: %w", targetGithubID, err)
 		}
 		return nil, fmt.Errorf("failed to find card: %w", err)
 	}
 
-	// デッキに追加
-	if err := s.cardRepo.AddToCollectedCards(ctx, collectorGithubID, card.ID); err != nil {
+	collectedCard, err := domain.NewCollectedCard(collectorGithubID, card.ID, detail)
+	if err != nil {
+		return nil, err
+	}
+
+	// デッキに追加（既にデッキにある場合は domain.ErrAlreadyExists を返す）
+	if err := s.cardRepo.AddToCollectedCards(ctx, collectedCard); err != nil {
+		if errors.Is(err, domain.ErrAlreadyExists) {
+			return nil, fmt.Errorf("card already in deck: githubID=%s: %w", targetGithubID, err)
+		}
+		if detail.CommunityID != nil && errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("community not found: id=%s: %w", detail.CommunityID, err)
+		}
 		return nil, fmt.Errorf("failed to add card to deck: %w", err)
 	}
 
@@ -160,8 +414,8 @@ func (s *CardService) RemoveCardFromDeck(ctx context.Context, collectorGithubID
 	// 削除対象のカードを取得
 	card, err := s.cardRepo.FindByGitHubID(ctx, targetGithubID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("card not found: githubID=%s", targetGithubID)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("card not found: githubID=%s: %w", targetGithubID, err)
 		}
 		return nil, fmt.Errorf("failed to find card: %w", err)
 	}
@@ -179,8 +433,111 @@ func (s *CardService) RemoveCardFromDeck(ctx context.Context, collectorGithubID
 	return card, nil
 }
 
+// カード交換の申し出の有効期限の既定値と上限
+// その場でQRコードやNFCを読み取ってもらう前提なので短くする
+const (
+	defaultExchangeExpiration = 5 * time.Minute
+	maxExchangeExpiration     = time.Hour
+)
+
+// カード交換のトークンが衝突した場合に作り直す回数
+const maxExchangeTokenAttempts = 3
+
+// CreateExchange は自分のカードを相手と交換するための申し出を作成する
+// expiresIn が0の場合は既定の有効期限を使う
+func (s *CardService) CreateExchange(ctx context.Context, githubID string, expiresIn time.Duration) (*domain.CardExchange, error) {
+	if expiresIn == 0 {
+		expiresIn = defaultExchangeExpiration
+	}
+	if expiresIn < 0 || expiresIn > maxExchangeExpiration {
+		return nil, fmt.Errorf("%w: expiration must be between 0 and %s", domain.ErrInvalidArgument, maxExchangeExpiration)
+	}
+
+	card, err := s.cardRepo.FindMyCard(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get my card: %w", err)
+	}
+	if card == nil {
+		return nil, fmt.Errorf("my card not found: %w", domain.ErrNotFound)
+	}
+
+	for attempt := 0; ; attempt++ {
+		exchange, err := domain.NewCardExchange(githubID, card.ID, time.Now().Add(expiresIn))
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.cardRepo.CreateExchange(ctx, exchange)
+		if err == nil {
+			exchange.OfferedCard = card
+			return exchange, nil
+		}
+		if !errors.Is(err, domain.ErrAlreadyExists) || attempt+1 >= maxExchangeTokenAttempts {
+			return nil, fmt.Errorf("failed to create exchange: %w", err)
+		}
+	}
+}
+
+// GetExchange はトークンからカード交換の申し出を取得する
+// トークンを知っている人だけが申し出を見られる
+func (s *CardService) GetExchange(ctx context.Context, token string) (*domain.CardExchange, error) {
+	exchange, err := s.cardRepo.FindExchangeByToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exchange: %w", err)
+	}
+
+	exchange.Status = exchange.StatusAt(time.Now())
+	return exchange, nil
+}
+
+// ListExchanges は自分が申し出た、または受け取ったカード交換を取得する
+func (s *CardService) ListExchanges(ctx context.Context, githubID string) ([]domain.CardExchange, error) {
+	exchanges, err := s.cardRepo.FindExchanges(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list exchanges: %w", err)
+	}
+
+	now := time.Now()
+	for i := range exchanges {
+		exchanges[i].Status = exchanges[i].StatusAt(now)
+	}
+
+	return exchanges, nil
+}
+
+// AcceptExchange はカード交換の申し出を受け取り、お互いのデッキに相手のカードを追加する
+// 受け取るには自分のカードが必要で、自分が申し出た交換は受け取れない
+func (s *CardService) AcceptExchange(ctx context.Context, token string, githubID string, detail domain.CollectDetail) (*domain.CardExchange, error) {
+	detail, err := detail.Normalize()
+	if err != nil {
+		return nil, err
+	}
+
+	card, err := s.cardRepo.FindMyCard(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get my card: %w", err)
+	}
+	if card == nil {
+		return nil, fmt.Errorf("my card not found: %w", domain.ErrNotFound)
+	}
+
+	if err := s.cardRepo.AcceptExchange(ctx, token, githubID, card.ID, detail, time.Now()); err != nil {
+		if detail.CommunityID != nil && errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("exchange or community not found: %w", err)
+		}
+		return nil, fmt.Errorf("failed to accept exchange: %w", err)
+	}
+
+	return s.GetExchange(ctx, token)
+}
+
 // RefreshAllCards はデータベース内の全カードをGitHub APIから最新情報で更新する
+// 呼び出したユーザーのレート制限を使い切らないように、GitHub Appが設定されている場合はそちらで取得する
 func (s *CardService) RefreshAllCards(ctx context.Context, githubClient GitHubClient) ([]domain.Card, error) {
+	if s.systemGitHubClient != nil {
+		githubClient = s.systemGitHubClient
+	}
+
 	// データベースから全カードを取得
 	cards, err := s.cardRepo.FindAllCardsInDB(ctx)
 	if err != nil {
@@ -191,19 +548,85 @@ func (s *CardService) RefreshAllCards(ctx context.Context, githubClient GitHubCl
 		return []domain.Card{}, nil
 	}
 
-	// GitHub APIから最新情報を取得して各カードに設定
+	if err := s.refreshCards(ctx, cards, githubClient); err != nil {
+		return nil, err
+	}
+
+	return cards, nil
+}
+
+// RefreshStaleCards は refreshedBefore より前に更新された、または一度も更新していないカードを古い順に limit 件更新し、更新した件数を返す
+// バックグラウンドのジョブから呼び出す
+func (s *CardService) RefreshStaleCards(ctx context.Context, refreshedBefore time.Time, limit int, githubClient GitHubClient) (int, error) {
+	cards, err := s.cardRepo.FindStaleCards(ctx, refreshedBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get stale cards: %w", err)
+	}
+
+	if len(cards) == 0 {
+		return 0, nil
+	}
+
+	if err := s.refreshCards(ctx, cards, githubClient); err != nil {
+		return 0, err
+	}
+
+	return len(cards), nil
+}
+
+// refreshCards はGitHub APIから最新情報を取得して各カードに設定し、保存する
+func (s *CardService) refreshCards(ctx context.Context, cards []domain.Card, githubClient GitHubClient) error {
 	if err := EnrichCardsWithGitHubInfo(ctx, cards, githubClient); err != nil {
-		return nil, fmt.Errorf("failed to enrich cards with github info: %w", err)
+		return fmt.Errorf("failed to enrich cards with github info: %w", err)
 	}
 
-	// 各カードを更新
+	now := time.Now()
 	for i := range cards {
+		cards[i].RefreshedAt = &now
 		if err := s.cardRepo.Update(ctx, &cards[i]); err != nil {
-			return nil, fmt.Errorf("failed to update card %s: %w", cards[i].GithubID, err)
+			return fmt.Errorf("failed to update card %s: %w", cards[i].GithubID, err)
 		}
 	}
 
-	return cards, nil
+	return nil
+}
+
+// UpgradeIdenticons は version より古いバージョンのIdenticonのカードを version の生成方法で作り直す
+// Identiconが変わってしまうので、明示的に呼び出された場合だけ実行する
+// dryRun の場合は作り直す対象のカードを返すだけで保存しない
+func (s *CardService) UpgradeIdenticons(ctx context.Context, version domain.IdenticonVersion, dryRun bool) ([]domain.Card, error) {
+	if err := version.Validate(); err != nil {
+		return nil, err
+	}
+
+	cards, err := s.cardRepo.FindAllCardsInDB(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all cards from db: %w", err)
+	}
+
+	upgraded := make([]domain.Card, 0, len(cards))
+	for _, card := range cards {
+		if card.IdenticonVersion >= version {
+			continue
+		}
+
+		color, blocks, err := s.identiconGenerator.Generate(card.GithubID, version)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate identicon for card %s: %w", card.GithubID, err)
+		}
+		card.Color = color
+		card.Blocks = blocks
+		card.IdenticonVersion = version
+
+		if !dryRun {
+			if err := s.cardRepo.Update(ctx, &card); err != nil {
+				return nil, fmt.Errorf("failed to update card %s: %w", card.GithubID, err)
+			}
+		}
+		upgraded = append(upgraded, card)
+	}
+
+	return upgraded, nil
 }
 
 // EnrichCardWithGitHubInfo はGitHub APIからユーザー情報を取得してCardに設定する
@@ -222,20 +645,32 @@ func EnrichCardWithGitHubInfo(ctx context.Context, card *domain.Card, githubClie
 	card.FullName = userInfo.Name
 	card.IconUrl = userInfo.AvatarURL
 
-	// MostUsedLanguageを取得して設定
-	langName, langColor, err := githubClient.GetMostUsedLanguage(ctx, userInfo.Login)
+	// 保存した言語をそのまま使うカードは、公開リポジトリの言語を取得しない
+	if card.Settings.IncludePrivateRepositories {
+		return nil
+	}
+
+	// MostUsedLanguageと言語の内訳を取得して設定
+	langInfo, err := githubClient.GetMostUsedLanguage(ctx, userInfo.Login)
 	if err != nil {
 		return fmt.Errorf("failed to get most used language: %w", err)
 	}
 
-	card.MostUsedLanguage = domain.Language{
-		LanguageName: langName,
-		Color:        langColor,
-	}
+	applyPublicLanguages(card, domain.Language{LanguageName: langInfo.Name, Color: langInfo.Color}, github.ToDomainLanguageBreakdown(langInfo.Breakdown))
 
 	return nil
 }
 
+// applyPublicLanguages は公開リポジトリから集計した言語をカードに設定する
+// プライベートリポジトリを含める設定の場合は、持ち主のトークンで保存した言語をそのまま使う
+func applyPublicLanguages(card *domain.Card, mostUsedLanguage domain.Language, topLanguages []domain.LanguageBreakdown) {
+	if card.Settings.IncludePrivateRepositories {
+		return
+	}
+	card.MostUsedLanguage = mostUsedLanguage
+	card.TopLanguages = topLanguages
+}
+
 // EnrichCardsWithGitHubInfo は複数のカードにGitHub情報を一括で設定する（バッチ処理版）
 // N+1問題を解消し、並列処理でパフォーマンスを向上させる
 func EnrichCardsWithGitHubInfo(ctx context.Context, cards []domain.Card, githubClient GitHubClient) error {
@@ -284,15 +719,14 @@ func EnrichCardsWithGitHubInfo(ctx context.Context, cards []domain.Card, githubC
 		}
 
 		langInfo := langInfoMap[userInfo.Login]
+		mostUsedLanguage := domain.Language{LanguageName: langInfo.Name, Color: langInfo.Color}
+		topLanguages := github.ToDomainLanguageBreakdown(langInfo.Breakdown)
 
 		for _, idx := range indices {
 			cards[idx].UserName = userInfo.Login
 			cards[idx].FullName = userInfo.Name
 			cards[idx].IconUrl = userInfo.AvatarURL
-			cards[idx].MostUsedLanguage = domain.Language{
-				LanguageName: langInfo.Name,
-				Color:        langInfo.Color,
-			}
+			applyPublicLanguages(&cards[idx], mostUsedLanguage, topLanguages)
 		}
 	}
 
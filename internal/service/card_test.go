@@ -2,15 +2,18 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/furarico/octo-deck-api/internal/cardimage"
 	"github.com/furarico/octo-deck-api/internal/domain"
 	"github.com/furarico/octo-deck-api/internal/github"
 	"github.com/furarico/octo-deck-api/internal/identicon"
 	"github.com/furarico/octo-deck-api/internal/repository"
-	"gorm.io/gorm"
+	"github.com/furarico/octo-deck-api/internal/share"
 )
 
 // テスト用のヘルパー関数: 正常なGitHubClientを返す
@@ -24,8 +27,8 @@ func createMockGitHubClient() *github.MockClient {
 				AvatarURL: "https://example.com/avatar.png",
 			}, nil
 		},
-		GetMostUsedLanguageFunc: func(ctx context.Context, login string) (string, string, error) {
-			return "Go", "#00ADD8", nil
+		GetMostUsedLanguageFunc: func(ctx context.Context, login string) (github.LanguageInfo, error) {
+			return github.LanguageInfo{Name: "Go", Color: "#00ADD8"}, nil
 		},
 	}
 }
@@ -41,44 +44,113 @@ func createTestCard(githubID string) *domain.Card {
 	}
 }
 
-// GetAllCards は自分が集めたカードを全て取得する
-func TestGetAllCards(t *testing.T) {
+// ListCards は自分が集めたカードをページ単位で取得する
+func TestListCards(t *testing.T) {
 	tests := []struct {
 		name          string
 		githubID      string
+		filter        domain.CardFilter
+		page          domain.PageRequest
 		setupRepo     func() *repository.MockCardRepository
-		wantErr       bool
+		wantErr       error
 		wantErrMsg    string
 		wantCardCount int
+		wantCursor    string
 	}{
 		{
-			name:     "正常にカード一覧を取得できる",
+			name:     "未指定の条件は既定値で取得する",
 			githubID: "12345",
 			setupRepo: func() *repository.MockCardRepository {
 				return &repository.MockCardRepository{
-					FindAllFunc: func(ctx context.Context, githubID string) ([]domain.Card, error) {
-						return []domain.Card{
-							*createTestCard("12345"),
-							*createTestCard("67890"),
+					SearchFunc: func(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+						want := domain.PageRequest{Limit: domain.DefaultPageLimit, SortKey: domain.SortKeyCollectedAt, Order: domain.SortOrderDesc}
+						if page != want {
+							return nil, fmt.Errorf("unexpected page request: %+v", page)
+						}
+						return &domain.CollectedCardPage{
+							CollectedCards: []domain.CollectedCard{
+								{Card: createTestCard("12345")},
+								{Card: createTestCard("67890")},
+							},
+							NextCursor: "next",
 						}, nil
 					},
 				}
 			},
-			wantErr:       false,
 			wantCardCount: 2,
+			wantCursor:    "next",
+		},
+		{
+			name:     "ユーザー名で並び替えられる",
+			githubID: "12345",
+			page:     domain.PageRequest{Limit: 10, SortKey: domain.SortKeyUserName, Order: domain.SortOrderAsc},
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					SearchFunc: func(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+						if page.SortKey != domain.SortKeyUserName || page.Order != domain.SortOrderAsc || page.Limit != 10 {
+							return nil, fmt.Errorf("unexpected page request: %+v", page)
+						}
+						return &domain.CollectedCardPage{CollectedCards: []domain.CollectedCard{{Card: createTestCard("12345")}}}, nil
+					},
+				}
+			},
+			wantCardCount: 1,
+		},
+		{
+			name:     "絞り込み条件の前後の空白を取り除いて検索する",
+			githubID: "12345",
+			filter:   domain.CardFilter{Query: "  octo ", Language: " Go", CommunityID: "community-1 "},
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					SearchFunc: func(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+						want := domain.CardFilter{Query: "octo", Language: "Go", CommunityID: "community-1"}
+						if filter != want {
+							return nil, fmt.Errorf("unexpected filter: %+v", filter)
+						}
+						return &domain.CollectedCardPage{CollectedCards: []domain.CollectedCard{{Card: createTestCard("12345")}}}, nil
+					},
+				}
+			},
+			wantCardCount: 1,
+		},
+		{
+			name:     "検索文字列が長すぎる場合",
+			githubID: "12345",
+			filter:   domain.CardFilter{Query: strings.Repeat("a", domain.MaxCardQueryLength+1)},
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{}
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name:     "デッキではコントリビュート数で並び替えられない",
+			githubID: "12345",
+			page:     domain.PageRequest{SortKey: domain.SortKeyContribution},
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{}
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name:     "limitが上限を超える場合",
+			githubID: "12345",
+			page:     domain.PageRequest{Limit: domain.MaxPageLimit + 1},
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{}
+			},
+			wantErr: domain.ErrInvalidArgument,
 		},
 		{
 			name:     "Repositoryエラーが発生した場合",
 			githubID: "12345",
 			setupRepo: func() *repository.MockCardRepository {
 				return &repository.MockCardRepository{
-					FindAllFunc: func(ctx context.Context, githubID string) ([]domain.Card, error) {
+					SearchFunc: func(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
 						return nil, fmt.Errorf("database error")
 					},
 				}
 			},
-			wantErr:    true,
-			wantErrMsg: "failed to get all cards",
+			wantErrMsg: "failed to list cards",
 		},
 	}
 
@@ -88,25 +160,32 @@ func TestGetAllCards(t *testing.T) {
 			cardRepo := tt.setupRepo()
 			identiconGen := &identicon.MockIdenticonGenerator{}
 
-			service := NewCardService(cardRepo, identiconGen)
-			cards, err := service.GetAllCards(ctx, tt.githubID)
+			service := NewCardService(cardRepo, identiconGen, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+			page, err := service.ListCards(ctx, tt.githubID, tt.filter, tt.page)
 
-			if tt.wantErr {
+			if tt.wantErr != nil || tt.wantErrMsg != "" {
 				if err == nil {
 					t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
 					return
 				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("エラーが期待と異なります: 期待=%v, 実際=%v", tt.wantErr, err)
+				}
 				if tt.wantErrMsg != "" && !contains(err.Error(), tt.wantErrMsg) {
 					t.Errorf("エラーメッセージが期待と異なります: 期待=%s, 実際=%s", tt.wantErrMsg, err.Error())
 				}
-			} else {
-				if err != nil {
-					t.Errorf("予期しないエラーが発生しました: %v", err)
-					return
-				}
-				if len(cards) != tt.wantCardCount {
-					t.Errorf("カード数が期待と異なります: 期待=%d, 実際=%d", tt.wantCardCount, len(cards))
-				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("予期しないエラーが発生しました: %v", err)
+				return
+			}
+			if len(page.CollectedCards) != tt.wantCardCount {
+				t.Errorf("カード数が期待と異なります: 期待=%d, 実際=%d", tt.wantCardCount, len(page.CollectedCards))
+			}
+			if page.NextCursor != tt.wantCursor {
+				t.Errorf("NextCursorが期待と異なります: 期待=%s, 実際=%s", tt.wantCursor, page.NextCursor)
 			}
 		})
 	}
@@ -207,7 +286,7 @@ func TestGetCardByGitHubID(t *testing.T) {
 			identiconGen := &identicon.MockIdenticonGenerator{}
 			githubClient := tt.setupGitHub()
 
-			service := NewCardService(cardRepo, identiconGen)
+			service := NewCardService(cardRepo, identiconGen, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
 			card, err := service.GetCardByGitHubID(ctx, tt.githubID, githubClient)
 
 			if tt.wantErr {
@@ -235,6 +314,123 @@ func TestGetCardByGitHubID(t *testing.T) {
 	}
 }
 
+// GetIdenticonImage はカードのIdenticonを描画し、描画に使ったデータからETagを求める
+func TestGetIdenticonImage(t *testing.T) {
+	card := createTestCard("12345")
+	cardRepo := &repository.MockCardRepository{
+		FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+			if githubID != card.GithubID {
+				return nil, domain.ErrNotFound
+			}
+			return card, nil
+		},
+	}
+	renderer := &identicon.MockRenderer{
+		RenderFunc: func(color domain.Color, blocks domain.Blocks, format domain.ImageFormat, opts domain.ImageOptions) ([]byte, error) {
+			if opts.Padding == nil {
+				return nil, fmt.Errorf("padding is not defaulted")
+			}
+			return []byte(fmt.Sprintf("%s:%d", format, opts.Size)), nil
+		},
+	}
+	service := NewCardService(cardRepo, &identicon.MockIdenticonGenerator{}, renderer, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+	ctx := context.Background()
+
+	svg, err := service.GetIdenticonImage(ctx, "12345", domain.ImageFormatSVG, domain.ImageOptions{})
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if string(svg.Data) != "svg:256" {
+		t.Errorf("既定の大きさで描画されていません: %s", svg.Data)
+	}
+
+	again, err := service.GetIdenticonImage(ctx, "12345", domain.ImageFormatSVG, domain.ImageOptions{})
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if svg.ETag == "" || svg.ETag != again.ETag {
+		t.Errorf("同じデータのETagが一致しません: %s, %s", svg.ETag, again.ETag)
+	}
+
+	large, err := service.GetIdenticonImage(ctx, "12345", domain.ImageFormatSVG, domain.ImageOptions{Size: 512})
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if large.ETag == svg.ETag {
+		t.Errorf("大きさが違うのにETagが一致しています")
+	}
+
+	if _, err := service.GetIdenticonImage(ctx, "12345", domain.ImageFormatPNG, domain.ImageOptions{Size: 4096}); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Errorf("大きすぎる画像: エラーが期待と異なります: %v", err)
+	}
+	if _, err := service.GetIdenticonImage(ctx, "99999", domain.ImageFormatPNG, domain.ImageOptions{}); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("存在しないカード: エラーが期待と異なります: %v", err)
+	}
+}
+
+// GetCardImage は指定されたGitHub IDのカード全体を画像として描画する
+func TestGetCardImage(t *testing.T) {
+	card := createTestCard("12345")
+	cardRepo := &repository.MockCardRepository{
+		FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+			if githubID != card.GithubID {
+				return nil, domain.ErrNotFound
+			}
+			return card, nil
+		},
+	}
+	renderer := &cardimage.MockRenderer{
+		RenderFunc: func(ctx context.Context, content domain.CardImageContent, format domain.ImageFormat) ([]byte, error) {
+			if content.TotalContribution == nil {
+				return []byte(fmt.Sprintf("%s:%s", format, content.Card.UserName)), nil
+			}
+			return []byte(fmt.Sprintf("%s:%s:%d", format, content.Card.UserName, *content.TotalContribution)), nil
+		},
+	}
+	service := NewCardService(cardRepo, &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, renderer, &share.MockSigner{}, nil)
+	ctx := context.Background()
+
+	svg, err := service.GetCardImage(ctx, "12345", domain.ImageFormatSVG, nil)
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if want := "svg:" + card.UserName; string(svg.Data) != want {
+		t.Errorf("描画された内容が違う: 期待=%s, 実際=%s", want, svg.Data)
+	}
+
+	again, err := service.GetCardImage(ctx, "12345", domain.ImageFormatSVG, nil)
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if svg.ETag == "" || svg.ETag != again.ETag {
+		t.Errorf("同じデータのETagが一致しません: %s, %s", svg.ETag, again.ETag)
+	}
+
+	total := 100
+	withTotal, err := service.GetCardImage(ctx, "12345", domain.ImageFormatSVG, &total)
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if want := "svg:" + card.UserName + ":100"; string(withTotal.Data) != want {
+		t.Errorf("コントリビュート数が渡されていません: %s", withTotal.Data)
+	}
+	if withTotal.ETag == svg.ETag {
+		t.Errorf("コントリビュート数が違うのにETagが一致しています")
+	}
+
+	png, err := service.GetCardImage(ctx, "12345", domain.ImageFormatPNG, nil)
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if png.ETag == svg.ETag {
+		t.Errorf("形式が違うのにETagが一致しています")
+	}
+
+	if _, err := service.GetCardImage(ctx, "99999", domain.ImageFormatPNG, nil); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("存在しないカード: エラーが期待と異なります: %v", err)
+	}
+}
+
 // GetMyCard は自分のカードを取得する
 func TestGetMyCard(t *testing.T) {
 	tests := []struct {
@@ -315,7 +511,7 @@ func TestGetMyCard(t *testing.T) {
 			identiconGen := &identicon.MockIdenticonGenerator{}
 			githubClient := tt.setupGitHub()
 
-			service := NewCardService(cardRepo, identiconGen)
+			service := NewCardService(cardRepo, identiconGen, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
 			card, err := service.GetMyCard(ctx, tt.githubID, githubClient)
 
 			if tt.wantErr {
@@ -378,7 +574,7 @@ func TestGetOrCreateMyCard(t *testing.T) {
 			setupRepo: func() *repository.MockCardRepository {
 				return &repository.MockCardRepository{
 					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
-						return nil, gorm.ErrRecordNotFound
+						return nil, domain.ErrNotFound
 					},
 					CreateFunc: func(ctx context.Context, card *domain.Card) error {
 						return nil
@@ -387,7 +583,7 @@ func TestGetOrCreateMyCard(t *testing.T) {
 			},
 			setupIdenticon: func() *identicon.MockIdenticonGenerator {
 				return &identicon.MockIdenticonGenerator{
-					GenerateFunc: func(githubID string) (domain.Color, domain.Blocks, error) {
+					GenerateFunc: func(githubID string, version domain.IdenticonVersion) (domain.Color, domain.Blocks, error) {
 						return domain.Color("#000000"), domain.Blocks{}, nil
 					},
 				}
@@ -419,13 +615,13 @@ func TestGetOrCreateMyCard(t *testing.T) {
 			setupRepo: func() *repository.MockCardRepository {
 				return &repository.MockCardRepository{
 					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
-						return nil, gorm.ErrRecordNotFound
+						return nil, domain.ErrNotFound
 					},
 				}
 			},
 			setupIdenticon: func() *identicon.MockIdenticonGenerator {
 				return &identicon.MockIdenticonGenerator{
-					GenerateFunc: func(githubID string) (domain.Color, domain.Blocks, error) {
+					GenerateFunc: func(githubID string, version domain.IdenticonVersion) (domain.Color, domain.Blocks, error) {
 						return "", domain.Blocks{}, fmt.Errorf("identicon generation error")
 					},
 				}
@@ -440,7 +636,7 @@ func TestGetOrCreateMyCard(t *testing.T) {
 			setupRepo: func() *repository.MockCardRepository {
 				return &repository.MockCardRepository{
 					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
-						return nil, gorm.ErrRecordNotFound
+						return nil, domain.ErrNotFound
 					},
 					CreateFunc: func(ctx context.Context, card *domain.Card) error {
 						return fmt.Errorf("create error")
@@ -449,7 +645,7 @@ func TestGetOrCreateMyCard(t *testing.T) {
 			},
 			setupIdenticon: func() *identicon.MockIdenticonGenerator {
 				return &identicon.MockIdenticonGenerator{
-					GenerateFunc: func(githubID string) (domain.Color, domain.Blocks, error) {
+					GenerateFunc: func(githubID string, version domain.IdenticonVersion) (domain.Color, domain.Blocks, error) {
 						return domain.Color("#000000"), domain.Blocks{}, nil
 					},
 				}
@@ -464,13 +660,13 @@ func TestGetOrCreateMyCard(t *testing.T) {
 			setupRepo: func() *repository.MockCardRepository {
 				return &repository.MockCardRepository{
 					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
-						return nil, gorm.ErrRecordNotFound
+						return nil, domain.ErrNotFound
 					},
 				}
 			},
 			setupIdenticon: func() *identicon.MockIdenticonGenerator {
 				return &identicon.MockIdenticonGenerator{
-					GenerateFunc: func(githubID string) (domain.Color, domain.Blocks, error) {
+					GenerateFunc: func(githubID string, version domain.IdenticonVersion) (domain.Color, domain.Blocks, error) {
 						return domain.Color("#000000"), domain.Blocks{}, nil
 					},
 				}
@@ -494,7 +690,7 @@ func TestGetOrCreateMyCard(t *testing.T) {
 			identiconGen := tt.setupIdenticon()
 			githubClient := tt.setupGitHub()
 
-			service := NewCardService(cardRepo, identiconGen)
+			service := NewCardService(cardRepo, identiconGen, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
 			card, err := service.GetOrCreateMyCard(ctx, tt.githubID, "MDQ6VXNlcjEyMzQ1", githubClient)
 
 			if tt.wantErr {
@@ -517,6 +713,76 @@ func TestGetOrCreateMyCard(t *testing.T) {
 				if card.GithubID != tt.githubID {
 					t.Errorf("GitHubIDが期待と異なります: 期待=%s, 実際=%s", tt.githubID, card.GithubID)
 				}
+				if tt.wantCreated && card.IdenticonVersion != identiconGen.CurrentVersion() {
+					t.Errorf("IdenticonVersionが期待と異なります: 期待=%d, 実際=%d", identiconGen.CurrentVersion(), card.IdenticonVersion)
+				}
+			}
+		})
+	}
+}
+
+// プライベートリポジトリを含める設定の場合、前回の集計から時間が経ったときだけ言語を取り直す
+func TestGetOrCreateMyCard_PrivateLanguagesTTL(t *testing.T) {
+	fresh := time.Now().Add(-time.Hour)
+	stale := time.Now().Add(-privateLanguagesTTL - time.Hour)
+
+	tests := []struct {
+		name        string
+		refreshedAt *time.Time
+		wantRefresh bool
+	}{
+		{
+			name:        "一度も集計していない場合は取り直す",
+			refreshedAt: nil,
+			wantRefresh: true,
+		},
+		{
+			name:        "前回の集計から間隔が経っていない場合は取り直さない",
+			refreshedAt: &fresh,
+			wantRefresh: false,
+		},
+		{
+			name:        "前回の集計から間隔が経った場合は取り直す",
+			refreshedAt: &stale,
+			wantRefresh: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := createTestCard("12345")
+			card.Settings.IncludePrivateRepositories = true
+			card.PrivateLanguagesRefreshedAt = tt.refreshedAt
+
+			updated := false
+			cardRepo := &repository.MockCardRepository{
+				FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+					return card, nil
+				},
+				UpdateFunc: func(ctx context.Context, card *domain.Card) error {
+					updated = true
+					return nil
+				},
+			}
+			called := false
+			githubClient := &github.MockClient{
+				GetMyLanguagesFunc: func(ctx context.Context) (github.LanguageInfo, error) {
+					called = true
+					return github.LanguageInfo{Name: "Rust", Color: "#dea584"}, nil
+				},
+			}
+
+			service := NewCardService(cardRepo, &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+			got, err := service.GetOrCreateMyCard(context.Background(), "12345", "MDQ6VXNlcjEyMzQ1", githubClient)
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+
+			if called != tt.wantRefresh || updated != tt.wantRefresh {
+				t.Errorf("言語を取り直したかどうかが期待と異なります: 期待=%v, 取得=%v, 保存=%v", tt.wantRefresh, called, updated)
+			}
+			if tt.wantRefresh && (got.PrivateLanguagesRefreshedAt == nil || time.Since(*got.PrivateLanguagesRefreshedAt) > time.Minute) {
+				t.Errorf("集計した日時が更新されていません: %v", got.PrivateLanguagesRefreshedAt)
 			}
 		})
 	}
@@ -528,6 +794,8 @@ func TestAddCardToDeck(t *testing.T) {
 		name              string
 		collectorGithubID string
 		targetGithubID    string
+		payload           string
+		detail            domain.CollectDetail
 		setupRepo         func() *repository.MockCardRepository
 		setupGitHub       func() *github.MockClient
 		wantErr           bool
@@ -542,7 +810,28 @@ func TestAddCardToDeck(t *testing.T) {
 					FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
 						return createTestCard(githubID), nil
 					},
-					AddToCollectedCardsFunc: func(ctx context.Context, collectorGithubID string, cardID domain.CardID) error {
+					AddToCollectedCardsFunc: func(ctx context.Context, collectedCard *domain.CollectedCard) error {
+						return nil
+					},
+				}
+			},
+			setupGitHub: createMockGitHubClient,
+			wantErr:     false,
+		},
+		{
+			name:              "集めたときの状況を保存できる",
+			collectorGithubID: "11111",
+			targetGithubID:    "12345",
+			detail:            domain.CollectDetail{Source: domain.CollectSourceNFC, Note: "  met at meetup  "},
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return createTestCard(githubID), nil
+					},
+					AddToCollectedCardsFunc: func(ctx context.Context, collectedCard *domain.CollectedCard) error {
+						if collectedCard.CollectorGithubID != "11111" || collectedCard.Source != domain.CollectSourceNFC || collectedCard.Note != "met at meetup" {
+							return fmt.Errorf("unexpected collected card: %+v", collectedCard)
+						}
 						return nil
 					},
 				}
@@ -550,6 +839,57 @@ func TestAddCardToDeck(t *testing.T) {
 			setupGitHub: createMockGitHubClient,
 			wantErr:     false,
 		},
+		{
+			name:              "署名付きペイロードでない場合",
+			collectorGithubID: "11111",
+			targetGithubID:    "12345",
+			payload:           "12345",
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return nil, fmt.Errorf("FindByGitHubID should not be called")
+					},
+				}
+			},
+			setupGitHub: createMockGitHubClient,
+			wantErr:     true,
+			wantErrMsg:  "failed to verify share payload",
+		},
+		{
+			name:              "集めた方法が不正な場合",
+			collectorGithubID: "11111",
+			targetGithubID:    "12345",
+			detail:            domain.CollectDetail{Source: "email"},
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return createTestCard(githubID), nil
+					},
+				}
+			},
+			setupGitHub: createMockGitHubClient,
+			wantErr:     true,
+			wantErrMsg:  "unsupported collect source",
+		},
+		{
+			name:              "コミュニティが存在しない場合",
+			collectorGithubID: "11111",
+			targetGithubID:    "12345",
+			detail:            domain.CollectDetail{CommunityID: func() *domain.CommunityID { id := domain.NewCommunityID(); return &id }()},
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return createTestCard(githubID), nil
+					},
+					AddToCollectedCardsFunc: func(ctx context.Context, collectedCard *domain.CollectedCard) error {
+						return domain.ErrNotFound
+					},
+				}
+			},
+			setupGitHub: createMockGitHubClient,
+			wantErr:     true,
+			wantErrMsg:  "community not found",
+		},
 		{
 			name:              "カードが見つからない場合",
 			collectorGithubID: "11111",
@@ -557,7 +897,7 @@ func TestAddCardToDeck(t *testing.T) {
 			setupRepo: func() *repository.MockCardRepository {
 				return &repository.MockCardRepository{
 					FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
-						return nil, gorm.ErrRecordNotFound
+						return nil, domain.ErrNotFound
 					},
 				}
 			},
@@ -589,7 +929,7 @@ func TestAddCardToDeck(t *testing.T) {
 					FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
 						return createTestCard(githubID), nil
 					},
-					AddToCollectedCardsFunc: func(ctx context.Context, collectorGithubID string, cardID domain.CardID) error {
+					AddToCollectedCardsFunc: func(ctx context.Context, collectedCard *domain.CollectedCard) error {
 						return fmt.Errorf("add error")
 					},
 				}
@@ -598,6 +938,24 @@ func TestAddCardToDeck(t *testing.T) {
 			wantErr:     true,
 			wantErrMsg:  "failed to add card to deck",
 		},
+		{
+			name:              "既にデッキにあるカードの場合",
+			collectorGithubID: "11111",
+			targetGithubID:    "12345",
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return createTestCard(githubID), nil
+					},
+					AddToCollectedCardsFunc: func(ctx context.Context, collectedCard *domain.CollectedCard) error {
+						return domain.ErrAlreadyExists
+					},
+				}
+			},
+			setupGitHub: createMockGitHubClient,
+			wantErr:     true,
+			wantErrMsg:  "card already in deck",
+		},
 		{
 			name:              "GitHubClientエラーが発生した場合",
 			collectorGithubID: "11111",
@@ -607,7 +965,7 @@ func TestAddCardToDeck(t *testing.T) {
 					FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
 						return createTestCard(githubID), nil
 					},
-					AddToCollectedCardsFunc: func(ctx context.Context, collectorGithubID string, cardID domain.CardID) error {
+					AddToCollectedCardsFunc: func(ctx context.Context, collectedCard *domain.CollectedCard) error {
 						return nil
 					},
 				}
@@ -631,8 +989,13 @@ func TestAddCardToDeck(t *testing.T) {
 			identiconGen := &identicon.MockIdenticonGenerator{}
 			githubClient := tt.setupGitHub()
 
-			service := NewCardService(cardRepo, identiconGen)
-			card, err := service.AddCardToDeck(ctx, tt.collectorGithubID, tt.targetGithubID, githubClient)
+			payload := "payload:" + tt.targetGithubID
+			if tt.payload != "" {
+				payload = tt.payload
+			}
+
+			service := NewCardService(cardRepo, identiconGen, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+			card, err := service.AddCardToDeck(ctx, tt.collectorGithubID, payload, tt.detail, githubClient)
 
 			if tt.wantErr {
 				if err == nil {
@@ -659,6 +1022,85 @@ func TestAddCardToDeck(t *testing.T) {
 	}
 }
 
+// ShareMyCard は自分のカードの署名付きペイロードを発行する
+func TestShareMyCard(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupRepo func() *repository.MockCardRepository
+		signer    *share.MockSigner
+		wantErr   error
+	}{
+		{
+			name: "自分のカードのペイロードを発行できる",
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return createTestCard(githubID), nil
+					},
+				}
+			},
+			signer: &share.MockSigner{},
+		},
+		{
+			name: "自分のカードがない場合",
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return nil, domain.ErrNotFound
+					},
+				}
+			},
+			signer:  &share.MockSigner{},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "署名に失敗した場合",
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return createTestCard(githubID), nil
+					},
+				}
+			},
+			signer: &share.MockSigner{
+				SignFunc: func(cardShare domain.CardShare) (string, error) {
+					return "", errSignFailed
+				},
+			},
+			wantErr: errSignFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewCardService(tt.setupRepo(), &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, tt.signer, nil)
+			before := time.Now()
+			cardShare, err := service.ShareMyCard(context.Background(), "11111")
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("エラーが期待と異なります: 期待=%v, 実際=%v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+			if cardShare.GithubID != "11111" {
+				t.Errorf("GitHubIDが期待と異なります: 期待=11111, 実際=%s", cardShare.GithubID)
+			}
+			if cardShare.Payload != "payload:11111" {
+				t.Errorf("ペイロードが期待と異なります: 実際=%s", cardShare.Payload)
+			}
+			if cardShare.ExpiresAt.Before(before.Add(shareExpiration)) {
+				t.Errorf("有効期限が期待より短いです: %s", cardShare.ExpiresAt)
+			}
+		})
+	}
+}
+
+var errSignFailed = errors.New("sign failed")
+
 // RemoveCardFromDeck はカードをデッキから削除する
 func TestRemoveCardFromDeck(t *testing.T) {
 	tests := []struct {
@@ -694,7 +1136,7 @@ func TestRemoveCardFromDeck(t *testing.T) {
 			setupRepo: func() *repository.MockCardRepository {
 				return &repository.MockCardRepository{
 					FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
-						return nil, gorm.ErrRecordNotFound
+						return nil, domain.ErrNotFound
 					},
 				}
 			},
@@ -768,7 +1210,7 @@ func TestRemoveCardFromDeck(t *testing.T) {
 			identiconGen := &identicon.MockIdenticonGenerator{}
 			githubClient := tt.setupGitHub()
 
-			service := NewCardService(cardRepo, identiconGen)
+			service := NewCardService(cardRepo, identiconGen, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
 			card, err := service.RemoveCardFromDeck(ctx, tt.collectorGithubID, tt.targetGithubID, githubClient)
 
 			if tt.wantErr {
@@ -830,13 +1272,23 @@ func TestEnrichCardWithGitHubInfo(t *testing.T) {
 							AvatarURL: "https://example.com/avatar.png",
 						}, nil
 					},
-					GetMostUsedLanguageFunc: func(ctx context.Context, login string) (string, string, error) {
-						return "Go", "#00ADD8", nil
+					GetMostUsedLanguageFunc: func(ctx context.Context, login string) (github.LanguageInfo, error) {
+						return github.LanguageInfo{
+							Name:  "Go",
+							Color: "#00ADD8",
+							Breakdown: []github.LanguageBreakdown{
+								{Name: "Go", Bytes: 3000, Percentage: 75, Color: "#00ADD8"},
+								{Name: "Shell", Bytes: 1000, Percentage: 25, Color: "#89e051"},
+							},
+						}, nil
 					},
 				}
 			},
 			wantErr: false,
 			validate: func(t *testing.T, card *domain.Card) {
+				if len(card.TopLanguages) != 2 || card.TopLanguages[1].LanguageName != "Shell" || card.TopLanguages[1].Percentage != 25 {
+					t.Errorf("TopLanguages = %+v, want [Go 75%%, Shell 25%%]", card.TopLanguages)
+				}
 				if card.UserName != "testuser" {
 					t.Errorf("UserName = %v, want testuser", card.UserName)
 				}
@@ -907,8 +1359,8 @@ func TestEnrichCardWithGitHubInfo(t *testing.T) {
 							AvatarURL: "https://example.com/avatar.png",
 						}, nil
 					},
-					GetMostUsedLanguageFunc: func(ctx context.Context, login string) (string, string, error) {
-						return "", "", fmt.Errorf("language api error")
+					GetMostUsedLanguageFunc: func(ctx context.Context, login string) (github.LanguageInfo, error) {
+						return github.LanguageInfo{}, fmt.Errorf("language api error")
 					},
 				}
 			},
@@ -1376,7 +1828,7 @@ func TestRefreshAllCards(t *testing.T) {
 			identiconGen := &identicon.MockIdenticonGenerator{}
 			githubClient := tt.setupGitHub()
 
-			service := NewCardService(cardRepo, identiconGen)
+			service := NewCardService(cardRepo, identiconGen, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
 			cards, err := service.RefreshAllCards(ctx, githubClient)
 
 			if tt.wantErr {
@@ -1402,3 +1854,535 @@ func TestRefreshAllCards(t *testing.T) {
 		})
 	}
 }
+
+// GitHub Appが設定されている場合は呼び出したユーザーのトークンを使わずに更新する
+func TestRefreshAllCards_SystemGitHubClient(t *testing.T) {
+	cardRepo := &repository.MockCardRepository{
+		FindAllCardsInDBFunc: func(ctx context.Context) ([]domain.Card, error) {
+			return []domain.Card{*createTestCard("12345")}, nil
+		},
+		UpdateFunc: func(ctx context.Context, card *domain.Card) error {
+			return nil
+		},
+	}
+	userClient := &github.MockClient{
+		GetUsersByIDsFunc: func(ctx context.Context, ids []int64) (map[int64]*github.UserInfo, error) {
+			t.Errorf("ユーザーのトークンでGitHub APIが呼ばれました")
+			return nil, fmt.Errorf("unexpected call")
+		},
+	}
+	systemClient := &github.MockClient{
+		GetUsersByIDsFunc: func(ctx context.Context, ids []int64) (map[int64]*github.UserInfo, error) {
+			return map[int64]*github.UserInfo{12345: {ID: 12345, Login: "testuser", Name: "Test User"}}, nil
+		},
+		GetMostUsedLanguagesFunc: func(ctx context.Context, logins []string) (map[string]github.LanguageInfo, error) {
+			return map[string]github.LanguageInfo{"testuser": {Name: "Go", Color: "#00ADD8"}}, nil
+		},
+	}
+
+	service := NewCardService(cardRepo, &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, systemClient)
+	cards, err := service.RefreshAllCards(context.Background(), userClient)
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if len(cards) != 1 || cards[0].UserName != "testuser" {
+		t.Errorf("カードが期待と異なります: %+v", cards)
+	}
+}
+
+// RefreshStaleCards は更新が古いカードだけを更新し、更新した日時を記録する
+func TestRefreshStaleCards(t *testing.T) {
+	refreshedBefore := time.Now().Add(-24 * time.Hour)
+	var gotBefore time.Time
+	var gotLimit int
+	var saved []domain.Card
+	cardRepo := &repository.MockCardRepository{
+		FindStaleCardsFunc: func(ctx context.Context, before time.Time, limit int) ([]domain.Card, error) {
+			gotBefore = before
+			gotLimit = limit
+			return []domain.Card{*createTestCard("12345")}, nil
+		},
+		UpdateFunc: func(ctx context.Context, card *domain.Card) error {
+			saved = append(saved, *card)
+			return nil
+		},
+	}
+	githubClient := &github.MockClient{
+		GetUsersByIDsFunc: func(ctx context.Context, ids []int64) (map[int64]*github.UserInfo, error) {
+			return map[int64]*github.UserInfo{12345: {ID: 12345, Login: "testuser", Name: "Test User"}}, nil
+		},
+		GetMostUsedLanguagesFunc: func(ctx context.Context, logins []string) (map[string]github.LanguageInfo, error) {
+			return map[string]github.LanguageInfo{"testuser": {Name: "Go", Color: "#00ADD8"}}, nil
+		},
+	}
+
+	service := NewCardService(cardRepo, &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+	refreshed, err := service.RefreshStaleCards(context.Background(), refreshedBefore, 100, githubClient)
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if refreshed != 1 {
+		t.Errorf("更新した件数が期待と異なります: 期待=1, 実際=%d", refreshed)
+	}
+	if !gotBefore.Equal(refreshedBefore) || gotLimit != 100 {
+		t.Errorf("検索条件が期待と異なります: before=%v, limit=%d", gotBefore, gotLimit)
+	}
+	if len(saved) != 1 || saved[0].UserName != "testuser" || saved[0].RefreshedAt == nil {
+		t.Errorf("保存したカードが期待と異なります: %+v", saved)
+	}
+}
+
+// プライベートリポジトリを含める設定のカードは、更新しても持ち主のトークンで保存した言語を残す
+func TestRefreshStaleCards_KeepPrivateLanguages(t *testing.T) {
+	card := createTestCard("12345")
+	card.Settings.IncludePrivateRepositories = true
+	card.MostUsedLanguage = domain.Language{LanguageName: "Rust", Color: "#dea584"}
+	card.TopLanguages = []domain.LanguageBreakdown{{LanguageName: "Rust", Bytes: 2000, Percentage: 100, Color: "#dea584"}}
+
+	var saved []domain.Card
+	cardRepo := &repository.MockCardRepository{
+		FindStaleCardsFunc: func(ctx context.Context, before time.Time, limit int) ([]domain.Card, error) {
+			return []domain.Card{*card}, nil
+		},
+		UpdateFunc: func(ctx context.Context, card *domain.Card) error {
+			saved = append(saved, *card)
+			return nil
+		},
+	}
+	githubClient := &github.MockClient{
+		GetUsersByIDsFunc: func(ctx context.Context, ids []int64) (map[int64]*github.UserInfo, error) {
+			return map[int64]*github.UserInfo{12345: {ID: 12345, Login: "testuser", Name: "Test User"}}, nil
+		},
+		GetMostUsedLanguagesFunc: func(ctx context.Context, logins []string) (map[string]github.LanguageInfo, error) {
+			return map[string]github.LanguageInfo{"testuser": {Name: "Go", Color: "#00ADD8"}}, nil
+		},
+	}
+
+	service := NewCardService(cardRepo, &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+	if _, err := service.RefreshStaleCards(context.Background(), time.Now(), 100, githubClient); err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if len(saved) != 1 || saved[0].UserName != "testuser" {
+		t.Fatalf("保存したカードが期待と異なります: %+v", saved)
+	}
+	if saved[0].MostUsedLanguage.LanguageName != "Rust" || len(saved[0].TopLanguages) != 1 {
+		t.Errorf("言語が上書きされています: %+v, %+v", saved[0].MostUsedLanguage, saved[0].TopLanguages)
+	}
+}
+
+// UpdateMyCardSettings は設定を保存し、設定に合わせて持ち主のトークンで言語を取り直す
+func TestUpdateMyCardSettings(t *testing.T) {
+	tests := []struct {
+		name         string
+		settings     domain.CardSettings
+		wantLanguage string
+	}{
+		{
+			name:         "プライベートリポジトリを含める場合は自分のリポジトリから集計する",
+			settings:     domain.CardSettings{IncludePrivateRepositories: true},
+			wantLanguage: "Rust",
+		},
+		{
+			name:         "含めない場合は公開リポジトリから集計する",
+			settings:     domain.CardSettings{IncludePrivateRepositories: false},
+			wantLanguage: "Go",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			card := createTestCard("12345")
+			card.UserName = "testuser"
+
+			var savedSettings *domain.CardSettings
+			var saved *domain.Card
+			cardRepo := &repository.MockCardRepository{
+				FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+					return card, nil
+				},
+				UpdateSettingsFunc: func(ctx context.Context, cardID domain.CardID, settings domain.CardSettings) error {
+					if cardID != card.ID {
+						return fmt.Errorf("unexpected card id: %s", cardID)
+					}
+					savedSettings = &settings
+					return nil
+				},
+				UpdateFunc: func(ctx context.Context, card *domain.Card) error {
+					saved = card
+					return nil
+				},
+			}
+			githubClient := &github.MockClient{
+				GetMostUsedLanguageFunc: func(ctx context.Context, login string) (github.LanguageInfo, error) {
+					if login != "testuser" {
+						return github.LanguageInfo{}, fmt.Errorf("unexpected login: %s", login)
+					}
+					return github.LanguageInfo{Name: "Go", Color: "#00ADD8"}, nil
+				},
+				GetMyLanguagesFunc: func(ctx context.Context) (github.LanguageInfo, error) {
+					return github.LanguageInfo{
+						Name:      "Rust",
+						Color:     "#dea584",
+						Breakdown: []github.LanguageBreakdown{{Name: "Rust", Bytes: 2000, Percentage: 100, Color: "#dea584"}},
+					}, nil
+				},
+			}
+
+			service := NewCardService(cardRepo, &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+			got, err := service.UpdateMyCardSettings(context.Background(), "12345", tt.settings, githubClient)
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+
+			if savedSettings == nil || *savedSettings != tt.settings {
+				t.Errorf("保存した設定が期待と異なります: %+v", savedSettings)
+			}
+			if got.Settings != tt.settings {
+				t.Errorf("カードの設定が期待と異なります: %+v", got.Settings)
+			}
+			if saved == nil || saved.MostUsedLanguage.LanguageName != tt.wantLanguage {
+				t.Errorf("保存したカードの言語が期待と異なります: 期待=%s, 実際=%+v", tt.wantLanguage, saved)
+			}
+		})
+	}
+}
+
+// 自分のカードがない場合は設定を変更できない
+func TestUpdateMyCardSettings_NotFound(t *testing.T) {
+	cardRepo := &repository.MockCardRepository{
+		FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+			return nil, domain.ErrNotFound
+		},
+	}
+
+	service := NewCardService(cardRepo, &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+	_, err := service.UpdateMyCardSettings(context.Background(), "12345", domain.CardSettings{IncludePrivateRepositories: true}, &github.MockClient{})
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("エラーが期待と異なります: 期待=%v, 実際=%v", domain.ErrNotFound, err)
+	}
+}
+
+// UpgradeIdenticons は古いバージョンのIdenticonのカードだけを指定したバージョンで作り直す
+func TestUpgradeIdenticons(t *testing.T) {
+	newCards := func() []domain.Card {
+		classic := createTestCard("12345")
+		classic.IdenticonVersion = domain.IdenticonVersionClassic
+		upToDate := createTestCard("67890")
+		upToDate.IdenticonVersion = domain.IdenticonVersionGrid7
+		return []domain.Card{*classic, *upToDate}
+	}
+	identiconGen := &identicon.MockIdenticonGenerator{
+		GenerateFunc: func(githubID string, version domain.IdenticonVersion) (domain.Color, domain.Blocks, error) {
+			if version != domain.IdenticonVersionGrid7 {
+				return "", nil, fmt.Errorf("unexpected version: %d", version)
+			}
+			return domain.Color("#8058d7"), domain.NewBlocks(7), nil
+		},
+	}
+
+	tests := []struct {
+		name        string
+		version     domain.IdenticonVersion
+		dryRun      bool
+		updateErr   error
+		wantErr     error
+		wantErrMsg  string
+		wantUpdated int
+	}{
+		{
+			name:        "古いバージョンのカードだけを作り直す",
+			version:     domain.IdenticonVersionGrid7,
+			wantUpdated: 1,
+		},
+		{
+			name:    "dryRunの場合は保存しない",
+			version: domain.IdenticonVersionGrid7,
+			dryRun:  true,
+		},
+		{
+			name:    "不正なバージョンの場合",
+			version: 0,
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name:       "カードの更新に失敗した場合",
+			version:    domain.IdenticonVersionGrid7,
+			updateErr:  fmt.Errorf("update error"),
+			wantErrMsg: "failed to update card 12345",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := 0
+			cardRepo := &repository.MockCardRepository{
+				FindAllCardsInDBFunc: func(ctx context.Context) ([]domain.Card, error) {
+					return newCards(), nil
+				},
+				UpdateFunc: func(ctx context.Context, card *domain.Card) error {
+					if tt.updateErr != nil {
+						return tt.updateErr
+					}
+					updated++
+					return nil
+				},
+			}
+
+			service := NewCardService(cardRepo, identiconGen, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+			cards, err := service.UpgradeIdenticons(context.Background(), tt.version, tt.dryRun)
+
+			if tt.wantErr != nil || tt.wantErrMsg != "" {
+				if err == nil {
+					t.Fatalf("エラーが期待されましたが、エラーが発生しませんでした")
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("エラーが期待と異なります: 期待=%v, 実際=%v", tt.wantErr, err)
+				}
+				if tt.wantErrMsg != "" && !contains(err.Error(), tt.wantErrMsg) {
+					t.Errorf("エラーメッセージが期待と異なります: 期待=%s, 実際=%s", tt.wantErrMsg, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+			if updated != tt.wantUpdated {
+				t.Errorf("更新回数が期待と異なります: 期待=%d, 実際=%d", tt.wantUpdated, updated)
+			}
+			if len(cards) != 1 || cards[0].GithubID != "12345" {
+				t.Fatalf("作り直したカードが期待と異なります: %+v", cards)
+			}
+			if cards[0].IdenticonVersion != domain.IdenticonVersionGrid7 || len(cards[0].Blocks) != 7 {
+				t.Errorf("Identiconが作り直されていません: version=%d, size=%d", cards[0].IdenticonVersion, len(cards[0].Blocks))
+			}
+		})
+	}
+}
+
+// CreateExchange は自分のカードを交換するための申し出を作成する
+func TestCreateExchange(t *testing.T) {
+	tests := []struct {
+		name          string
+		expiresIn     time.Duration
+		setupRepo     func() *repository.MockCardRepository
+		wantExpiresIn time.Duration
+		wantErr       error
+	}{
+		{
+			name:      "有効期限を省略すると既定の有効期限になる",
+			expiresIn: 0,
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return createTestCard(githubID), nil
+					},
+				}
+			},
+			wantExpiresIn: defaultExchangeExpiration,
+		},
+		{
+			name:      "有効期限が上限を超える場合",
+			expiresIn: maxExchangeExpiration + time.Second,
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{}
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name:      "自分のカードがない場合",
+			expiresIn: time.Minute,
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return nil, domain.ErrNotFound
+					},
+				}
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name:      "トークンが衝突した場合は作り直す",
+			expiresIn: time.Minute,
+			setupRepo: func() *repository.MockCardRepository {
+				attempts := 0
+				return &repository.MockCardRepository{
+					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return createTestCard(githubID), nil
+					},
+					CreateExchangeFunc: func(ctx context.Context, exchange *domain.CardExchange) error {
+						attempts++
+						if attempts == 1 {
+							return domain.ErrAlreadyExists
+						}
+						return nil
+					},
+				}
+			},
+			wantExpiresIn: time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewCardService(tt.setupRepo(), &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+			before := time.Now()
+			exchange, err := service.CreateExchange(context.Background(), "11111", tt.expiresIn)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("エラーが期待と異なります: 期待=%v, 実際=%v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+			if exchange.Token == "" {
+				t.Errorf("トークンが空です")
+			}
+			if exchange.Status != domain.CardExchangeStatusPending {
+				t.Errorf("状態が期待と異なります: 期待=pending, 実際=%s", exchange.Status)
+			}
+			if exchange.OfferedCard == nil || exchange.OfferedCard.GithubID != "11111" {
+				t.Errorf("申し出た人のカードが設定されていません")
+			}
+			if exchange.ExpiresAt.Before(before.Add(tt.wantExpiresIn)) {
+				t.Errorf("有効期限が期待より短いです: %s", exchange.ExpiresAt)
+			}
+		})
+	}
+}
+
+// GetExchange は有効期限を過ぎた申し出を expired として返す
+func TestGetExchange(t *testing.T) {
+	tests := []struct {
+		name       string
+		exchange   domain.CardExchange
+		wantStatus domain.CardExchangeStatus
+	}{
+		{
+			name:       "有効期限内の申し出はpendingのまま",
+			exchange:   domain.CardExchange{Status: domain.CardExchangeStatusPending, ExpiresAt: time.Now().Add(time.Minute)},
+			wantStatus: domain.CardExchangeStatusPending,
+		},
+		{
+			name:       "有効期限を過ぎた申し出はexpiredになる",
+			exchange:   domain.CardExchange{Status: domain.CardExchangeStatusPending, ExpiresAt: time.Now().Add(-time.Minute)},
+			wantStatus: domain.CardExchangeStatusExpired,
+		},
+		{
+			name:       "受け取り済みの申し出は有効期限を過ぎてもacceptedのまま",
+			exchange:   domain.CardExchange{Status: domain.CardExchangeStatusAccepted, ExpiresAt: time.Now().Add(-time.Minute)},
+			wantStatus: domain.CardExchangeStatusAccepted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardRepo := &repository.MockCardRepository{
+				FindExchangeByTokenFunc: func(ctx context.Context, token string) (*domain.CardExchange, error) {
+					exchange := tt.exchange
+					return &exchange, nil
+				},
+			}
+			service := NewCardService(cardRepo, &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+
+			exchange, err := service.GetExchange(context.Background(), "token")
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+			if exchange.Status != tt.wantStatus {
+				t.Errorf("状態が期待と異なります: 期待=%s, 実際=%s", tt.wantStatus, exchange.Status)
+			}
+		})
+	}
+}
+
+// AcceptExchange は自分のカードで申し出を受け取る
+func TestAcceptExchange(t *testing.T) {
+	myCard := createTestCard("22222")
+
+	tests := []struct {
+		name      string
+		detail    domain.CollectDetail
+		setupRepo func() *repository.MockCardRepository
+		wantErr   error
+	}{
+		{
+			name:   "自分のカードで申し出を受け取れる",
+			detail: domain.CollectDetail{Source: domain.CollectSourceQR, Note: "  hello  "},
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return myCard, nil
+					},
+					AcceptExchangeFunc: func(ctx context.Context, token string, acceptedByGithubID string, acceptedCardID domain.CardID, detail domain.CollectDetail, now time.Time) error {
+						if acceptedByGithubID != "22222" || acceptedCardID != myCard.ID {
+							return fmt.Errorf("unexpected accepter: %s", acceptedByGithubID)
+						}
+						if detail.Note != "hello" {
+							return fmt.Errorf("note is not trimmed: %q", detail.Note)
+						}
+						return nil
+					},
+					FindExchangeByTokenFunc: func(ctx context.Context, token string) (*domain.CardExchange, error) {
+						return &domain.CardExchange{Token: token, Status: domain.CardExchangeStatusAccepted, AcceptedCard: myCard}, nil
+					},
+				}
+			},
+		},
+		{
+			name:   "集めた方法が不正な場合",
+			detail: domain.CollectDetail{Source: "unknown"},
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{}
+			},
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name: "自分のカードがない場合",
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return nil, domain.ErrNotFound
+					},
+				}
+			},
+			wantErr: domain.ErrNotFound,
+		},
+		{
+			name: "受け取り済みの申し出の場合",
+			setupRepo: func() *repository.MockCardRepository {
+				return &repository.MockCardRepository{
+					FindMyCardFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+						return myCard, nil
+					},
+					AcceptExchangeFunc: func(ctx context.Context, token string, acceptedByGithubID string, acceptedCardID domain.CardID, detail domain.CollectDetail, now time.Time) error {
+						return fmt.Errorf("%w: exchange has already been accepted", domain.ErrAlreadyExists)
+					},
+				}
+			},
+			wantErr: domain.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewCardService(tt.setupRepo(), &identicon.MockIdenticonGenerator{}, &identicon.MockRenderer{}, &cardimage.MockRenderer{}, &share.MockSigner{}, nil)
+			exchange, err := service.AcceptExchange(context.Background(), "token", "22222", tt.detail)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("エラーが期待と異なります: 期待=%v, 実際=%v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+			if exchange.Status != domain.CardExchangeStatusAccepted {
+				t.Errorf("状態が期待と異なります: 期待=accepted, 実際=%s", exchange.Status)
+			}
+		})
+	}
+}
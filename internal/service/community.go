@@ -2,44 +2,70 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/furarico/octo-deck-api/internal/domain"
 	"github.com/furarico/octo-deck-api/internal/github"
+	"github.com/google/uuid"
 )
 
 // CommunityRepository はServiceが必要とするRepositoryのインターフェース
 type CommunityRepository interface {
-	FindAll(ctx context.Context, githubID string) ([]domain.Community, error)
+	FindPage(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error)
 	FindByID(ctx context.Context, id string) (*domain.Community, error)
 	FindByIDWithHighlightedCard(ctx context.Context, id string) (*domain.Community, error)
+	FindActive(ctx context.Context, now time.Time, refreshedBefore time.Time, limit int) ([]domain.Community, error)
 	FindCards(ctx context.Context, id string) ([]domain.Card, error)
-	Create(ctx context.Context, community *domain.Community) error
+	FindCardsPage(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error)
+	Create(ctx context.Context, community *domain.Community, ownerCardID string) error
+	Update(ctx context.Context, community *domain.Community) error
 	Delete(ctx context.Context, id string) error
+	Purge(ctx context.Context, id string) error
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int, error)
+	FindDeletedByID(ctx context.Context, id string) (*domain.Community, error)
+	Restore(ctx context.Context, id string, deletedAfter time.Time) error
 	AddCard(ctx context.Context, communityID string, cardID string) error
 	RemoveCard(ctx context.Context, communityID string, cardID string) error
 	UpdateHighlightedCard(ctx context.Context, communityID string, highlightedCard *domain.HighlightedCard) error
 	UpdateCommunityCardContributions(ctx context.Context, communityID string, cardContributions map[string]int) error
+	FindMemberRole(ctx context.Context, communityID string, cardID string) (domain.CommunityRole, error)
+	UpdateMemberRole(ctx context.Context, communityID string, cardID string, role domain.CommunityRole) error
+	TransferOwnership(ctx context.Context, communityID string, fromCardID string, toCardID string) error
+	CreateInvite(ctx context.Context, invite *domain.CommunityInvite) error
+	FindActiveInvites(ctx context.Context, communityID string, now time.Time) ([]domain.CommunityInvite, error)
+	FindInviteByCode(ctx context.Context, code string) (*domain.CommunityInvite, error)
+	JoinByInvite(ctx context.Context, code string, cardID string, now time.Time) error
+	RevokeInvite(ctx context.Context, communityID string, code string, now time.Time) error
 }
 
 type CommunityService struct {
 	communityRepo CommunityRepository
 	cardRepo      CardRepository
+	snapshotRepo  ContributionSnapshotRepository
 }
 
-func NewCommunityService(communityRepo CommunityRepository, cardRepo CardRepository) *CommunityService {
+func NewCommunityService(communityRepo CommunityRepository, cardRepo CardRepository, snapshotRepo ContributionSnapshotRepository) *CommunityService {
 	return &CommunityService{
 		communityRepo: communityRepo,
 		cardRepo:      cardRepo,
+		snapshotRepo:  snapshotRepo,
 	}
 }
 
-// GetAllCommunities はすべてのコミュニティを取得する
-func (s *CommunityService) GetAllCommunities(ctx context.Context, githubID string) ([]domain.Community, error) {
-	communities, err := s.communityRepo.FindAll(ctx, githubID)
+// ListCommunities は参加しているコミュニティを作成日時の新しい順にページ単位で取得する
+func (s *CommunityService) ListCommunities(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error) {
+	page, err := page.WithDefaults(domain.SortOrderDesc, domain.SortKeyCreatedAt)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get all communities: %w", err)
+		return nil, err
+	}
+
+	communities, err := s.communityRepo.FindPage(ctx, githubID, page)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list communities: %w", err)
 	}
 
 	return communities, nil
@@ -53,7 +79,7 @@ func (s *CommunityService) GetCommunityByID(ctx context.Context, id string) (*do
 	}
 
 	if community == nil {
-		return nil, fmt.Errorf("community not found: id=%s", id)
+		return nil, fmt.Errorf("community not found: id=%s: %w", id, domain.ErrNotFound)
 	}
 
 	return community, nil
@@ -67,7 +93,7 @@ func (s *CommunityService) GetCommunityWithHighlightedCard(ctx context.Context,
 		return nil, nil, fmt.Errorf("failed to get community by id: %w", err)
 	}
 	if community == nil {
-		return nil, nil, fmt.Errorf("community not found: id=%s", id)
+		return nil, nil, fmt.Errorf("community not found: id=%s: %w", id, domain.ErrNotFound)
 	}
 
 	return community, &community.HighlightedCard, nil
@@ -81,7 +107,7 @@ func (s *CommunityService) RefreshHighlightedCard(ctx context.Context, id string
 		return nil, nil, fmt.Errorf("failed to get community by id: %w", err)
 	}
 	if community == nil {
-		return nil, nil, fmt.Errorf("community not found: id=%s", id)
+		return nil, nil, fmt.Errorf("community not found: id=%s: %w", id, domain.ErrNotFound)
 	}
 
 	// コミュニティのカード一覧を取得
@@ -146,6 +172,7 @@ func (s *CommunityService) RefreshHighlightedCard(ctx context.Context, id string
 
 	// コミュニティカードのコントリビュート数を更新
 	cardContributions := make(map[string]int)
+	var snapshots []contributionSnapshot
 	for _, info := range usersFullInfo {
 		nodeID, ok := loginToNodeID[info.Login]
 		if !ok {
@@ -158,6 +185,26 @@ func (s *CommunityService) RefreshHighlightedCard(ctx context.Context, id string
 		card := cards[cardIdx]
 		cardIDStr := card.ID.String()
 		cardContributions[cardIDStr] = info.Total
+
+		// コミュニティの期間の日ごとの数と内訳は、HighlightedCardを保存した後にまとめて記録する
+		contributions, err := github.ToDomainContributions(info.Contributions)
+		if err != nil {
+			log.Printf("Warning: failed to convert contributions of %s: %v", card.GithubID, err)
+			continue
+		}
+		detail := &domain.ContributionDetailSnapshot{
+			GithubID:          card.GithubID,
+			From:              community.StartedAt,
+			To:                community.EndedAt,
+			TotalContribution: info.Total,
+			ContributionDetail: *domain.NewContributionDetail(
+				info.Reviews,
+				info.Commits,
+				info.PRs,
+				info.Issues,
+			),
+		}
+		snapshots = append(snapshots, contributionSnapshot{githubID: card.GithubID, contributions: contributions, detail: detail})
 	}
 
 	if err := s.communityRepo.UpdateCommunityCardContributions(ctx, id, cardContributions); err != nil {
@@ -186,6 +233,14 @@ func (s *CommunityService) RefreshHighlightedCard(ctx context.Context, id string
 		return nil, nil, fmt.Errorf("failed to update highlighted card: %w", err)
 	}
 
+	// 記録は統計の履歴のためのものなので、失敗してもコミュニティの更新は止めない
+	fetchedAt := time.Now()
+	for _, snapshot := range snapshots {
+		if err := saveContributionSnapshot(ctx, s.snapshotRepo, snapshot.githubID, snapshot.contributions, snapshot.detail, fetchedAt); err != nil {
+			log.Printf("Warning: failed to save contribution snapshot of %s: %v", snapshot.githubID, err)
+		}
+	}
+
 	// 更新後のコミュニティを取得して返す
 	updatedCommunity, err := s.communityRepo.FindByIDWithHighlightedCard(ctx, id)
 	if err != nil {
@@ -259,10 +314,7 @@ func calculateHighlightedCardFromFullInfo(usersFullInfo []github.UserFullInfo, c
 		card.UserName = info.Login
 		card.FullName = info.Name
 		card.IconUrl = info.AvatarURL
-		card.MostUsedLanguage = domain.Language{
-			LanguageName: info.MostUsedLanguage,
-			Color:        info.MostUsedLanguageColor,
-		}
+		applyPublicLanguages(&card, domain.Language{LanguageName: info.MostUsedLanguage, Color: info.MostUsedLanguageColor}, github.ToDomainLanguageBreakdown(info.Languages))
 
 		return card
 	}
@@ -276,29 +328,320 @@ func calculateHighlightedCardFromFullInfo(usersFullInfo []github.UserFullInfo, c
 	}
 }
 
-// GetCommunityCards は指定したコミュニティIDのカード一覧をデータベースから取得する
-func (s *CommunityService) GetCommunityCards(ctx context.Context, id string) ([]domain.Card, error) {
-	cards, err := s.communityRepo.FindCards(ctx, id)
+// ListCommunityCards は指定したコミュニティIDのカード一覧をページ単位で取得する
+// 並び替えはコントリビュート数・ユーザー名・言語から選べる（既定はコントリビュート数の多い順）
+func (s *CommunityService) ListCommunityCards(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error) {
+	page, err := page.WithDefaults(domain.SortOrderDesc, domain.SortKeyContribution, domain.SortKeyUserName, domain.SortKeyLanguage)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.GetCommunityByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	cards, err := s.communityRepo.FindCardsPage(ctx, id, page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get community cards: %w", err)
+		return nil, fmt.Errorf("failed to list community cards: %w", err)
 	}
 
 	return cards, nil
 }
 
 // CreateCommunityWithPeriod は集計期間を指定してコミュニティを作成する
-func (s *CommunityService) CreateCommunityWithPeriod(ctx context.Context, name string, startDateTime, endDateTime time.Time) (*domain.Community, error) {
-	community := domain.NewCommunity(name, startDateTime, endDateTime, domain.HighlightedCard{})
+// 作成者のカードはオーナーとしてコミュニティに参加する
+func (s *CommunityService) CreateCommunityWithPeriod(ctx context.Context, name string, ownerGithubID string, startDateTime, endDateTime time.Time) (*domain.Community, error) {
+	if endDateTime.Before(startDateTime) {
+		return nil, fmt.Errorf("%w: endDateTime must not be before startDateTime", domain.ErrInvalidArgument)
+	}
+
+	ownerCard, err := s.findCardByGitHubID(ctx, ownerGithubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owner card: %w", err)
+	}
+
+	community := domain.NewCommunity(name, ownerGithubID, startDateTime, endDateTime, domain.HighlightedCard{})
 
-	if err := s.communityRepo.Create(ctx, community); err != nil {
+	if err := s.communityRepo.Create(ctx, community, ownerCard.ID.String()); err != nil {
 		return nil, fmt.Errorf("failed to create community: %w", err)
 	}
 
 	return community, nil
 }
 
+// UpdateCommunity はコミュニティの名前と集計期間を更新する
+// nilの項目は更新しない
+func (s *CommunityService) UpdateCommunity(ctx context.Context, id string, name *string, startDateTime, endDateTime *time.Time) (*domain.Community, error) {
+	community, err := s.GetCommunityByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name != nil {
+		if *name == "" {
+			return nil, fmt.Errorf("%w: community name must not be empty", domain.ErrInvalidArgument)
+		}
+		community.Name = *name
+	}
+	if startDateTime != nil {
+		community.StartedAt = *startDateTime
+	}
+	if endDateTime != nil {
+		community.EndedAt = *endDateTime
+	}
+	if community.EndedAt.Before(community.StartedAt) {
+		return nil, fmt.Errorf("%w: endDateTime must not be before startDateTime", domain.ErrInvalidArgument)
+	}
+
+	if err := s.communityRepo.Update(ctx, community); err != nil {
+		return nil, fmt.Errorf("failed to update community: %w", err)
+	}
+
+	return community, nil
+}
+
+// AuthorizeMember は指定したユーザーがコミュニティでrequired以上の役割を持っているかを確認する
+// 権限がない、またはコミュニティに参加していない場合は domain.ErrForbidden を返す
+func (s *CommunityService) AuthorizeMember(ctx context.Context, communityID string, githubID string, required domain.CommunityRole) error {
+	// コミュニティが存在しない場合は403ではなく404を返す
+	if _, err := s.GetCommunityByID(ctx, communityID); err != nil {
+		return err
+	}
+
+	role, err := s.findMemberRole(ctx, communityID, githubID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("%w: not a member of community: id=%s", domain.ErrForbidden, communityID)
+		}
+		return err
+	}
+
+	if !role.HasPermissionOf(required) {
+		return fmt.Errorf("%w: %s role is required: id=%s", domain.ErrForbidden, required, communityID)
+	}
+
+	return nil
+}
+
+// TransferOwnership はコミュニティのオーナー権限を別のメンバーに移譲する
+// 元のオーナーは管理者になる
+func (s *CommunityService) TransferOwnership(ctx context.Context, communityID string, currentOwnerGithubID string, newOwnerGithubID string) (*domain.Community, error) {
+	if currentOwnerGithubID == newOwnerGithubID {
+		return nil, fmt.Errorf("%w: already the owner of community: id=%s", domain.ErrInvalidArgument, communityID)
+	}
+
+	currentOwnerCard, err := s.findCardByGitHubID(ctx, currentOwnerGithubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get owner card: %w", err)
+	}
+
+	newOwnerCard, err := s.findCardByGitHubID(ctx, newOwnerGithubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get new owner card: %w", err)
+	}
+
+	if _, err := s.communityRepo.FindMemberRole(ctx, communityID, newOwnerCard.ID.String()); err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("%w: new owner is not a member of community: id=%s", domain.ErrInvalidArgument, communityID)
+		}
+		return nil, fmt.Errorf("failed to get member role: %w", err)
+	}
+
+	if err := s.communityRepo.TransferOwnership(ctx, communityID, currentOwnerCard.ID.String(), newOwnerCard.ID.String()); err != nil {
+		return nil, fmt.Errorf("failed to transfer ownership: %w", err)
+	}
+
+	return s.GetCommunityByID(ctx, communityID)
+}
+
+// UpdateMemberRole はコミュニティのメンバーを管理者に昇格、または一般メンバーに降格する
+// オーナーの役割はこのメソッドでは変更できない（TransferOwnershipを使う）
+func (s *CommunityService) UpdateMemberRole(ctx context.Context, communityID string, targetGithubID string, role domain.CommunityRole) (*domain.CommunityMember, error) {
+	if role != domain.CommunityRoleAdmin && role != domain.CommunityRoleMember {
+		return nil, fmt.Errorf("%w: role must be admin or member: %s", domain.ErrInvalidArgument, role)
+	}
+
+	card, err := s.findCardByGitHubID(ctx, targetGithubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member card: %w", err)
+	}
+
+	currentRole, err := s.communityRepo.FindMemberRole(ctx, communityID, card.ID.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get member role: %w", err)
+	}
+	if currentRole == domain.CommunityRoleOwner {
+		return nil, fmt.Errorf("%w: cannot change the owner's role, transfer ownership instead", domain.ErrInvalidArgument)
+	}
+
+	if err := s.communityRepo.UpdateMemberRole(ctx, communityID, card.ID.String(), role); err != nil {
+		return nil, fmt.Errorf("failed to update member role: %w", err)
+	}
+
+	return &domain.CommunityMember{Card: *card, Role: role}, nil
+}
+
+// 論理削除したコミュニティを復元できる期間
+const communityRestorePeriod = 7 * 24 * time.Hour
+
+// 招待コードの有効期限の既定値と上限
+const (
+	defaultInviteExpiration = 24 * time.Hour
+	maxInviteExpiration     = 30 * 24 * time.Hour
+)
+
+// 招待コードが衝突した場合に作り直す回数
+const maxInviteCodeAttempts = 3
+
+// CreateInvite はコミュニティの招待コードを作成する
+// expiresIn が0の場合は既定の有効期限を使う
+func (s *CommunityService) CreateInvite(ctx context.Context, communityID string, createdByGithubID string, expiresIn time.Duration, singleUse bool) (*domain.CommunityInvite, error) {
+	if expiresIn == 0 {
+		expiresIn = defaultInviteExpiration
+	}
+	if expiresIn < 0 || expiresIn > maxInviteExpiration {
+		return nil, fmt.Errorf("%w: expiration must be between 0 and %s", domain.ErrInvalidArgument, maxInviteExpiration)
+	}
+
+	community, err := s.GetCommunityByID(ctx, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		invite, err := domain.NewCommunityInvite(community.ID, createdByGithubID, time.Now().Add(expiresIn), singleUse)
+		if err != nil {
+			return nil, err
+		}
+
+		err = s.communityRepo.CreateInvite(ctx, invite)
+		if err == nil {
+			return invite, nil
+		}
+		if !errors.Is(err, domain.ErrAlreadyExists) || attempt+1 >= maxInviteCodeAttempts {
+			return nil, fmt.Errorf("failed to create invite: %w", err)
+		}
+	}
+}
+
+// GetActiveInvites はコミュニティの使用可能な招待コードを取得する
+func (s *CommunityService) GetActiveInvites(ctx context.Context, communityID string) ([]domain.CommunityInvite, error) {
+	invites, err := s.communityRepo.FindActiveInvites(ctx, communityID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invites: %w", err)
+	}
+
+	return invites, nil
+}
+
+// RevokeInvite はコミュニティの招待コードを取り消す
+func (s *CommunityService) RevokeInvite(ctx context.Context, communityID string, code string) (*domain.CommunityInvite, error) {
+	code = normalizeInviteCode(code)
+
+	now := time.Now()
+	if err := s.communityRepo.RevokeInvite(ctx, communityID, code, now); err != nil {
+		return nil, fmt.Errorf("failed to revoke invite: %w", err)
+	}
+
+	invite, err := s.communityRepo.FindInviteByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invite: %w", err)
+	}
+	if invite == nil {
+		return nil, fmt.Errorf("invite not found: %w", domain.ErrNotFound)
+	}
+
+	return invite, nil
+}
+
+// JoinByInvite は招待コードを使ってカードをコミュニティに参加させる
+// 既に参加している場合は招待コードを消費せずに domain.ErrAlreadyExists を返す
+func (s *CommunityService) JoinByInvite(ctx context.Context, code string, cardID string) (*domain.Community, error) {
+	code = normalizeInviteCode(code)
+
+	invite, err := s.communityRepo.FindInviteByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invite: %w", err)
+	}
+	if invite == nil {
+		return nil, fmt.Errorf("invite not found: %w", domain.ErrNotFound)
+	}
+
+	now := time.Now()
+	if !invite.IsActive(now) {
+		return nil, fmt.Errorf("%w: invite code is expired, revoked or already used", domain.ErrInvalidArgument)
+	}
+
+	// 削除されたコミュニティの招待コードは使えない
+	communityID := uuid.UUID(invite.CommunityID).String()
+	if _, err := s.GetCommunityByID(ctx, communityID); err != nil {
+		return nil, err
+	}
+	if _, err := s.communityRepo.FindMemberRole(ctx, communityID, cardID); err == nil {
+		return nil, fmt.Errorf("card already in community: id=%s: %w", communityID, domain.ErrAlreadyExists)
+	} else if !errors.Is(err, domain.ErrNotFound) {
+		return nil, fmt.Errorf("failed to get member role: %w", err)
+	}
+
+	// 1回限りのコードが同時に使われた場合に備えて、使用回数の更新で使用可能かを再確認する
+	// 参加に失敗した場合はコードを消費しないように、使用回数の更新とメンバーの追加は1つのトランザクションで行う
+	if err := s.communityRepo.JoinByInvite(ctx, code, cardID, now); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrNotFound):
+			return nil, fmt.Errorf("%w: invite code is expired, revoked or already used", domain.ErrInvalidArgument)
+		case errors.Is(err, domain.ErrAlreadyExists):
+			return nil, fmt.Errorf("card already in community: id=%s: %w", communityID, err)
+		}
+		return nil, fmt.Errorf("failed to join community by invite: %w", err)
+	}
+
+	return s.GetCommunityByID(ctx, communityID)
+}
+
+// normalizeInviteCode は入力された招待コードを比較できる形に揃える
+func normalizeInviteCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// findMemberRole はGitHub IDのユーザーのコミュニティ内での役割を取得する
+func (s *CommunityService) findMemberRole(ctx context.Context, communityID string, githubID string) (domain.CommunityRole, error) {
+	card, err := s.findCardByGitHubID(ctx, githubID)
+	if err != nil {
+		return "", err
+	}
+
+	role, err := s.communityRepo.FindMemberRole(ctx, communityID, card.ID.String())
+	if err != nil {
+		return "", fmt.Errorf("failed to get member role: %w", err)
+	}
+
+	return role, nil
+}
+
+// findCardByGitHubID はGitHub IDのカードを取得する
+func (s *CommunityService) findCardByGitHubID(ctx context.Context, githubID string) (*domain.Card, error) {
+	card, err := s.cardRepo.FindByGitHubID(ctx, githubID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get card: %w", err)
+	}
+	if card == nil {
+		return nil, fmt.Errorf("card not found: githubID=%s: %w", githubID, domain.ErrNotFound)
+	}
+
+	return card, nil
+}
+
 // DeleteCommunity はコミュニティを削除する
-func (s *CommunityService) DeleteCommunity(ctx context.Context, id string) error {
+// permanent が false の場合は論理削除し、communityRestorePeriod の間は RestoreCommunity で復元できる
+// permanent が true の場合はメンバーや招待コードも含めて物理削除する
+func (s *CommunityService) DeleteCommunity(ctx context.Context, id string, permanent bool) error {
+	if permanent {
+		if err := s.communityRepo.Purge(ctx, id); err != nil {
+			return fmt.Errorf("failed to purge community: %w", err)
+		}
+		return nil
+	}
+
 	if err := s.communityRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete community: %w", err)
 	}
@@ -306,9 +649,77 @@ func (s *CommunityService) DeleteCommunity(ctx context.Context, id string) error
 	return nil
 }
 
+// RestoreCommunity は論理削除されたコミュニティを復元する
+// 削除時のオーナーのみ復元でき、削除から communityRestorePeriod を過ぎたコミュニティは復元できない
+func (s *CommunityService) RestoreCommunity(ctx context.Context, id string, githubID string) (*domain.Community, error) {
+	community, err := s.communityRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get deleted community: %w", err)
+	}
+	if community == nil {
+		return nil, fmt.Errorf("deleted community not found: id=%s: %w", id, domain.ErrNotFound)
+	}
+
+	if community.OwnerGithubID != githubID {
+		return nil, fmt.Errorf("%w: only the owner can restore the community: id=%s", domain.ErrForbidden, id)
+	}
+
+	restorableAfter := time.Now().Add(-communityRestorePeriod)
+	if community.DeletedAt != nil && !community.DeletedAt.After(restorableAfter) {
+		return nil, fmt.Errorf("restore period has expired: id=%s: %w", id, domain.ErrNotFound)
+	}
+
+	if err := s.communityRepo.Restore(ctx, id, restorableAfter); err != nil {
+		return nil, fmt.Errorf("failed to restore community: %w", err)
+	}
+
+	return s.GetCommunityByID(ctx, id)
+}
+
+// RefreshActiveCommunities は期間中のコミュニティのうち、HighlightedCardを refreshedBefore より前に計算した、
+// または一度も計算していないものを古い順に limit 件計算し直し、計算し直した件数を返す
+// バックグラウンドのジョブから呼び出す。一部のコミュニティで失敗しても残りのコミュニティは計算し直す
+func (s *CommunityService) RefreshActiveCommunities(ctx context.Context, now time.Time, refreshedBefore time.Time, limit int, githubClient GitHubClient) (int, error) {
+	communities, err := s.communityRepo.FindActive(ctx, now, refreshedBefore, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active communities: %w", err)
+	}
+
+	refreshed := 0
+	var errs []error
+	for _, community := range communities {
+		id := community.ID.String()
+		if _, _, err := s.RefreshHighlightedCard(ctx, id, githubClient); err != nil {
+			errs = append(errs, fmt.Errorf("community %s: %w", id, err))
+			continue
+		}
+		refreshed++
+	}
+
+	if len(errs) > 0 {
+		return refreshed, fmt.Errorf("failed to refresh %d of %d communities: %w", len(errs), len(communities), errors.Join(errs...))
+	}
+
+	return refreshed, nil
+}
+
+// PurgeExpiredCommunities は復元期間を過ぎた論理削除済みのコミュニティを物理削除し、削除した件数を返す
+func (s *CommunityService) PurgeExpiredCommunities(ctx context.Context) (int, error) {
+	purged, err := s.communityRepo.PurgeDeletedBefore(ctx, time.Now().Add(-communityRestorePeriod))
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted communities: %w", err)
+	}
+
+	return purged, nil
+}
+
 // AddCardToCommunity はコミュニティにカードを追加する
+// 既にコミュニティに参加している場合は domain.ErrAlreadyExists を返す
 func (s *CommunityService) AddCardToCommunity(ctx context.Context, communityID string, cardID string) error {
 	if err := s.communityRepo.AddCard(ctx, communityID, cardID); err != nil {
+		if errors.Is(err, domain.ErrAlreadyExists) {
+			return fmt.Errorf("card already in community: id=%s: %w", communityID, err)
+		}
 		return fmt.Errorf("failed to add card to community: %w", err)
 	}
 
@@ -316,7 +727,16 @@ func (s *CommunityService) AddCardToCommunity(ctx context.Context, communityID s
 }
 
 // RemoveCardFromCommunity はコミュニティからカードを削除する
+// オーナーはオーナー権限を移譲するまでコミュニティから抜けられない
 func (s *CommunityService) RemoveCardFromCommunity(ctx context.Context, communityID string, cardID string) error {
+	role, err := s.communityRepo.FindMemberRole(ctx, communityID, cardID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return fmt.Errorf("failed to get member role: %w", err)
+	}
+	if role == domain.CommunityRoleOwner {
+		return fmt.Errorf("%w: the owner cannot leave the community, transfer ownership first", domain.ErrForbidden)
+	}
+
 	if err := s.communityRepo.RemoveCard(ctx, communityID, cardID); err != nil {
 		return fmt.Errorf("failed to remove card from community: %w", err)
 	}
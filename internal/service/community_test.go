@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -22,8 +23,8 @@ func createTestCommunity(name string) *domain.Community {
 	}
 }
 
-// GetAllCommunities はすべてのコミュニティを取得する
-func TestGetAllCommunities(t *testing.T) {
+// ListCommunities は参加しているコミュニティをページ単位で取得する
+func TestListCommunities(t *testing.T) {
 	tests := []struct {
 		name       string
 		githubID   string
@@ -37,11 +38,14 @@ func TestGetAllCommunities(t *testing.T) {
 			githubID: "12345",
 			setupRepo: func() *repository.MockCommunityRepository {
 				return &repository.MockCommunityRepository{
-					FindAllFunc: func(ctx context.Context, githubID string) ([]domain.Community, error) {
-						return []domain.Community{
+					FindPageFunc: func(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error) {
+						if page.SortKey != domain.SortKeyCreatedAt || page.Order != domain.SortOrderDesc {
+							return nil, fmt.Errorf("unexpected page request: %+v", page)
+						}
+						return &domain.CommunityPage{Communities: []domain.Community{
 							*createTestCommunity("Community 1"),
 							*createTestCommunity("Community 2"),
-						}, nil
+						}}, nil
 					},
 				}
 			},
@@ -53,8 +57,8 @@ func TestGetAllCommunities(t *testing.T) {
 			githubID: "12345",
 			setupRepo: func() *repository.MockCommunityRepository {
 				return &repository.MockCommunityRepository{
-					FindAllFunc: func(ctx context.Context, githubID string) ([]domain.Community, error) {
-						return []domain.Community{}, nil
+					FindPageFunc: func(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error) {
+						return &domain.CommunityPage{Communities: []domain.Community{}}, nil
 					},
 				}
 			},
@@ -66,13 +70,13 @@ func TestGetAllCommunities(t *testing.T) {
 			githubID: "12345",
 			setupRepo: func() *repository.MockCommunityRepository {
 				return &repository.MockCommunityRepository{
-					FindAllFunc: func(ctx context.Context, githubID string) ([]domain.Community, error) {
+					FindPageFunc: func(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error) {
 						return nil, fmt.Errorf("database error")
 					},
 				}
 			},
 			wantErr:    true,
-			wantErrMsg: "failed to get all communities",
+			wantErrMsg: "failed to list communities",
 		},
 	}
 
@@ -81,8 +85,8 @@ func TestGetAllCommunities(t *testing.T) {
 			ctx := context.Background()
 			communityRepo := tt.setupRepo()
 			cardRepo := &repository.MockCardRepository{}
-			service := NewCommunityService(communityRepo, cardRepo)
-			communities, err := service.GetAllCommunities(ctx, tt.githubID)
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
+			page, err := service.ListCommunities(ctx, tt.githubID, domain.PageRequest{})
 
 			if tt.wantErr {
 				if err == nil {
@@ -97,8 +101,8 @@ func TestGetAllCommunities(t *testing.T) {
 					t.Errorf("予期しないエラーが発生しました: %v", err)
 					return
 				}
-				if len(communities) != tt.wantCount {
-					t.Errorf("コミュニティ数が期待と異なります: 期待=%d, 実際=%d", tt.wantCount, len(communities))
+				if len(page.Communities) != tt.wantCount {
+					t.Errorf("コミュニティ数が期待と異なります: 期待=%d, 実際=%d", tt.wantCount, len(page.Communities))
 				}
 			}
 		})
@@ -159,7 +163,7 @@ func TestGetCommunityByID(t *testing.T) {
 			ctx := context.Background()
 			communityRepo := tt.setupRepo()
 			cardRepo := &repository.MockCardRepository{}
-			service := NewCommunityService(communityRepo, cardRepo)
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
 			community, err := service.GetCommunityByID(ctx, tt.communityID)
 
 			if tt.wantErr {
@@ -242,7 +246,7 @@ func TestGetCommunityWithHighlightedCard(t *testing.T) {
 			ctx := context.Background()
 			communityRepo := tt.setupRepo()
 			cardRepo := &repository.MockCardRepository{}
-			service := NewCommunityService(communityRepo, cardRepo)
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
 			community, highlightedCard, err := service.GetCommunityWithHighlightedCard(ctx, tt.communityID)
 
 			if tt.wantErr {
@@ -271,8 +275,8 @@ func TestGetCommunityWithHighlightedCard(t *testing.T) {
 	}
 }
 
-// GetCommunityCards は指定したコミュニティIDのカード一覧を取得する
-func TestGetCommunityCards(t *testing.T) {
+// ListCommunityCards は指定したコミュニティIDのカード一覧をページ単位で取得する
+func TestListCommunityCards(t *testing.T) {
 	tests := []struct {
 		name        string
 		communityID string
@@ -286,7 +290,13 @@ func TestGetCommunityCards(t *testing.T) {
 			communityID: "test-community-id",
 			setupRepo: func() *repository.MockCommunityRepository {
 				return &repository.MockCommunityRepository{
-					FindCardsFunc: func(ctx context.Context, id string) ([]domain.Card, error) {
+					FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+						return createTestCommunity("Test Community"), nil
+					},
+					FindCardsPageFunc: func(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error) {
+						if page.SortKey != domain.SortKeyContribution || page.Order != domain.SortOrderDesc {
+							return nil, fmt.Errorf("unexpected page request: %+v", page)
+						}
 						card1 := createTestCard("12345")
 						card1.UserName = "user1"
 						card1.FullName = "User One"
@@ -295,7 +305,7 @@ func TestGetCommunityCards(t *testing.T) {
 						card2.UserName = "user2"
 						card2.FullName = "User Two"
 						card2.IconUrl = "https://example.com/user2.png"
-						return []domain.Card{*card1, *card2}, nil
+						return &domain.CardPage{Cards: []domain.Card{*card1, *card2}}, nil
 					},
 				}
 			},
@@ -307,8 +317,11 @@ func TestGetCommunityCards(t *testing.T) {
 			communityID: "test-community-id",
 			setupRepo: func() *repository.MockCommunityRepository {
 				return &repository.MockCommunityRepository{
-					FindCardsFunc: func(ctx context.Context, id string) ([]domain.Card, error) {
-						return []domain.Card{}, nil
+					FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+						return createTestCommunity("Test Community"), nil
+					},
+					FindCardsPageFunc: func(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error) {
+						return &domain.CardPage{Cards: []domain.Card{}}, nil
 					},
 				}
 			},
@@ -320,13 +333,29 @@ func TestGetCommunityCards(t *testing.T) {
 			communityID: "test-community-id",
 			setupRepo: func() *repository.MockCommunityRepository {
 				return &repository.MockCommunityRepository{
-					FindCardsFunc: func(ctx context.Context, id string) ([]domain.Card, error) {
+					FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+						return createTestCommunity("Test Community"), nil
+					},
+					FindCardsPageFunc: func(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error) {
 						return nil, fmt.Errorf("database error")
 					},
 				}
 			},
 			wantErr:    true,
-			wantErrMsg: "failed to get community cards",
+			wantErrMsg: "failed to list community cards",
+		},
+		{
+			name:        "コミュニティが存在しない場合",
+			communityID: "test-community-id",
+			setupRepo: func() *repository.MockCommunityRepository {
+				return &repository.MockCommunityRepository{
+					FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+						return nil, fmt.Errorf("record: %w", domain.ErrNotFound)
+					},
+				}
+			},
+			wantErr:    true,
+			wantErrMsg: "not found",
 		},
 	}
 
@@ -335,8 +364,8 @@ func TestGetCommunityCards(t *testing.T) {
 			ctx := context.Background()
 			communityRepo := tt.setupRepo()
 			cardRepo := &repository.MockCardRepository{}
-			service := NewCommunityService(communityRepo, cardRepo)
-			cards, err := service.GetCommunityCards(ctx, tt.communityID)
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
+			page, err := service.ListCommunityCards(ctx, tt.communityID, domain.PageRequest{})
 
 			if tt.wantErr {
 				if err == nil {
@@ -351,8 +380,8 @@ func TestGetCommunityCards(t *testing.T) {
 					t.Errorf("予期しないエラーが発生しました: %v", err)
 					return
 				}
-				if len(cards) != tt.wantCount {
-					t.Errorf("カード数が期待と異なります: 期待=%d, 実際=%d", tt.wantCount, len(cards))
+				if len(page.Cards) != tt.wantCount {
+					t.Errorf("カード数が期待と異なります: 期待=%d, 実際=%d", tt.wantCount, len(page.Cards))
 				}
 			}
 		})
@@ -381,7 +410,7 @@ func TestCreateCommunityWithPeriod(t *testing.T) {
 			endDateTime:   endDateTime,
 			setupRepo: func() *repository.MockCommunityRepository {
 				return &repository.MockCommunityRepository{
-					CreateFunc: func(ctx context.Context, community *domain.Community) error {
+					CreateFunc: func(ctx context.Context, community *domain.Community, ownerCardID string) error {
 						return nil
 					},
 				}
@@ -395,7 +424,7 @@ func TestCreateCommunityWithPeriod(t *testing.T) {
 			endDateTime:   endDateTime,
 			setupRepo: func() *repository.MockCommunityRepository {
 				return &repository.MockCommunityRepository{
-					CreateFunc: func(ctx context.Context, community *domain.Community) error {
+					CreateFunc: func(ctx context.Context, community *domain.Community, ownerCardID string) error {
 						return fmt.Errorf("database error")
 					},
 				}
@@ -410,8 +439,8 @@ func TestCreateCommunityWithPeriod(t *testing.T) {
 			ctx := context.Background()
 			communityRepo := tt.setupRepo()
 			cardRepo := &repository.MockCardRepository{}
-			service := NewCommunityService(communityRepo, cardRepo)
-			community, err := service.CreateCommunityWithPeriod(ctx, tt.communityName, tt.startDateTime, tt.endDateTime)
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
+			community, err := service.CreateCommunityWithPeriod(ctx, tt.communityName, "owner", tt.startDateTime, tt.endDateTime)
 
 			if tt.wantErr {
 				if err == nil {
@@ -443,18 +472,38 @@ func TestDeleteCommunity(t *testing.T) {
 	tests := []struct {
 		name        string
 		communityID string
+		permanent   bool
 		setupRepo   func() *repository.MockCommunityRepository
 		wantErr     bool
 		wantErrMsg  string
 	}{
 		{
-			name:        "正常にコミュニティを削除できる",
+			name:        "正常にコミュニティを論理削除できる",
 			communityID: "test-community-id",
 			setupRepo: func() *repository.MockCommunityRepository {
 				return &repository.MockCommunityRepository{
 					DeleteFunc: func(ctx context.Context, id string) error {
 						return nil
 					},
+					PurgeFunc: func(ctx context.Context, id string) error {
+						return fmt.Errorf("purge should not be called")
+					},
+				}
+			},
+			wantErr: false,
+		},
+		{
+			name:        "permanentの場合は物理削除する",
+			communityID: "test-community-id",
+			permanent:   true,
+			setupRepo: func() *repository.MockCommunityRepository {
+				return &repository.MockCommunityRepository{
+					DeleteFunc: func(ctx context.Context, id string) error {
+						return fmt.Errorf("delete should not be called")
+					},
+					PurgeFunc: func(ctx context.Context, id string) error {
+						return nil
+					},
 				}
 			},
 			wantErr: false,
@@ -479,8 +528,8 @@ func TestDeleteCommunity(t *testing.T) {
 			ctx := context.Background()
 			communityRepo := tt.setupRepo()
 			cardRepo := &repository.MockCardRepository{}
-			service := NewCommunityService(communityRepo, cardRepo)
-			err := service.DeleteCommunity(ctx, tt.communityID)
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
+			err := service.DeleteCommunity(ctx, tt.communityID, tt.permanent)
 
 			if tt.wantErr {
 				if err == nil {
@@ -537,6 +586,20 @@ func TestAddCardToCommunity(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "failed to add card to community",
 		},
+		{
+			name:        "既にコミュニティに参加している場合",
+			communityID: "test-community-id",
+			cardID:      "test-card-id",
+			setupRepo: func() *repository.MockCommunityRepository {
+				return &repository.MockCommunityRepository{
+					AddCardFunc: func(ctx context.Context, communityID string, cardID string) error {
+						return domain.ErrAlreadyExists
+					},
+				}
+			},
+			wantErr:    true,
+			wantErrMsg: "card already in community",
+		},
 	}
 
 	for _, tt := range tests {
@@ -544,7 +607,7 @@ func TestAddCardToCommunity(t *testing.T) {
 			ctx := context.Background()
 			communityRepo := tt.setupRepo()
 			cardRepo := &repository.MockCardRepository{}
-			service := NewCommunityService(communityRepo, cardRepo)
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
 			err := service.AddCardToCommunity(ctx, tt.communityID, tt.cardID)
 
 			if tt.wantErr {
@@ -609,7 +672,7 @@ func TestRemoveCardFromCommunity(t *testing.T) {
 			ctx := context.Background()
 			communityRepo := tt.setupRepo()
 			cardRepo := &repository.MockCardRepository{}
-			service := NewCommunityService(communityRepo, cardRepo)
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
 			err := service.RemoveCardFromCommunity(ctx, tt.communityID, tt.cardID)
 
 			if tt.wantErr {
@@ -1134,7 +1197,7 @@ func TestRefreshHighlightedCard(t *testing.T) {
 			communityRepo := tt.setupRepo()
 			cardRepo := tt.setupCardRepo()
 			githubClient := tt.setupGitHub()
-			service := NewCommunityService(communityRepo, cardRepo)
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
 
 			community, highlightedCard, err := service.RefreshHighlightedCard(ctx, tt.communityID, githubClient)
 
@@ -1166,3 +1229,620 @@ func TestRefreshHighlightedCard(t *testing.T) {
 		})
 	}
 }
+
+// RefreshHighlightedCard はメンバーごとにコミュニティの期間の日ごとの数と内訳を記録する
+func TestRefreshHighlightedCard_SaveSnapshot(t *testing.T) {
+	community := createTestCommunity("Test Community")
+	community.StartedAt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	community.EndedAt = time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	communityRepo := &repository.MockCommunityRepository{
+		FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+			return community, nil
+		},
+		FindCardsFunc: func(ctx context.Context, id string) ([]domain.Card, error) {
+			return []domain.Card{*createTestCard("12345")}, nil
+		},
+		FindByIDWithHighlightedCardFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+			return community, nil
+		},
+	}
+	githubClient := &github.MockClient{
+		GetUsersFullInfoByNodeIDsFunc: func(ctx context.Context, nodeIDs []string, from, to time.Time) ([]github.UserFullInfo, error) {
+			info := createTestUserFullInfo("user1", 8, 5, 1, 1, 1)
+			info.Contributions = []github.Contribution{
+				{Date: "2024-01-01", Count: 3},
+				{Date: "2024-01-02", Count: 5},
+			}
+			return []github.UserFullInfo{info}, nil
+		},
+	}
+
+	savedContributions := make(map[string][]domain.Contribution)
+	var savedDetails []domain.ContributionDetailSnapshot
+	snapshotRepo := &repository.MockContributionSnapshotRepository{
+		SaveContributionsFunc: func(ctx context.Context, githubID string, contributions []domain.Contribution, fetchedAt time.Time) error {
+			savedContributions[githubID] = contributions
+			return nil
+		},
+		SaveContributionDetailFunc: func(ctx context.Context, snapshot *domain.ContributionDetailSnapshot) error {
+			savedDetails = append(savedDetails, *snapshot)
+			return nil
+		},
+	}
+
+	service := NewCommunityService(communityRepo, &repository.MockCardRepository{}, snapshotRepo)
+	if _, _, err := service.RefreshHighlightedCard(context.Background(), "test-community-id", githubClient); err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+
+	if len(savedContributions["12345"]) != 2 {
+		t.Errorf("保存した日数が違う: 期待=2, 実際=%d", len(savedContributions["12345"]))
+	}
+	if len(savedDetails) != 1 {
+		t.Fatalf("保存した内訳の数が違う: 期待=1, 実際=%d", len(savedDetails))
+	}
+	detail := savedDetails[0]
+	if detail.GithubID != "12345" || !detail.From.Equal(community.StartedAt) || !detail.To.Equal(community.EndedAt) {
+		t.Errorf("内訳の期間が違う: %+v", detail)
+	}
+	if detail.TotalContribution != 8 || detail.ContributionDetail.CommitCount != 5 {
+		t.Errorf("内訳が違う: %+v", detail)
+	}
+}
+
+// RefreshHighlightedCard は記録の保存に失敗してもコミュニティの更新を続ける
+func TestRefreshHighlightedCard_SaveSnapshotError(t *testing.T) {
+	community := createTestCommunity("Test Community")
+	highlightedCardSaved := false
+	communityRepo := &repository.MockCommunityRepository{
+		FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+			return community, nil
+		},
+		FindCardsFunc: func(ctx context.Context, id string) ([]domain.Card, error) {
+			return []domain.Card{*createTestCard("12345"), *createTestCard("67890")}, nil
+		},
+		UpdateHighlightedCardFunc: func(ctx context.Context, communityID string, highlightedCard *domain.HighlightedCard) error {
+			highlightedCardSaved = true
+			return nil
+		},
+		FindByIDWithHighlightedCardFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+			return community, nil
+		},
+	}
+	githubClient := &github.MockClient{
+		GetUsersFullInfoByNodeIDsFunc: func(ctx context.Context, nodeIDs []string, from, to time.Time) ([]github.UserFullInfo, error) {
+			return []github.UserFullInfo{
+				createTestUserFullInfo("user1", 8, 5, 1, 1, 1),
+				createTestUserFullInfo("user2", 4, 2, 1, 1, 0),
+			}, nil
+		},
+	}
+
+	var savedGithubIDs []string
+	snapshotRepo := &repository.MockContributionSnapshotRepository{
+		SaveContributionsFunc: func(ctx context.Context, githubID string, contributions []domain.Contribution, fetchedAt time.Time) error {
+			savedGithubIDs = append(savedGithubIDs, githubID)
+			return fmt.Errorf("database error")
+		},
+	}
+
+	service := NewCommunityService(communityRepo, &repository.MockCardRepository{}, snapshotRepo)
+	if _, _, err := service.RefreshHighlightedCard(context.Background(), "test-community-id", githubClient); err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+
+	if !highlightedCardSaved {
+		t.Errorf("HighlightedCardが保存されていません")
+	}
+	// 1人目の記録に失敗しても残りのメンバーの記録を続ける
+	if len(savedGithubIDs) != 2 {
+		t.Errorf("記録を試みた人数が違う: 期待=2, 実際=%d", len(savedGithubIDs))
+	}
+}
+
+// AuthorizeMember はメンバーの役割が必要な権限を満たしているか確認する
+func TestAuthorizeMember(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      domain.CommunityRole
+		roleErr   error
+		required  domain.CommunityRole
+		community *domain.Community
+		wantErrIs error
+	}{
+		{
+			name:      "オーナーは管理者権限の操作ができる",
+			role:      domain.CommunityRoleOwner,
+			required:  domain.CommunityRoleAdmin,
+			community: createTestCommunity("Test Community"),
+		},
+		{
+			name:      "管理者は管理者権限の操作ができる",
+			role:      domain.CommunityRoleAdmin,
+			required:  domain.CommunityRoleAdmin,
+			community: createTestCommunity("Test Community"),
+		},
+		{
+			name:      "管理者はオーナー権限の操作ができない",
+			role:      domain.CommunityRoleAdmin,
+			required:  domain.CommunityRoleOwner,
+			community: createTestCommunity("Test Community"),
+			wantErrIs: domain.ErrForbidden,
+		},
+		{
+			name:      "一般メンバーは管理者権限の操作ができない",
+			role:      domain.CommunityRoleMember,
+			required:  domain.CommunityRoleAdmin,
+			community: createTestCommunity("Test Community"),
+			wantErrIs: domain.ErrForbidden,
+		},
+		{
+			name:      "メンバーでない場合は操作できない",
+			roleErr:   domain.ErrNotFound,
+			required:  domain.CommunityRoleMember,
+			community: createTestCommunity("Test Community"),
+			wantErrIs: domain.ErrForbidden,
+		},
+		{
+			name:      "コミュニティが存在しない場合はNotFound",
+			role:      domain.CommunityRoleOwner,
+			required:  domain.CommunityRoleOwner,
+			community: nil,
+			wantErrIs: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			communityRepo := &repository.MockCommunityRepository{
+				FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+					return tt.community, nil
+				},
+				FindMemberRoleFunc: func(ctx context.Context, communityID string, cardID string) (domain.CommunityRole, error) {
+					return tt.role, tt.roleErr
+				},
+			}
+			cardRepo := &repository.MockCardRepository{}
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
+			err := service.AuthorizeMember(ctx, "test-community-id", "12345", tt.required)
+
+			if tt.wantErrIs == nil {
+				if err != nil {
+					t.Errorf("予期しないエラーが発生しました: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErrIs) {
+				t.Errorf("エラーの種類が期待と異なります: 期待=%v, 実際=%v", tt.wantErrIs, err)
+			}
+		})
+	}
+}
+
+// TransferOwnership はコミュニティのオーナー権限を移譲する
+func TestTransferOwnership(t *testing.T) {
+	tests := []struct {
+		name             string
+		newOwnerGithubID string
+		newOwnerRoleErr  error
+		wantErrIs        error
+		wantTransferred  bool
+	}{
+		{
+			name:             "正常にオーナー権限を移譲できる",
+			newOwnerGithubID: "67890",
+			wantTransferred:  true,
+		},
+		{
+			name:             "自分自身には移譲できない",
+			newOwnerGithubID: "12345",
+			wantErrIs:        domain.ErrInvalidArgument,
+		},
+		{
+			name:             "メンバーでないユーザーには移譲できない",
+			newOwnerGithubID: "67890",
+			newOwnerRoleErr:  domain.ErrNotFound,
+			wantErrIs:        domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			transferred := false
+			communityRepo := &repository.MockCommunityRepository{
+				FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+					return createTestCommunity("Test Community"), nil
+				},
+				FindMemberRoleFunc: func(ctx context.Context, communityID string, cardID string) (domain.CommunityRole, error) {
+					return domain.CommunityRoleMember, tt.newOwnerRoleErr
+				},
+				TransferOwnershipFunc: func(ctx context.Context, communityID string, fromCardID string, toCardID string) error {
+					transferred = true
+					return nil
+				},
+			}
+			cardRepo := &repository.MockCardRepository{
+				FindByGitHubIDFunc: func(ctx context.Context, githubID string) (*domain.Card, error) {
+					return &domain.Card{ID: domain.NewCardID(), GithubID: githubID}, nil
+				},
+			}
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
+			_, err := service.TransferOwnership(ctx, "test-community-id", "12345", tt.newOwnerGithubID)
+
+			if tt.wantErrIs != nil {
+				if !errors.Is(err, tt.wantErrIs) {
+					t.Errorf("エラーの種類が期待と異なります: 期待=%v, 実際=%v", tt.wantErrIs, err)
+				}
+			} else if err != nil {
+				t.Errorf("予期しないエラーが発生しました: %v", err)
+			}
+			if transferred != tt.wantTransferred {
+				t.Errorf("移譲の実行が期待と異なります: 期待=%v, 実際=%v", tt.wantTransferred, transferred)
+			}
+		})
+	}
+}
+
+// UpdateMemberRole はメンバーの役割を変更する
+func TestUpdateMemberRole(t *testing.T) {
+	tests := []struct {
+		name        string
+		currentRole domain.CommunityRole
+		role        domain.CommunityRole
+		wantErrIs   error
+	}{
+		{
+			name:        "一般メンバーを管理者に昇格できる",
+			currentRole: domain.CommunityRoleMember,
+			role:        domain.CommunityRoleAdmin,
+		},
+		{
+			name:        "管理者を一般メンバーに降格できる",
+			currentRole: domain.CommunityRoleAdmin,
+			role:        domain.CommunityRoleMember,
+		},
+		{
+			name:        "オーナーの役割は変更できない",
+			currentRole: domain.CommunityRoleOwner,
+			role:        domain.CommunityRoleMember,
+			wantErrIs:   domain.ErrInvalidArgument,
+		},
+		{
+			name:        "オーナーを指定することはできない",
+			currentRole: domain.CommunityRoleMember,
+			role:        domain.CommunityRoleOwner,
+			wantErrIs:   domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			communityRepo := &repository.MockCommunityRepository{
+				FindMemberRoleFunc: func(ctx context.Context, communityID string, cardID string) (domain.CommunityRole, error) {
+					return tt.currentRole, nil
+				},
+			}
+			cardRepo := &repository.MockCardRepository{}
+			service := NewCommunityService(communityRepo, cardRepo, repository.NewMockContributionSnapshotRepository())
+			member, err := service.UpdateMemberRole(ctx, "test-community-id", "67890", tt.role)
+
+			if tt.wantErrIs != nil {
+				if !errors.Is(err, tt.wantErrIs) {
+					t.Errorf("エラーの種類が期待と異なります: 期待=%v, 実際=%v", tt.wantErrIs, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("予期しないエラーが発生しました: %v", err)
+				return
+			}
+			if member.Role != tt.role {
+				t.Errorf("役割が期待と異なります: 期待=%s, 実際=%s", tt.role, member.Role)
+			}
+		})
+	}
+}
+
+// CreateInvite はコミュニティの招待コードを作成する
+func TestCreateInvite(t *testing.T) {
+	tests := []struct {
+		name          string
+		expiresIn     time.Duration
+		createErrs    []error
+		wantErrIs     error
+		wantExpiresIn time.Duration
+	}{
+		{
+			name:          "有効期限を省略した場合は既定値になる",
+			expiresIn:     0,
+			wantExpiresIn: defaultInviteExpiration,
+		},
+		{
+			name:          "指定した有効期限で作成できる",
+			expiresIn:     time.Hour,
+			wantExpiresIn: time.Hour,
+		},
+		{
+			name:      "有効期限が上限を超える場合",
+			expiresIn: maxInviteExpiration + time.Minute,
+			wantErrIs: domain.ErrInvalidArgument,
+		},
+		{
+			name:          "コードが衝突した場合は作り直す",
+			expiresIn:     time.Hour,
+			createErrs:    []error{domain.ErrAlreadyExists},
+			wantExpiresIn: time.Hour,
+		},
+		{
+			name:       "コードの衝突が続いた場合",
+			expiresIn:  time.Hour,
+			createErrs: []error{domain.ErrAlreadyExists, domain.ErrAlreadyExists, domain.ErrAlreadyExists},
+			wantErrIs:  domain.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			calls := 0
+			communityRepo := &repository.MockCommunityRepository{
+				FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+					return createTestCommunity("Test Community"), nil
+				},
+				CreateInviteFunc: func(ctx context.Context, invite *domain.CommunityInvite) error {
+					defer func() { calls++ }()
+					if calls < len(tt.createErrs) {
+						return tt.createErrs[calls]
+					}
+					return nil
+				},
+			}
+			service := NewCommunityService(communityRepo, &repository.MockCardRepository{}, repository.NewMockContributionSnapshotRepository())
+			before := time.Now()
+			invite, err := service.CreateInvite(ctx, "test-community-id", "12345", tt.expiresIn, true)
+
+			if tt.wantErrIs != nil {
+				if !errors.Is(err, tt.wantErrIs) {
+					t.Errorf("エラーの種類が期待と異なります: 期待=%v, 実際=%v", tt.wantErrIs, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("予期しないエラーが発生しました: %v", err)
+				return
+			}
+			if len(invite.Code) != 8 {
+				t.Errorf("招待コードの長さが期待と異なります: %s", invite.Code)
+			}
+			if !invite.SingleUse {
+				t.Errorf("1回限りのコードになっていません")
+			}
+			if invite.ExpiresAt.Before(before.Add(tt.wantExpiresIn)) || invite.ExpiresAt.After(time.Now().Add(tt.wantExpiresIn)) {
+				t.Errorf("有効期限が期待と異なります: %s", invite.ExpiresAt)
+			}
+		})
+	}
+}
+
+// JoinByInvite は招待コードを使ってコミュニティに参加する
+func TestJoinByInvite(t *testing.T) {
+	now := time.Now()
+	revokedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		name      string
+		invite    *domain.CommunityInvite
+		isMember  bool
+		joinErr   error
+		wantErrIs error
+		wantAdded bool
+	}{
+		{
+			name:      "正常に参加できる",
+			invite:    &domain.CommunityInvite{Code: "ABCD2345", ExpiresAt: now.Add(time.Hour)},
+			wantAdded: true,
+		},
+		{
+			name:      "招待コードが存在しない場合",
+			invite:    nil,
+			wantErrIs: domain.ErrNotFound,
+		},
+		{
+			name:      "期限切れの場合",
+			invite:    &domain.CommunityInvite{Code: "ABCD2345", ExpiresAt: now.Add(-time.Hour)},
+			wantErrIs: domain.ErrInvalidArgument,
+		},
+		{
+			name:      "取り消し済みの場合",
+			invite:    &domain.CommunityInvite{Code: "ABCD2345", ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt},
+			wantErrIs: domain.ErrInvalidArgument,
+		},
+		{
+			name:      "使用済みの1回限りのコードの場合",
+			invite:    &domain.CommunityInvite{Code: "ABCD2345", ExpiresAt: now.Add(time.Hour), SingleUse: true, UsedCount: 1},
+			wantErrIs: domain.ErrInvalidArgument,
+		},
+		{
+			name:      "既に参加している場合",
+			invite:    &domain.CommunityInvite{Code: "ABCD2345", ExpiresAt: now.Add(time.Hour)},
+			isMember:  true,
+			wantErrIs: domain.ErrAlreadyExists,
+		},
+		{
+			name:      "1回限りのコードが同時に使われた場合",
+			invite:    &domain.CommunityInvite{Code: "ABCD2345", ExpiresAt: now.Add(time.Hour), SingleUse: true},
+			joinErr:   fmt.Errorf("active invite not found: %w", domain.ErrNotFound),
+			wantErrIs: domain.ErrInvalidArgument,
+		},
+		{
+			name:      "同時に参加してメンバーの追加が重複した場合",
+			invite:    &domain.CommunityInvite{Code: "ABCD2345", ExpiresAt: now.Add(time.Hour), SingleUse: true},
+			joinErr:   domain.ErrAlreadyExists,
+			wantErrIs: domain.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			added := false
+			communityRepo := &repository.MockCommunityRepository{
+				FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+					return createTestCommunity("Test Community"), nil
+				},
+				FindInviteByCodeFunc: func(ctx context.Context, code string) (*domain.CommunityInvite, error) {
+					if code != "ABCD2345" {
+						return nil, fmt.Errorf("unexpected code: %s", code)
+					}
+					return tt.invite, nil
+				},
+				FindMemberRoleFunc: func(ctx context.Context, communityID string, cardID string) (domain.CommunityRole, error) {
+					if tt.isMember {
+						return domain.CommunityRoleMember, nil
+					}
+					return "", domain.ErrNotFound
+				},
+				JoinByInviteFunc: func(ctx context.Context, code string, cardID string, now time.Time) error {
+					if tt.joinErr != nil {
+						return tt.joinErr
+					}
+					added = true
+					return nil
+				},
+			}
+			service := NewCommunityService(communityRepo, &repository.MockCardRepository{}, repository.NewMockContributionSnapshotRepository())
+			// 入力された招待コードは空白と大文字・小文字を揃えてから使う
+			_, err := service.JoinByInvite(ctx, " abcd2345 ", domain.NewCardID().String())
+
+			if tt.wantErrIs != nil {
+				if !errors.Is(err, tt.wantErrIs) {
+					t.Errorf("エラーの種類が期待と異なります: 期待=%v, 実際=%v", tt.wantErrIs, err)
+				}
+			} else if err != nil {
+				t.Errorf("予期しないエラーが発生しました: %v", err)
+			}
+			if added != tt.wantAdded {
+				t.Errorf("カードの追加が期待と異なります: 期待=%v, 実際=%v", tt.wantAdded, added)
+			}
+		})
+	}
+}
+
+// RestoreCommunity は論理削除されたコミュニティを復元する
+func TestRestoreCommunity(t *testing.T) {
+	now := time.Now()
+	recentlyDeletedAt := now.Add(-time.Hour)
+	expiredDeletedAt := now.Add(-communityRestorePeriod - time.Hour)
+
+	tests := []struct {
+		name         string
+		githubID     string
+		deleted      *domain.Community
+		wantErrIs    error
+		wantRestored bool
+	}{
+		{
+			name:         "オーナーは削除したコミュニティを復元できる",
+			githubID:     "owner",
+			deleted:      &domain.Community{ID: domain.NewCommunityID(), OwnerGithubID: "owner", DeletedAt: &recentlyDeletedAt},
+			wantRestored: true,
+		},
+		{
+			name:      "オーナー以外は復元できない",
+			githubID:  "member",
+			deleted:   &domain.Community{ID: domain.NewCommunityID(), OwnerGithubID: "owner", DeletedAt: &recentlyDeletedAt},
+			wantErrIs: domain.ErrForbidden,
+		},
+		{
+			name:      "復元期間を過ぎたコミュニティは復元できない",
+			githubID:  "owner",
+			deleted:   &domain.Community{ID: domain.NewCommunityID(), OwnerGithubID: "owner", DeletedAt: &expiredDeletedAt},
+			wantErrIs: domain.ErrNotFound,
+		},
+		{
+			name:      "削除されていないコミュニティの場合",
+			githubID:  "owner",
+			deleted:   nil,
+			wantErrIs: domain.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			restored := false
+			communityRepo := &repository.MockCommunityRepository{
+				FindDeletedByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+					return tt.deleted, nil
+				},
+				RestoreFunc: func(ctx context.Context, id string, deletedAfter time.Time) error {
+					restored = true
+					return nil
+				},
+				FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+					return createTestCommunity("Test Community"), nil
+				},
+			}
+			service := NewCommunityService(communityRepo, &repository.MockCardRepository{}, repository.NewMockContributionSnapshotRepository())
+			_, err := service.RestoreCommunity(ctx, "test-community-id", tt.githubID)
+
+			if tt.wantErrIs != nil {
+				if !errors.Is(err, tt.wantErrIs) {
+					t.Errorf("エラーの種類が期待と異なります: 期待=%v, 実際=%v", tt.wantErrIs, err)
+				}
+			} else if err != nil {
+				t.Errorf("予期しないエラーが発生しました: %v", err)
+			}
+			if restored != tt.wantRestored {
+				t.Errorf("復元の実行が期待と異なります: 期待=%v, 実際=%v", tt.wantRestored, restored)
+			}
+		})
+	}
+}
+
+// RefreshActiveCommunities は期間中のコミュニティのHighlightedCardを計算し直し、失敗したコミュニティがあっても残りは続ける
+func TestRefreshActiveCommunities(t *testing.T) {
+	ok := createTestCommunity("OK Community")
+	broken := createTestCommunity("Broken Community")
+	now := time.Now()
+
+	var gotLimit int
+	var updated []string
+	communityRepo := &repository.MockCommunityRepository{
+		FindActiveFunc: func(ctx context.Context, n time.Time, refreshedBefore time.Time, limit int) ([]domain.Community, error) {
+			gotLimit = limit
+			return []domain.Community{*broken, *ok}, nil
+		},
+		FindByIDFunc: func(ctx context.Context, id string) (*domain.Community, error) {
+			if id == broken.ID.String() {
+				return nil, fmt.Errorf("database error")
+			}
+			return ok, nil
+		},
+		FindCardsFunc: func(ctx context.Context, id string) ([]domain.Card, error) {
+			return []domain.Card{}, nil
+		},
+		UpdateHighlightedCardFunc: func(ctx context.Context, communityID string, highlightedCard *domain.HighlightedCard) error {
+			updated = append(updated, communityID)
+			return nil
+		},
+	}
+	service := NewCommunityService(communityRepo, &repository.MockCardRepository{}, repository.NewMockContributionSnapshotRepository())
+
+	refreshed, err := service.RefreshActiveCommunities(context.Background(), now, now.Add(-15*time.Minute), 10, &github.MockClient{})
+	if err == nil {
+		t.Errorf("エラーが期待されましたが、エラーが発生しませんでした")
+	}
+	if refreshed != 1 {
+		t.Errorf("計算し直した件数が期待と異なります: 期待=1, 実際=%d", refreshed)
+	}
+	if len(updated) != 1 || updated[0] != ok.ID.String() {
+		t.Errorf("保存したコミュニティが期待と異なります: %v", updated)
+	}
+	if gotLimit != 10 {
+		t.Errorf("limitが期待と異なります: 期待=10, 実際=%d", gotLimit)
+	}
+}
@@ -12,9 +12,13 @@ type GitHubClient interface {
 	GetAuthenticatedUser(ctx context.Context) (*github.UserInfo, error)
 	GetUserByID(ctx context.Context, id int64) (*github.UserInfo, error)
 	GetUsersByIDs(ctx context.Context, ids []int64) (map[int64]*github.UserInfo, error)
-	GetUserStats(ctx context.Context, githubID int64) (*github.UserStats, error)
-	GetMostUsedLanguage(ctx context.Context, login string) (string, string, error)
+	// GetUserStats はユーザーの from から to までのコントリビューション統計を取得する。ゼロ値の場合は過去1年間を集計する
+	GetUserStats(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error)
+	// GetMostUsedLanguage はユーザーの最も使用している言語と言語の内訳を取得する
+	GetMostUsedLanguage(ctx context.Context, login string) (github.LanguageInfo, error)
 	GetMostUsedLanguages(ctx context.Context, logins []string) (map[string]github.LanguageInfo, error)
+	// GetMyLanguages はトークンのユーザー自身の、プライベートリポジトリも含めた言語の内訳を取得する
+	GetMyLanguages(ctx context.Context) (github.LanguageInfo, error)
 	// GetUsersFullInfoByNodeIDs はNodeIDを使ってユーザーの全情報（基本情報、貢献データ、言語情報）を一括取得する
 	GetUsersFullInfoByNodeIDs(ctx context.Context, nodeIDs []string, from, to time.Time) ([]github.UserFullInfo, error)
 }
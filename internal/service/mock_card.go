@@ -2,30 +2,40 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 // MockCardService はテスト用のモックサービス
 type MockCardService struct {
-	GetAllCardsFunc        func(ctx context.Context, githubID string) ([]domain.Card, error)
-	GetCardByGitHubIDFunc  func(ctx context.Context, githubID string, githubClient GitHubClient) (*domain.Card, error)
-	GetMyCardFunc          func(ctx context.Context, githubID string, githubClient GitHubClient) (*domain.Card, error)
-	GetOrCreateMyCardFunc  func(ctx context.Context, githubID string, nodeID string, githubClient GitHubClient) (*domain.Card, error)
-	AddCardToDeckFunc      func(ctx context.Context, collectorGithubID string, targetGithubID string, githubClient GitHubClient) (*domain.Card, error)
-	RemoveCardFromDeckFunc func(ctx context.Context, collectorGithubID string, targetGithubID string, githubClient GitHubClient) (*domain.Card, error)
-	RefreshAllCardsFunc    func(ctx context.Context, githubClient GitHubClient) ([]domain.Card, error)
+	ListCardsFunc            func(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error)
+	GetCardByGitHubIDFunc    func(ctx context.Context, githubID string, githubClient GitHubClient) (*domain.Card, error)
+	GetIdenticonImageFunc    func(ctx context.Context, githubID string, format domain.ImageFormat, opts domain.ImageOptions) (*domain.Image, error)
+	GetCardImageFunc         func(ctx context.Context, githubID string, format domain.ImageFormat, totalContribution *int) (*domain.Image, error)
+	GetMyCardFunc            func(ctx context.Context, githubID string, githubClient GitHubClient) (*domain.Card, error)
+	GetOrCreateMyCardFunc    func(ctx context.Context, githubID string, nodeID string, githubClient GitHubClient) (*domain.Card, error)
+	AddCardToDeckFunc        func(ctx context.Context, collectorGithubID string, payload string, detail domain.CollectDetail, githubClient GitHubClient) (*domain.Card, error)
+	RemoveCardFromDeckFunc   func(ctx context.Context, collectorGithubID string, targetGithubID string, githubClient GitHubClient) (*domain.Card, error)
+	ShareMyCardFunc          func(ctx context.Context, githubID string) (*domain.CardShare, error)
+	GetMyCardSettingsFunc    func(ctx context.Context, githubID string) (*domain.CardSettings, error)
+	UpdateMyCardSettingsFunc func(ctx context.Context, githubID string, settings domain.CardSettings, githubClient GitHubClient) (*domain.Card, error)
+	RefreshAllCardsFunc      func(ctx context.Context, githubClient GitHubClient) ([]domain.Card, error)
+	CreateExchangeFunc       func(ctx context.Context, githubID string, expiresIn time.Duration) (*domain.CardExchange, error)
+	GetExchangeFunc          func(ctx context.Context, token string) (*domain.CardExchange, error)
+	ListExchangesFunc        func(ctx context.Context, githubID string) ([]domain.CardExchange, error)
+	AcceptExchangeFunc       func(ctx context.Context, token string, githubID string, detail domain.CollectDetail) (*domain.CardExchange, error)
 }
 
 func NewMockCardService() *MockCardService {
 	return &MockCardService{}
 }
 
-func (m *MockCardService) GetAllCards(ctx context.Context, githubID string) ([]domain.Card, error) {
-	if m.GetAllCardsFunc != nil {
-		return m.GetAllCardsFunc(ctx, githubID)
+func (m *MockCardService) ListCards(ctx context.Context, githubID string, filter domain.CardFilter, page domain.PageRequest) (*domain.CollectedCardPage, error) {
+	if m.ListCardsFunc != nil {
+		return m.ListCardsFunc(ctx, githubID, filter, page)
 	}
-	return []domain.Card{}, nil
+	return &domain.CollectedCardPage{CollectedCards: []domain.CollectedCard{}}, nil
 }
 
 func (m *MockCardService) GetCardByGitHubID(ctx context.Context, githubID string, githubClient GitHubClient) (*domain.Card, error) {
@@ -35,6 +45,20 @@ func (m *MockCardService) GetCardByGitHubID(ctx context.Context, githubID string
 	return &domain.Card{}, nil
 }
 
+func (m *MockCardService) GetIdenticonImage(ctx context.Context, githubID string, format domain.ImageFormat, opts domain.ImageOptions) (*domain.Image, error) {
+	if m.GetIdenticonImageFunc != nil {
+		return m.GetIdenticonImageFunc(ctx, githubID, format, opts)
+	}
+	return nil, nil
+}
+
+func (m *MockCardService) GetCardImage(ctx context.Context, githubID string, format domain.ImageFormat, totalContribution *int) (*domain.Image, error) {
+	if m.GetCardImageFunc != nil {
+		return m.GetCardImageFunc(ctx, githubID, format, totalContribution)
+	}
+	return nil, nil
+}
+
 func (m *MockCardService) GetMyCard(ctx context.Context, githubID string, githubClient GitHubClient) (*domain.Card, error) {
 	if m.GetMyCardFunc != nil {
 		return m.GetMyCardFunc(ctx, githubID, githubClient)
@@ -49,9 +73,30 @@ func (m *MockCardService) GetOrCreateMyCard(ctx context.Context, githubID string
 	return nil, nil
 }
 
-func (m *MockCardService) AddCardToDeck(ctx context.Context, collectorGithubID string, targetGithubID string, githubClient GitHubClient) (*domain.Card, error) {
+func (m *MockCardService) AddCardToDeck(ctx context.Context, collectorGithubID string, payload string, detail domain.CollectDetail, githubClient GitHubClient) (*domain.Card, error) {
 	if m.AddCardToDeckFunc != nil {
-		return m.AddCardToDeckFunc(ctx, collectorGithubID, targetGithubID, githubClient)
+		return m.AddCardToDeckFunc(ctx, collectorGithubID, payload, detail, githubClient)
+	}
+	return nil, nil
+}
+
+func (m *MockCardService) ShareMyCard(ctx context.Context, githubID string) (*domain.CardShare, error) {
+	if m.ShareMyCardFunc != nil {
+		return m.ShareMyCardFunc(ctx, githubID)
+	}
+	return nil, nil
+}
+
+func (m *MockCardService) GetMyCardSettings(ctx context.Context, githubID string) (*domain.CardSettings, error) {
+	if m.GetMyCardSettingsFunc != nil {
+		return m.GetMyCardSettingsFunc(ctx, githubID)
+	}
+	return nil, nil
+}
+
+func (m *MockCardService) UpdateMyCardSettings(ctx context.Context, githubID string, settings domain.CardSettings, githubClient GitHubClient) (*domain.Card, error) {
+	if m.UpdateMyCardSettingsFunc != nil {
+		return m.UpdateMyCardSettingsFunc(ctx, githubID, settings, githubClient)
 	}
 	return nil, nil
 }
@@ -69,3 +114,31 @@ func (m *MockCardService) RefreshAllCards(ctx context.Context, githubClient GitH
 	}
 	return []domain.Card{}, nil
 }
+
+func (m *MockCardService) CreateExchange(ctx context.Context, githubID string, expiresIn time.Duration) (*domain.CardExchange, error) {
+	if m.CreateExchangeFunc != nil {
+		return m.CreateExchangeFunc(ctx, githubID, expiresIn)
+	}
+	return nil, nil
+}
+
+func (m *MockCardService) GetExchange(ctx context.Context, token string) (*domain.CardExchange, error) {
+	if m.GetExchangeFunc != nil {
+		return m.GetExchangeFunc(ctx, token)
+	}
+	return nil, nil
+}
+
+func (m *MockCardService) ListExchanges(ctx context.Context, githubID string) ([]domain.CardExchange, error) {
+	if m.ListExchangesFunc != nil {
+		return m.ListExchangesFunc(ctx, githubID)
+	}
+	return []domain.CardExchange{}, nil
+}
+
+func (m *MockCardService) AcceptExchange(ctx context.Context, token string, githubID string, detail domain.CollectDetail) (*domain.CardExchange, error) {
+	if m.AcceptExchangeFunc != nil {
+		return m.AcceptExchangeFunc(ctx, token, githubID, detail)
+	}
+	return nil, nil
+}
@@ -9,13 +9,22 @@ import (
 
 // MockCommunityService はテスト用のモックサービス
 type MockCommunityService struct {
-	GetAllCommunitiesFunc               func(ctx context.Context, githubID string) ([]domain.Community, error)
+	ListCommunitiesFunc                 func(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error)
 	GetCommunityByIDFunc                func(ctx context.Context, id string) (*domain.Community, error)
 	GetCommunityWithHighlightedCardFunc func(ctx context.Context, id string) (*domain.Community, *domain.HighlightedCard, error)
 	RefreshHighlightedCardFunc          func(ctx context.Context, id string, githubClient GitHubClient) (*domain.Community, *domain.HighlightedCard, error)
-	GetCommunityCardsFunc               func(ctx context.Context, id string) ([]domain.Card, error)
-	CreateCommunityWithPeriodFunc       func(ctx context.Context, name string, startDateTime, endDateTime time.Time) (*domain.Community, error)
-	DeleteCommunityFunc                 func(ctx context.Context, id string) error
+	ListCommunityCardsFunc              func(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error)
+	CreateCommunityWithPeriodFunc       func(ctx context.Context, name string, ownerGithubID string, startDateTime, endDateTime time.Time) (*domain.Community, error)
+	UpdateCommunityFunc                 func(ctx context.Context, id string, name *string, startDateTime, endDateTime *time.Time) (*domain.Community, error)
+	DeleteCommunityFunc                 func(ctx context.Context, id string, permanent bool) error
+	RestoreCommunityFunc                func(ctx context.Context, id string, githubID string) (*domain.Community, error)
+	AuthorizeMemberFunc                 func(ctx context.Context, communityID string, githubID string, required domain.CommunityRole) error
+	TransferOwnershipFunc               func(ctx context.Context, communityID string, currentOwnerGithubID string, newOwnerGithubID string) (*domain.Community, error)
+	UpdateMemberRoleFunc                func(ctx context.Context, communityID string, targetGithubID string, role domain.CommunityRole) (*domain.CommunityMember, error)
+	CreateInviteFunc                    func(ctx context.Context, communityID string, createdByGithubID string, expiresIn time.Duration, singleUse bool) (*domain.CommunityInvite, error)
+	GetActiveInvitesFunc                func(ctx context.Context, communityID string) ([]domain.CommunityInvite, error)
+	RevokeInviteFunc                    func(ctx context.Context, communityID string, code string) (*domain.CommunityInvite, error)
+	JoinByInviteFunc                    func(ctx context.Context, code string, cardID string) (*domain.Community, error)
 	AddCardToCommunityFunc              func(ctx context.Context, communityID string, cardID string) error
 	RemoveCardFromCommunityFunc         func(ctx context.Context, communityID string, cardID string) error
 }
@@ -24,11 +33,11 @@ func NewMockCommunityService() *MockCommunityService {
 	return &MockCommunityService{}
 }
 
-func (m *MockCommunityService) GetAllCommunities(ctx context.Context, githubID string) ([]domain.Community, error) {
-	if m.GetAllCommunitiesFunc != nil {
-		return m.GetAllCommunitiesFunc(ctx, githubID)
+func (m *MockCommunityService) ListCommunities(ctx context.Context, githubID string, page domain.PageRequest) (*domain.CommunityPage, error) {
+	if m.ListCommunitiesFunc != nil {
+		return m.ListCommunitiesFunc(ctx, githubID, page)
 	}
-	return []domain.Community{}, nil
+	return &domain.CommunityPage{Communities: []domain.Community{}}, nil
 }
 
 func (m *MockCommunityService) GetCommunityByID(ctx context.Context, id string) (*domain.Community, error) {
@@ -52,27 +61,41 @@ func (m *MockCommunityService) RefreshHighlightedCard(ctx context.Context, id st
 	return nil, nil, nil
 }
 
-func (m *MockCommunityService) GetCommunityCards(ctx context.Context, id string) ([]domain.Card, error) {
-	if m.GetCommunityCardsFunc != nil {
-		return m.GetCommunityCardsFunc(ctx, id)
+func (m *MockCommunityService) ListCommunityCards(ctx context.Context, id string, page domain.PageRequest) (*domain.CardPage, error) {
+	if m.ListCommunityCardsFunc != nil {
+		return m.ListCommunityCardsFunc(ctx, id, page)
 	}
-	return []domain.Card{}, nil
+	return &domain.CardPage{Cards: []domain.Card{}}, nil
 }
 
-func (m *MockCommunityService) CreateCommunityWithPeriod(ctx context.Context, name string, startDateTime, endDateTime time.Time) (*domain.Community, error) {
+func (m *MockCommunityService) CreateCommunityWithPeriod(ctx context.Context, name string, ownerGithubID string, startDateTime, endDateTime time.Time) (*domain.Community, error) {
 	if m.CreateCommunityWithPeriodFunc != nil {
-		return m.CreateCommunityWithPeriodFunc(ctx, name, startDateTime, endDateTime)
+		return m.CreateCommunityWithPeriodFunc(ctx, name, ownerGithubID, startDateTime, endDateTime)
 	}
 	return nil, nil
 }
 
-func (m *MockCommunityService) DeleteCommunity(ctx context.Context, id string) error {
+func (m *MockCommunityService) UpdateCommunity(ctx context.Context, id string, name *string, startDateTime, endDateTime *time.Time) (*domain.Community, error) {
+	if m.UpdateCommunityFunc != nil {
+		return m.UpdateCommunityFunc(ctx, id, name, startDateTime, endDateTime)
+	}
+	return nil, nil
+}
+
+func (m *MockCommunityService) DeleteCommunity(ctx context.Context, id string, permanent bool) error {
 	if m.DeleteCommunityFunc != nil {
-		return m.DeleteCommunityFunc(ctx, id)
+		return m.DeleteCommunityFunc(ctx, id, permanent)
 	}
 	return nil
 }
 
+func (m *MockCommunityService) RestoreCommunity(ctx context.Context, id string, githubID string) (*domain.Community, error) {
+	if m.RestoreCommunityFunc != nil {
+		return m.RestoreCommunityFunc(ctx, id, githubID)
+	}
+	return nil, nil
+}
+
 func (m *MockCommunityService) AddCardToCommunity(ctx context.Context, communityID string, cardID string) error {
 	if m.AddCardToCommunityFunc != nil {
 		return m.AddCardToCommunityFunc(ctx, communityID, cardID)
@@ -86,3 +109,52 @@ func (m *MockCommunityService) RemoveCardFromCommunity(ctx context.Context, comm
 	}
 	return nil
 }
+
+func (m *MockCommunityService) AuthorizeMember(ctx context.Context, communityID string, githubID string, required domain.CommunityRole) error {
+	if m.AuthorizeMemberFunc != nil {
+		return m.AuthorizeMemberFunc(ctx, communityID, githubID, required)
+	}
+	return nil
+}
+
+func (m *MockCommunityService) TransferOwnership(ctx context.Context, communityID string, currentOwnerGithubID string, newOwnerGithubID string) (*domain.Community, error) {
+	if m.TransferOwnershipFunc != nil {
+		return m.TransferOwnershipFunc(ctx, communityID, currentOwnerGithubID, newOwnerGithubID)
+	}
+	return nil, nil
+}
+
+func (m *MockCommunityService) UpdateMemberRole(ctx context.Context, communityID string, targetGithubID string, role domain.CommunityRole) (*domain.CommunityMember, error) {
+	if m.UpdateMemberRoleFunc != nil {
+		return m.UpdateMemberRoleFunc(ctx, communityID, targetGithubID, role)
+	}
+	return nil, nil
+}
+
+func (m *MockCommunityService) CreateInvite(ctx context.Context, communityID string, createdByGithubID string, expiresIn time.Duration, singleUse bool) (*domain.CommunityInvite, error) {
+	if m.CreateInviteFunc != nil {
+		return m.CreateInviteFunc(ctx, communityID, createdByGithubID, expiresIn, singleUse)
+	}
+	return nil, nil
+}
+
+func (m *MockCommunityService) GetActiveInvites(ctx context.Context, communityID string) ([]domain.CommunityInvite, error) {
+	if m.GetActiveInvitesFunc != nil {
+		return m.GetActiveInvitesFunc(ctx, communityID)
+	}
+	return []domain.CommunityInvite{}, nil
+}
+
+func (m *MockCommunityService) RevokeInvite(ctx context.Context, communityID string, code string) (*domain.CommunityInvite, error) {
+	if m.RevokeInviteFunc != nil {
+		return m.RevokeInviteFunc(ctx, communityID, code)
+	}
+	return nil, nil
+}
+
+func (m *MockCommunityService) JoinByInvite(ctx context.Context, code string, cardID string) (*domain.Community, error) {
+	if m.JoinByInviteFunc != nil {
+		return m.JoinByInviteFunc(ctx, code, cardID)
+	}
+	return nil, nil
+}
@@ -2,22 +2,39 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
 // MockStatsService はテスト用のモック統計サービス
 type MockStatsService struct {
-	GetUserStatsFunc func(ctx context.Context, githubID string, githubClient GitHubClient) (*domain.Stats, error)
+	GetUserStatsFunc           func(ctx context.Context, githubID string, query domain.StatsQuery, githubClient GitHubClient) (*domain.Stats, error)
+	GetStatsSnapshotFunc       func(ctx context.Context, githubID string) (*domain.Stats, error)
+	GetContributionHistoryFunc func(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionHistory, error)
 }
 
 func NewMockStatsService() *MockStatsService {
 	return &MockStatsService{}
 }
 
-func (m *MockStatsService) GetUserStats(ctx context.Context, githubID string, githubClient GitHubClient) (*domain.Stats, error) {
+func (m *MockStatsService) GetUserStats(ctx context.Context, githubID string, query domain.StatsQuery, githubClient GitHubClient) (*domain.Stats, error) {
 	if m.GetUserStatsFunc != nil {
-		return m.GetUserStatsFunc(ctx, githubID, githubClient)
+		return m.GetUserStatsFunc(ctx, githubID, query, githubClient)
 	}
 	return &domain.Stats{}, nil
 }
+
+func (m *MockStatsService) GetStatsSnapshot(ctx context.Context, githubID string) (*domain.Stats, error) {
+	if m.GetStatsSnapshotFunc != nil {
+		return m.GetStatsSnapshotFunc(ctx, githubID)
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (m *MockStatsService) GetContributionHistory(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionHistory, error) {
+	if m.GetContributionHistoryFunc != nil {
+		return m.GetContributionHistoryFunc(ctx, githubID, from, to)
+	}
+	return &domain.ContributionHistory{From: from, To: to}, nil
+}
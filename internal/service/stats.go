@@ -2,26 +2,65 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"strconv"
+	"time"
 
 	"github.com/furarico/octo-deck-api/internal/domain"
 )
 
-type StatsService struct{}
+const (
+	// defaultStatsRange は期間の片方だけを指定されたときに集計する期間
+	defaultStatsRange = 365 * 24 * time.Hour
+	// maxStatsRange は集計できる期間の上限。GitHub APIは1年ごとに呼ぶので、呼び出す回数を抑えるために制限する
+	maxStatsRange = 10 * 365 * 24 * time.Hour
+	// statsRangePrecision は現在までの期間の始まりを揃える単位。GitHub APIのキャッシュのキーで期間の終わりを揃える単位と合わせる
+	statsRangePrecision = time.Hour
+)
+
+// ContributionSnapshotRepository はコントリビューションの記録を保存するRepositoryのインターフェース
+type ContributionSnapshotRepository interface {
+	SaveContributions(ctx context.Context, githubID string, contributions []domain.Contribution, fetchedAt time.Time) error
+	SaveContributionDetail(ctx context.Context, snapshot *domain.ContributionDetailSnapshot) error
+	FindContributions(ctx context.Context, githubID string, from, to time.Time) ([]domain.Contribution, error)
+	FindContributionDetail(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionDetailSnapshot, error)
+	FindPeerTotals(ctx context.Context, githubID string, from, to time.Time) ([]int, error)
+}
+
+type StatsService struct {
+	snapshotRepo ContributionSnapshotRepository
+	now          func() time.Time
+}
 
-func NewStatsService() *StatsService {
-	return &StatsService{}
+func NewStatsService(snapshotRepo ContributionSnapshotRepository) *StatsService {
+	return &StatsService{
+		snapshotRepo: snapshotRepo,
+		now:          time.Now,
+	}
 }
 
-// GetUserStats は指定されたGitHub IDのユーザーの統計情報を取得する
-func (s *StatsService) GetUserStats(ctx context.Context, githubID string, githubClient GitHubClient) (*domain.Stats, error) {
+// GetUserStats は指定されたGitHub IDのユーザーの、条件に合う期間の統計情報と、そこから求めた連続記録や集計を取得する
+// 期間を指定しない場合は過去1年間を集計する。from だけの場合は現在まで、to だけの場合は to までの1年間を集計する
+func (s *StatsService) GetUserStats(ctx context.Context, githubID string, query domain.StatsQuery, githubClient GitHubClient) (*domain.Stats, error) {
 	id, err := strconv.ParseInt(githubID, 10, 64)
 	if err != nil {
-		return nil, fmt.Errorf("invalid github id: %w", err)
+		return nil, fmt.Errorf("%w: invalid github id: %w", domain.ErrInvalidArgument, err)
+	}
+
+	loc, err := loadTimezone(query.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	rangeFrom, rangeTo, err := resolveStatsRange(query.From, query.To, now)
+	if err != nil {
+		return nil, err
 	}
 
-	githubStats, err := githubClient.GetUserStats(ctx, id)
+	githubStats, err := githubClient.GetUserStats(ctx, id, rangeFrom, rangeTo)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user stats: %w", err)
 	}
@@ -31,5 +70,166 @@ func (s *StatsService) GetUserStats(ctx context.Context, githubID string, github
 		return nil, fmt.Errorf("failed to convert stats to domain: %w", err)
 	}
 
+	// 日ごとの数と内訳を記録し、後からGitHubを呼ばずに過去の期間の統計を求められるようにする
+	// 記録は統計の履歴のためのものなので、失敗しても取得した統計は返す
+	var detail *domain.ContributionDetailSnapshot
+	if !domainStats.From.IsZero() && !domainStats.To.IsZero() {
+		detail = &domain.ContributionDetailSnapshot{
+			GithubID:           githubID,
+			From:               domainStats.From,
+			To:                 domainStats.To,
+			TotalContribution:  domainStats.TotalContribution,
+			ContributionDetail: domainStats.ContributionDetail,
+		}
+	}
+	if err := saveContributionSnapshot(ctx, s.snapshotRepo, githubID, domainStats.Contributions, detail, now); err != nil {
+		log.Printf("Warning: failed to save contribution snapshot of %s: %v", githubID, err)
+	}
+
+	domainStats.Summary = summarizeStats(domainStats, loc, now)
+
+	// 仲間の記録は日ごとに保存しているので、カレンダーと同じ日の範囲で比べる
+	if n := len(domainStats.Contributions); n > 0 {
+		peers, err := s.snapshotRepo.FindPeerTotals(ctx, githubID, domainStats.Contributions[0].Date, domainStats.Contributions[n-1].Date.AddDate(0, 0, 1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to find peer totals: %w", err)
+		}
+		domainStats.Summary.PeerCount = len(peers)
+		if len(peers) > 0 {
+			p := percentile(domainStats.TotalContribution, peers)
+			domainStats.Summary.Percentile = &p
+		}
+	}
+
 	return domainStats, nil
 }
+
+// loadTimezone はIANAのタイムゾーン名からタイムゾーンを読み込む。空の場合はUTCを返す
+func loadTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid timezone: %w", domain.ErrInvalidArgument, err)
+	}
+	return loc, nil
+}
+
+// GetContributionHistory は保存済みの記録から from から to（その日を含む）までのコントリビューションの統計を求める
+// GitHub APIは呼ばないので、1年より前の期間やトークンがなくなったユーザーの統計も返せる
+func (s *StatsService) GetContributionHistory(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionHistory, error) {
+	if _, err := strconv.ParseInt(githubID, 10, 64); err != nil {
+		return nil, fmt.Errorf("%w: invalid github id: %w", domain.ErrInvalidArgument, err)
+	}
+	if from.After(to) {
+		return nil, fmt.Errorf("%w: from must not be after to", domain.ErrInvalidArgument)
+	}
+
+	end := to.AddDate(0, 0, 1)
+	contributions, err := s.snapshotRepo.FindContributions(ctx, githubID, from, end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find contribution snapshots: %w", err)
+	}
+
+	total := 0
+	for _, c := range contributions {
+		total += c.Count
+	}
+
+	detail, err := s.snapshotRepo.FindContributionDetail(ctx, githubID, from, end)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, fmt.Errorf("failed to find contribution detail snapshot: %w", err)
+	}
+
+	return &domain.ContributionHistory{
+		From:              from,
+		To:                to,
+		Contributions:     contributions,
+		TotalContribution: total,
+		Detail:            detail,
+	}, nil
+}
+
+// resolveStatsRange は指定された期間から集計する期間を求める
+// 両方とも指定されていない場合はゼロ値を返し、GitHubの既定の過去1年間を集計させる
+func resolveStatsRange(from, to *time.Time, now time.Time) (time.Time, time.Time, error) {
+	if from == nil && to == nil {
+		return time.Time{}, time.Time{}, nil
+	}
+
+	end := now
+	if to != nil && to.Before(now) {
+		end = *to
+	}
+	start := end.Add(-defaultStatsRange)
+	if end.Equal(now) {
+		// 現在から求めた始まりは呼び出すたびに変わり、キャッシュが読み出されないので時間の境界に揃える
+		// 切り上げて、期間がGitHub APIで1回に取得できる1年を超えないようにする
+		start = now.Truncate(statsRangePrecision).Add(statsRangePrecision - defaultStatsRange)
+	}
+	if from != nil {
+		start = *from
+	}
+
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: from must be before to and now", domain.ErrInvalidArgument)
+	}
+	if end.Sub(start) > maxStatsRange {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: range must not be longer than %d years", domain.ErrInvalidArgument, maxStatsRange/defaultStatsRange)
+	}
+	return start, end, nil
+}
+
+// GetStatsSnapshot は保存済みの記録から過去1年間のコントリビューションの統計を求める
+// GitHub APIは呼ばないので、カードの画像のようにユーザーのトークンがない場面で使う
+// 記録がない場合は domain.ErrNotFound を返す
+func (s *StatsService) GetStatsSnapshot(ctx context.Context, githubID string) (*domain.Stats, error) {
+	// どのインスタンスでも同じ結果になるように、期間は日の境界に揃える
+	to := s.now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+	from := to.AddDate(-1, 0, 0)
+
+	contributions, err := s.snapshotRepo.FindContributions(ctx, githubID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find contribution snapshots: %w", err)
+	}
+	if len(contributions) == 0 {
+		return nil, fmt.Errorf("contribution snapshots not found: githubID=%s: %w", githubID, domain.ErrNotFound)
+	}
+
+	total := 0
+	for _, c := range contributions {
+		total += c.Count
+	}
+
+	return &domain.Stats{
+		Contributions:     contributions,
+		TotalContribution: total,
+		From:              from,
+		To:                to,
+	}, nil
+}
+
+// contributionSnapshot は記録するユーザーの日ごとのコントリビューション数と期間の内訳
+type contributionSnapshot struct {
+	githubID      string
+	contributions []domain.Contribution
+	detail        *domain.ContributionDetailSnapshot
+}
+
+// saveContributionSnapshot はユーザーの日ごとのコントリビューション数と期間の内訳を記録する
+// detail がnilの場合は日ごとの数だけを記録する
+func saveContributionSnapshot(ctx context.Context, repo ContributionSnapshotRepository, githubID string, contributions []domain.Contribution, detail *domain.ContributionDetailSnapshot, fetchedAt time.Time) error {
+	if err := repo.SaveContributions(ctx, githubID, contributions, fetchedAt); err != nil {
+		return fmt.Errorf("failed to save contribution snapshots: %w", err)
+	}
+	if detail == nil {
+		return nil
+	}
+
+	detail.FetchedAt = fetchedAt
+	if err := repo.SaveContributionDetail(ctx, detail); err != nil {
+		return fmt.Errorf("failed to save contribution detail snapshot: %w", err)
+	}
+	return nil
+}
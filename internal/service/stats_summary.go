@@ -0,0 +1,112 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// summarizeStats は統計情報から連続記録・曜日と時間帯・週と月ごとの合計を求める
+// 連続記録の「今日」と時間帯は loc のタイムゾーンで数える。仲間との比較は含めない
+func summarizeStats(stats *domain.Stats, loc *time.Location, now time.Time) domain.StatsSummary {
+	summary := domain.StatsSummary{
+		Weekly:   []domain.ContributionAggregate{},
+		Monthly:  []domain.ContributionAggregate{},
+		Timezone: loc.String(),
+	}
+
+	contributions := make([]domain.Contribution, len(stats.Contributions))
+	copy(contributions, stats.Contributions)
+	sort.Slice(contributions, func(i, j int) bool {
+		return contributions[i].Date.Before(contributions[j].Date)
+	})
+
+	// 最長の連続記録と、曜日・週・月ごとの合計
+	countByDate := make(map[string]int, len(contributions))
+	var weekdayTotals [7]int
+	run := 0
+	var prev time.Time
+	for _, c := range contributions {
+		countByDate[c.Date.Format(time.DateOnly)] += c.Count
+		weekdayTotals[c.Date.Weekday()] += c.Count
+
+		switch {
+		case c.Count == 0:
+			run = 0
+		case run > 0 && c.Date.Equal(prev.AddDate(0, 0, 1)):
+			run++
+		default:
+			run = 1
+		}
+		prev = c.Date
+		summary.LongestStreak = max(summary.LongestStreak, run)
+
+		weekStart := c.Date.AddDate(0, 0, -int(c.Date.Weekday()))
+		summary.Weekly = addToAggregates(summary.Weekly, weekStart, c.Count)
+		monthStart := time.Date(c.Date.Year(), c.Date.Month(), 1, 0, 0, 0, 0, c.Date.Location())
+		summary.Monthly = addToAggregates(summary.Monthly, monthStart, c.Count)
+	}
+
+	// 今日の分はまだ途中なので、今日が0件の場合は前日から数える
+	end := stats.To
+	if end.IsZero() || end.After(now) {
+		end = now
+	}
+	day := end.In(loc)
+	day = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	if countByDate[day.Format(time.DateOnly)] == 0 {
+		day = day.AddDate(0, 0, -1)
+	}
+	for countByDate[day.Format(time.DateOnly)] > 0 {
+		summary.CurrentStreak++
+		day = day.AddDate(0, 0, -1)
+	}
+
+	if weekday, ok := argmax(weekdayTotals[:]); ok {
+		w := time.Weekday(weekday)
+		summary.MostActiveWeekday = &w
+	}
+
+	var hourTotals [24 / domain.HourBucketSize]int
+	for _, t := range stats.ActivityTimes {
+		hourTotals[t.In(loc).Hour()/domain.HourBucketSize]++
+	}
+	if bucket, ok := argmax(hourTotals[:]); ok {
+		hour := bucket * domain.HourBucketSize
+		summary.MostActiveHourBucket = &hour
+	}
+
+	return summary
+}
+
+// addToAggregates は日付の順に並んだ集計に数を足す。最後の区切りと違う場合は新しい区切りを追加する
+func addToAggregates(aggregates []domain.ContributionAggregate, start time.Time, count int) []domain.ContributionAggregate {
+	if n := len(aggregates); n > 0 && aggregates[n-1].Start.Equal(start) {
+		aggregates[n-1].Count += count
+		return aggregates
+	}
+	return append(aggregates, domain.ContributionAggregate{Start: start, Count: count})
+}
+
+// argmax は最も大きい値の位置を返す。同じ値の場合は前のものを選び、すべて0の場合はfalseを返す
+func argmax(values []int) (int, bool) {
+	best := -1
+	for i, v := range values {
+		if v > 0 && (best < 0 || v > values[best]) {
+			best = i
+		}
+	}
+	return best, best >= 0
+}
+
+// percentile は peers のうち total より少ない人の割合（0〜100）を返す
+func percentile(total int, peers []int) float64 {
+	below := 0
+	for _, peer := range peers {
+		if peer < total {
+			below++
+		}
+	}
+	return float64(below) / float64(len(peers)) * 100
+}
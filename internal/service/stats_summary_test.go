@@ -0,0 +1,96 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+func day(month time.Month, d int, count int) domain.Contribution {
+	return domain.Contribution{Date: time.Date(2025, month, d, 0, 0, 0, 0, time.UTC), Count: count}
+}
+
+// summarizeStats は連続記録・曜日と時間帯・週と月ごとの合計を求める
+func TestSummarizeStats(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("タイムゾーンの情報がありません: %v", err)
+	}
+
+	// 2025-01-29(水)〜2025-02-04(火)
+	stats := &domain.Stats{
+		Contributions: []domain.Contribution{
+			day(1, 29, 2),
+			day(1, 30, 3),
+			day(1, 31, 1),
+			day(2, 1, 0),
+			day(2, 2, 4),
+			day(2, 3, 1),
+			day(2, 4, 0),
+		},
+		ActivityTimes: []time.Time{
+			// UTCでは15時台だが、東京では0時台
+			time.Date(2025, 2, 2, 15, 30, 0, 0, time.UTC),
+			time.Date(2025, 2, 2, 16, 0, 0, 0, time.UTC),
+			time.Date(2025, 2, 3, 3, 0, 0, 0, time.UTC),
+		},
+		To: time.Date(2025, 2, 4, 3, 0, 0, 0, time.UTC),
+	}
+	now := time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC)
+
+	summary := summarizeStats(stats, tokyo, now)
+
+	// 最終日（2/4）は0件なので、前日から数える
+	if summary.CurrentStreak != 2 {
+		t.Errorf("CurrentStreakが違う: 期待=2, 実際=%d", summary.CurrentStreak)
+	}
+	if summary.LongestStreak != 3 {
+		t.Errorf("LongestStreakが違う: 期待=3, 実際=%d", summary.LongestStreak)
+	}
+	if summary.MostActiveWeekday == nil || *summary.MostActiveWeekday != time.Sunday {
+		t.Errorf("MostActiveWeekdayが違う: 期待=Sunday, 実際=%v", summary.MostActiveWeekday)
+	}
+	if summary.MostActiveHourBucket == nil || *summary.MostActiveHourBucket != 0 {
+		t.Errorf("MostActiveHourBucketが違う: 期待=0, 実際=%v", summary.MostActiveHourBucket)
+	}
+	if summary.Timezone != "Asia/Tokyo" {
+		t.Errorf("Timezoneが違う: 期待=Asia/Tokyo, 実際=%s", summary.Timezone)
+	}
+
+	// 週は日曜日始まり（1/26の週と2/2の週）
+	wantWeekly := []domain.ContributionAggregate{
+		{Start: time.Date(2025, 1, 26, 0, 0, 0, 0, time.UTC), Count: 6},
+		{Start: time.Date(2025, 2, 2, 0, 0, 0, 0, time.UTC), Count: 5},
+	}
+	if len(summary.Weekly) != len(wantWeekly) {
+		t.Fatalf("Weeklyが違う: 期待=%v, 実際=%v", wantWeekly, summary.Weekly)
+	}
+	for i, want := range wantWeekly {
+		if !summary.Weekly[i].Start.Equal(want.Start) || summary.Weekly[i].Count != want.Count {
+			t.Errorf("Weekly[%d]が違う: 期待=%v, 実際=%v", i, want, summary.Weekly[i])
+		}
+	}
+	if len(summary.Monthly) != 2 || summary.Monthly[0].Count != 6 || summary.Monthly[1].Count != 5 {
+		t.Errorf("Monthlyが違う: %v", summary.Monthly)
+	}
+}
+
+// コントリビューションがない場合は曜日と時間帯を求めない
+func TestSummarizeStats_Empty(t *testing.T) {
+	summary := summarizeStats(&domain.Stats{Contributions: []domain.Contribution{day(1, 1, 0)}}, time.UTC, time.Now())
+
+	if summary.CurrentStreak != 0 || summary.LongestStreak != 0 {
+		t.Errorf("連続記録が0ではありません: %+v", summary)
+	}
+	if summary.MostActiveWeekday != nil || summary.MostActiveHourBucket != nil {
+		t.Errorf("曜日と時間帯がnilではありません: %+v", summary)
+	}
+}
+
+// percentile は仲間のうち合計が少ない人の割合を返す
+func TestPercentile(t *testing.T) {
+	if got := percentile(10, []int{5, 10, 20, 1}); got != 50 {
+		t.Errorf("percentileが違う: 期待=50, 実際=%v", got)
+	}
+}
@@ -2,10 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/furarico/octo-deck-api/internal/domain"
 	"github.com/furarico/octo-deck-api/internal/github"
+	"github.com/furarico/octo-deck-api/internal/repository"
 )
 
 // テスト用のヘルパー関数: 正常なUserStatsを返す
@@ -24,6 +28,8 @@ func createTestUserStats() *github.UserStats {
 			PullRequestCount: 20,
 			ReviewCount:      10,
 		},
+		From: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
 	}
 }
 
@@ -41,7 +47,7 @@ func TestGetUserStats(t *testing.T) {
 			githubID: "12345",
 			setupGitHub: func() *github.MockClient {
 				return &github.MockClient{
-					GetUserStatsFunc: func(ctx context.Context, githubID int64) (*github.UserStats, error) {
+					GetUserStatsFunc: func(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
 						return createTestUserStats(), nil
 					},
 				}
@@ -62,7 +68,7 @@ func TestGetUserStats(t *testing.T) {
 			githubID: "12345",
 			setupGitHub: func() *github.MockClient {
 				return &github.MockClient{
-					GetUserStatsFunc: func(ctx context.Context, githubID int64) (*github.UserStats, error) {
+					GetUserStatsFunc: func(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
 						return nil, fmt.Errorf("github api error")
 					},
 				}
@@ -75,7 +81,7 @@ func TestGetUserStats(t *testing.T) {
 			githubID: "12345",
 			setupGitHub: func() *github.MockClient {
 				return &github.MockClient{
-					GetUserStatsFunc: func(ctx context.Context, githubID int64) (*github.UserStats, error) {
+					GetUserStatsFunc: func(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
 						// 無効な日付形式を含むUserStatsを返す
 						return &github.UserStats{
 							Contributions: []github.Contribution{
@@ -102,7 +108,7 @@ func TestGetUserStats(t *testing.T) {
 			githubID: "12345",
 			setupGitHub: func() *github.MockClient {
 				return &github.MockClient{
-					GetUserStatsFunc: func(ctx context.Context, githubID int64) (*github.UserStats, error) {
+					GetUserStatsFunc: func(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
 						return &github.UserStats{
 							Contributions:         []github.Contribution{},
 							TotalContribution:     0,
@@ -121,8 +127,8 @@ func TestGetUserStats(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
 			githubClient := tt.setupGitHub()
-			service := NewStatsService()
-			stats, err := service.GetUserStats(ctx, tt.githubID, githubClient)
+			service := NewStatsService(repository.NewMockContributionSnapshotRepository())
+			stats, err := service.GetUserStats(ctx, tt.githubID, domain.StatsQuery{}, githubClient)
 
 			if tt.wantErr {
 				if err == nil {
@@ -145,3 +151,381 @@ func TestGetUserStats(t *testing.T) {
 		})
 	}
 }
+
+// GetStatsSnapshot は保存済みの記録から過去1年間の統計を求める
+func TestGetStatsSnapshot(t *testing.T) {
+	now := time.Date(2025, 1, 1, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		contributions []domain.Contribution
+		findErr       error
+		wantErrIs     error
+		wantTotal     int
+	}{
+		{
+			name: "記録の合計を返す",
+			contributions: []domain.Contribution{
+				{Date: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), Count: 5},
+				{Date: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Count: 3},
+			},
+			wantTotal: 8,
+		},
+		{
+			name:          "記録がない場合",
+			contributions: []domain.Contribution{},
+			wantErrIs:     domain.ErrNotFound,
+		},
+		{
+			name:    "記録の取得に失敗した場合",
+			findErr: fmt.Errorf("database error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFrom, gotTo time.Time
+			snapshotRepo := &repository.MockContributionSnapshotRepository{
+				FindContributionsFunc: func(ctx context.Context, githubID string, from, to time.Time) ([]domain.Contribution, error) {
+					gotFrom, gotTo = from, to
+					return tt.contributions, tt.findErr
+				},
+			}
+			service := NewStatsService(snapshotRepo)
+			service.now = func() time.Time { return now }
+
+			stats, err := service.GetStatsSnapshot(context.Background(), "12345")
+
+			// 同じ日のうちはどのインスタンスでも同じ期間になる
+			wantTo := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+			if !gotFrom.Equal(wantTo.AddDate(-1, 0, 0)) || !gotTo.Equal(wantTo) {
+				t.Errorf("期間が違う: 実際=%v - %v", gotFrom, gotTo)
+			}
+			if tt.findErr != nil || tt.wantErrIs != nil {
+				if err == nil || (tt.wantErrIs != nil && !errors.Is(err, tt.wantErrIs)) {
+					t.Errorf("エラーが期待と異なります: 期待=%v, 実際=%v", tt.wantErrIs, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+			if stats.TotalContribution != tt.wantTotal {
+				t.Errorf("TotalContributionが違う: 期待=%d, 実際=%d", tt.wantTotal, stats.TotalContribution)
+			}
+		})
+	}
+}
+
+// GetUserStats は取得した日ごとの数と内訳を記録する
+func TestGetUserStats_SaveSnapshot(t *testing.T) {
+	var savedContributions []domain.Contribution
+	var savedDetail *domain.ContributionDetailSnapshot
+	snapshotRepo := &repository.MockContributionSnapshotRepository{
+		SaveContributionsFunc: func(ctx context.Context, githubID string, contributions []domain.Contribution, fetchedAt time.Time) error {
+			savedContributions = contributions
+			return nil
+		},
+		SaveContributionDetailFunc: func(ctx context.Context, snapshot *domain.ContributionDetailSnapshot) error {
+			savedDetail = snapshot
+			return nil
+		},
+	}
+	githubClient := &github.MockClient{
+		GetUserStatsFunc: func(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
+			return createTestUserStats(), nil
+		},
+	}
+
+	service := NewStatsService(snapshotRepo)
+	if _, err := service.GetUserStats(context.Background(), "12345", domain.StatsQuery{}, githubClient); err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+
+	if len(savedContributions) != 2 {
+		t.Errorf("保存した日数が違う: 期待=2, 実際=%d", len(savedContributions))
+	}
+	if savedDetail == nil {
+		t.Fatalf("内訳が保存されていません")
+	}
+	wantFrom := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	wantTo := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	if savedDetail.GithubID != "12345" || !savedDetail.From.Equal(wantFrom) || !savedDetail.To.Equal(wantTo) {
+		t.Errorf("内訳の期間が違う: %+v", savedDetail)
+	}
+	if savedDetail.ContributionDetail.CommitCount != 50 || savedDetail.TotalContribution != 100 {
+		t.Errorf("内訳が違う: %+v", savedDetail)
+	}
+}
+
+// 記録の保存に失敗しても、取得した統計情報は返す
+func TestGetUserStats_SaveSnapshotError(t *testing.T) {
+	snapshotRepo := &repository.MockContributionSnapshotRepository{
+		SaveContributionsFunc: func(ctx context.Context, githubID string, contributions []domain.Contribution, fetchedAt time.Time) error {
+			return fmt.Errorf("database error")
+		},
+	}
+	githubClient := &github.MockClient{
+		GetUserStatsFunc: func(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
+			return createTestUserStats(), nil
+		},
+	}
+
+	service := NewStatsService(snapshotRepo)
+	stats, err := service.GetUserStats(context.Background(), "12345", domain.StatsQuery{}, githubClient)
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+	if stats.TotalContribution != 100 || len(stats.Contributions) != 2 {
+		t.Errorf("統計情報が違う: %+v", stats)
+	}
+}
+
+// GetContributionHistory は保存済みの記録から期間の統計情報を求める
+func TestGetContributionHistory(t *testing.T) {
+	from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		githubID     string
+		from, to     time.Time
+		snapshotRepo *repository.MockContributionSnapshotRepository
+		wantErr      error
+		wantTotal    int
+		wantDetail   bool
+	}{
+		{
+			name:     "日ごとの数を合計し、内訳の記録を返す",
+			githubID: "12345",
+			from:     from,
+			to:       to,
+			snapshotRepo: &repository.MockContributionSnapshotRepository{
+				FindContributionsFunc: func(ctx context.Context, githubID string, gotFrom, gotTo time.Time) ([]domain.Contribution, error) {
+					// 最終日を含めるため、翌日までを検索する
+					if !gotFrom.Equal(from) || !gotTo.Equal(to.AddDate(0, 0, 1)) {
+						return nil, fmt.Errorf("unexpected range: %v - %v", gotFrom, gotTo)
+					}
+					return []domain.Contribution{
+						{Date: time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC), Count: 5},
+						{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Count: 3},
+					}, nil
+				},
+				FindContributionDetailFunc: func(ctx context.Context, githubID string, from, to time.Time) (*domain.ContributionDetailSnapshot, error) {
+					return &domain.ContributionDetailSnapshot{GithubID: githubID, TotalContribution: 3}, nil
+				},
+			},
+			wantTotal:  8,
+			wantDetail: true,
+		},
+		{
+			name:         "内訳の記録がない場合は日ごとの数だけを返す",
+			githubID:     "12345",
+			from:         from,
+			to:           to,
+			snapshotRepo: repository.NewMockContributionSnapshotRepository(),
+			wantTotal:    0,
+			wantDetail:   false,
+		},
+		{
+			name:         "無効なGitHubIDの場合",
+			githubID:     "invalid_id",
+			from:         from,
+			to:           to,
+			snapshotRepo: repository.NewMockContributionSnapshotRepository(),
+			wantErr:      domain.ErrInvalidArgument,
+		},
+		{
+			name:         "開始日が終了日より後の場合",
+			githubID:     "12345",
+			from:         to,
+			to:           from,
+			snapshotRepo: repository.NewMockContributionSnapshotRepository(),
+			wantErr:      domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewStatsService(tt.snapshotRepo)
+			history, err := service.GetContributionHistory(context.Background(), tt.githubID, tt.from, tt.to)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("エラーが期待と異なります: 期待=%v, 実際=%v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+			if history.TotalContribution != tt.wantTotal {
+				t.Errorf("TotalContributionが違う: 期待=%d, 実際=%d", tt.wantTotal, history.TotalContribution)
+			}
+			if (history.Detail != nil) != tt.wantDetail {
+				t.Errorf("内訳の有無が違う: 期待=%v, 実際=%+v", tt.wantDetail, history.Detail)
+			}
+		})
+	}
+}
+
+// GetUserStats は指定された期間から集計する期間を求めてGitHubに問い合わせる
+func TestGetUserStats_Range(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	hackathonStart := time.Date(2025, 5, 10, 9, 0, 0, 0, time.UTC)
+	hackathonEnd := time.Date(2025, 5, 11, 18, 0, 0, 0, time.UTC)
+	ptr := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name     string
+		from, to *time.Time
+		wantFrom time.Time
+		wantTo   time.Time
+		wantErr  error
+	}{
+		{
+			name: "期間を指定しない場合はGitHubの既定の期間を使う",
+		},
+		{
+			name:     "両方を指定した場合はその期間を使う",
+			from:     ptr(hackathonStart),
+			to:       ptr(hackathonEnd),
+			wantFrom: hackathonStart,
+			wantTo:   hackathonEnd,
+		},
+		{
+			name:     "fromだけを指定した場合は現在までを集計する",
+			from:     ptr(hackathonStart),
+			wantFrom: hackathonStart,
+			wantTo:   now,
+		},
+		{
+			name:     "toだけを指定した場合はtoまでの1年間を集計する",
+			to:       ptr(hackathonEnd),
+			wantFrom: hackathonEnd.Add(-defaultStatsRange),
+			wantTo:   hackathonEnd,
+		},
+		{
+			name:     "toが未来の場合は現在までにする",
+			from:     ptr(hackathonStart),
+			to:       ptr(now.AddDate(0, 1, 0)),
+			wantFrom: hackathonStart,
+			wantTo:   now,
+		},
+		{
+			name:    "fromがtoより後の場合",
+			from:    ptr(hackathonEnd),
+			to:      ptr(hackathonStart),
+			wantErr: domain.ErrInvalidArgument,
+		},
+		{
+			name:    "期間が長すぎる場合",
+			from:    ptr(now.Add(-maxStatsRange - time.Hour)),
+			wantErr: domain.ErrInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotFrom, gotTo time.Time
+			githubClient := &github.MockClient{
+				GetUserStatsFunc: func(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
+					gotFrom, gotTo = from, to
+					return createTestUserStats(), nil
+				},
+			}
+			service := NewStatsService(repository.NewMockContributionSnapshotRepository())
+			service.now = func() time.Time { return now }
+
+			_, err := service.GetUserStats(context.Background(), "12345", domain.StatsQuery{From: tt.from, To: tt.to}, githubClient)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("エラーが期待と異なります: 期待=%v, 実際=%v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("予期しないエラーが発生しました: %v", err)
+			}
+			if !gotFrom.Equal(tt.wantFrom) || !gotTo.Equal(tt.wantTo) {
+				t.Errorf("期間が違う: 期待=%v - %v, 実際=%v - %v", tt.wantFrom, tt.wantTo, gotFrom, gotTo)
+			}
+		})
+	}
+}
+
+// 現在までの1年間を集計する場合、同じ時間帯の呼び出しは同じ期間になる
+func TestGetUserStats_RangeUntilNow(t *testing.T) {
+	future := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	wantFrom := time.Date(2024, 6, 1, 13, 0, 0, 0, time.UTC)
+
+	var froms []time.Time
+	githubClient := &github.MockClient{
+		GetUserStatsFunc: func(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
+			froms = append(froms, from)
+			if to.Sub(from) > defaultStatsRange {
+				t.Errorf("期間が1年より長い: %v - %v", from, to)
+			}
+			return createTestUserStats(), nil
+		},
+	}
+	service := NewStatsService(repository.NewMockContributionSnapshotRepository())
+
+	for _, now := range []time.Time{
+		time.Date(2025, 6, 1, 12, 0, 5, 0, time.UTC),
+		time.Date(2025, 6, 1, 12, 59, 0, 0, time.UTC),
+	} {
+		service.now = func() time.Time { return now }
+		if _, err := service.GetUserStats(context.Background(), "12345", domain.StatsQuery{To: &future}, githubClient); err != nil {
+			t.Fatalf("予期しないエラーが発生しました: %v", err)
+		}
+	}
+
+	for _, from := range froms {
+		if !from.Equal(wantFrom) {
+			t.Errorf("期間の始まりが違う: 期待=%v, 実際=%v", wantFrom, from)
+		}
+	}
+}
+
+// GetUserStats は同じコミュニティの仲間と比べた割合を求める
+func TestGetUserStats_Percentile(t *testing.T) {
+	var gotFrom, gotTo time.Time
+	snapshotRepo := &repository.MockContributionSnapshotRepository{
+		FindPeerTotalsFunc: func(ctx context.Context, githubID string, from, to time.Time) ([]int, error) {
+			gotFrom, gotTo = from, to
+			return []int{50, 150, 99, 100}, nil
+		},
+	}
+	githubClient := &github.MockClient{
+		GetUserStatsFunc: func(ctx context.Context, githubID int64, from, to time.Time) (*github.UserStats, error) {
+			return createTestUserStats(), nil
+		},
+	}
+
+	service := NewStatsService(snapshotRepo)
+	stats, err := service.GetUserStats(context.Background(), "12345", domain.StatsQuery{}, githubClient)
+	if err != nil {
+		t.Fatalf("予期しないエラーが発生しました: %v", err)
+	}
+
+	// カレンダーの最初の日から最後の日の翌日までで比べる
+	if !gotFrom.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) || !gotTo.Equal(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("仲間と比べる期間が違う: %v - %v", gotFrom, gotTo)
+	}
+	if stats.Summary.PeerCount != 4 {
+		t.Errorf("PeerCountが違う: 期待=4, 実際=%d", stats.Summary.PeerCount)
+	}
+	if stats.Summary.Percentile == nil || *stats.Summary.Percentile != 50 {
+		t.Errorf("Percentileが違う: 期待=50, 実際=%v", stats.Summary.Percentile)
+	}
+}
+
+// 無効なタイムゾーンの場合はInvalidArgumentを返す
+func TestGetUserStats_InvalidTimezone(t *testing.T) {
+	service := NewStatsService(repository.NewMockContributionSnapshotRepository())
+	_, err := service.GetUserStats(context.Background(), "12345", domain.StatsQuery{Timezone: "Mars/Olympus"}, &github.MockClient{})
+	if !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Errorf("エラーが期待と異なります: 期待=%v, 実際=%v", domain.ErrInvalidArgument, err)
+	}
+}
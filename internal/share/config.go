@@ -0,0 +1,35 @@
+package share
+
+import (
+	"fmt"
+	"os"
+)
+
+// 署名に使う鍵の最小バイト数（HMAC-SHA256のハッシュ長）
+const minSecretLength = 32
+
+// Config はカードの共有ペイロードの署名の設定
+type Config struct {
+	// Secret はHMAC-SHA256の鍵。32バイト以上のランダムな文字列を設定する
+	Secret []byte
+}
+
+// LoadConfig は環境変数から署名の設定を読み込む
+func LoadConfig() (Config, error) {
+	cfg := Config{
+		Secret: []byte(os.Getenv("CARD_SHARE_SECRET")),
+	}
+
+	if err := cfg.validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+func (c Config) validate() error {
+	if len(c.Secret) < minSecretLength {
+		return fmt.Errorf("CARD_SHARE_SECRET must be at least %d bytes", minSecretLength)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package share
+
+import (
+	"strings"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+type MockSigner struct {
+	SignFunc   func(cardShare domain.CardShare) (string, error)
+	VerifyFunc func(payload string, now time.Time) (*domain.CardShare, error)
+}
+
+func (s *MockSigner) Sign(cardShare domain.CardShare) (string, error) {
+	if s.SignFunc != nil {
+		return s.SignFunc(cardShare)
+	}
+	return "payload:" + cardShare.GithubID, nil
+}
+
+// Verify は既定では "payload:<GitHub ID>" の形式のペイロードを受け付ける
+func (s *MockSigner) Verify(payload string, now time.Time) (*domain.CardShare, error) {
+	if s.VerifyFunc != nil {
+		return s.VerifyFunc(payload, now)
+	}
+	githubID, ok := strings.CutPrefix(payload, "payload:")
+	if !ok {
+		return nil, ErrMalformedPayload
+	}
+	return &domain.CardShare{GithubID: githubID, IssuedAt: now, ExpiresAt: now.Add(time.Minute)}, nil
+}
@@ -0,0 +1,103 @@
+package share
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// ペイロードの形式のバージョン。署名の方式を変えるときに上げる
+const payloadVersion = "v1"
+
+// 共有ペイロードの検証に失敗したことを表すエラー
+var (
+	// ErrMalformedPayload はペイロードの形式が正しくないことを表す
+	ErrMalformedPayload = fmt.Errorf("%w: malformed share payload", domain.ErrInvalidArgument)
+	// ErrInvalidSignature は署名が一致しない（改ざんされた）ことを表す
+	ErrInvalidSignature = fmt.Errorf("%w: invalid share payload signature", domain.ErrInvalidArgument)
+	// ErrExpiredPayload はペイロードの有効期限が切れていることを表す
+	ErrExpiredPayload = fmt.Errorf("%w: share payload has expired", domain.ErrInvalidArgument)
+)
+
+// claims はペイロードに含める内容
+type claims struct {
+	Subject   string `json:"sub"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Signer はカードの共有ペイロードをHMAC-SHA256で署名・検証する
+// ペイロードは "v1.<base64url(JSON)>.<base64url(署名)>" の形式で、QRコードやNFCにそのまま載せられる
+type Signer struct {
+	secret []byte
+}
+
+func NewSigner(cfg Config) (*Signer, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return &Signer{secret: cfg.Secret}, nil
+}
+
+// Sign はカードの共有情報に署名したペイロードを返す
+func (s *Signer) Sign(cardShare domain.CardShare) (string, error) {
+	body, err := json.Marshal(claims{
+		Subject:   cardShare.GithubID,
+		IssuedAt:  cardShare.IssuedAt.Unix(),
+		ExpiresAt: cardShare.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal share payload: %w", err)
+	}
+
+	signed := payloadVersion + "." + base64.RawURLEncoding.EncodeToString(body)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(s.mac(signed)), nil
+}
+
+// Verify はペイロードの署名と有効期限を検証し、カードの共有情報を返す
+func (s *Signer) Verify(payload string, now time.Time) (*domain.CardShare, error) {
+	parts := strings.Split(payload, ".")
+	if len(parts) != 3 || parts[0] != payloadVersion {
+		return nil, ErrMalformedPayload
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformedPayload
+	}
+	if !hmac.Equal(signature, s.mac(parts[0]+"."+parts[1])) {
+		return nil, ErrInvalidSignature
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrMalformedPayload
+	}
+	var c claims
+	if err := json.Unmarshal(body, &c); err != nil || c.Subject == "" {
+		return nil, ErrMalformedPayload
+	}
+
+	expiresAt := time.Unix(c.ExpiresAt, 0)
+	if !now.Before(expiresAt) {
+		return nil, ErrExpiredPayload
+	}
+
+	return &domain.CardShare{
+		GithubID:  c.Subject,
+		IssuedAt:  time.Unix(c.IssuedAt, 0),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (s *Signer) mac(message string) []byte {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(message))
+	return h.Sum(nil)
+}
@@ -0,0 +1,143 @@
+package share
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+func newTestSigner(t *testing.T, secret string) *Signer {
+	t.Helper()
+	signer, err := NewSigner(Config{Secret: []byte(secret)})
+	if err != nil {
+		t.Fatalf("NewSigner() error = %v", err)
+	}
+	return signer
+}
+
+// 署名したペイロードを検証できることと、不正なペイロードを拒否することをテスト
+func TestSigner_Verify(t *testing.T) {
+	const secret = "0123456789abcdef0123456789abcdef"
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	signer := newTestSigner(t, secret)
+
+	sign := func(t *testing.T, expiresAt time.Time) string {
+		t.Helper()
+		payload, err := signer.Sign(domain.CardShare{GithubID: "12345", IssuedAt: now, ExpiresAt: expiresAt})
+		if err != nil {
+			t.Fatalf("Sign() error = %v", err)
+		}
+		return payload
+	}
+
+	tests := []struct {
+		name    string
+		payload func(t *testing.T) string
+		wantErr error
+	}{
+		{
+			name: "有効期限内のペイロードを検証できる",
+			payload: func(t *testing.T) string {
+				return sign(t, now.Add(time.Minute))
+			},
+		},
+		{
+			name: "有効期限が切れたペイロードは拒否する",
+			payload: func(t *testing.T) string {
+				return sign(t, now)
+			},
+			wantErr: ErrExpiredPayload,
+		},
+		{
+			name: "本文を書き換えたペイロードは拒否する",
+			payload: func(t *testing.T) string {
+				parts := strings.Split(sign(t, now.Add(time.Minute)), ".")
+				body := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"99999","iat":0,"exp":4102444800}`))
+				return parts[0] + "." + body + "." + parts[2]
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "別の鍵で署名したペイロードは拒否する",
+			payload: func(t *testing.T) string {
+				other := newTestSigner(t, "fedcba9876543210fedcba9876543210")
+				payload, err := other.Sign(domain.CardShare{GithubID: "12345", IssuedAt: now, ExpiresAt: now.Add(time.Minute)})
+				if err != nil {
+					t.Fatalf("Sign() error = %v", err)
+				}
+				return payload
+			},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name: "GitHub IDだけのペイロードは拒否する",
+			payload: func(t *testing.T) string {
+				return "12345"
+			},
+			wantErr: ErrMalformedPayload,
+		},
+		{
+			name: "バージョンが違うペイロードは拒否する",
+			payload: func(t *testing.T) string {
+				return "v0" + strings.TrimPrefix(sign(t, now.Add(time.Minute)), payloadVersion)
+			},
+			wantErr: ErrMalformedPayload,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cardShare, err := signer.Verify(tt.payload(t), now)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+				}
+				if !errors.Is(err, domain.ErrInvalidArgument) {
+					t.Errorf("Verify() error = %v, want ErrInvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			if cardShare.GithubID != "12345" {
+				t.Errorf("GithubID = %s, want 12345", cardShare.GithubID)
+			}
+			if !cardShare.ExpiresAt.Equal(now.Add(time.Minute)) {
+				t.Errorf("ExpiresAt = %s, want %s", cardShare.ExpiresAt, now.Add(time.Minute))
+			}
+		})
+	}
+}
+
+// 短すぎる鍵では署名できないことをテスト
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		wantErr bool
+	}{
+		{name: "32バイト以上の鍵を読み込める", secret: "0123456789abcdef0123456789abcdef", wantErr: false},
+		{name: "鍵が設定されていない場合はエラー", secret: "", wantErr: true},
+		{name: "鍵が短すぎる場合はエラー", secret: "short-secret", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("CARD_SHARE_SECRET", tt.secret)
+
+			cfg, err := LoadConfig()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(cfg.Secret) != tt.secret {
+				t.Errorf("Secret = %s, want %s", cfg.Secret, tt.secret)
+			}
+		})
+	}
+}
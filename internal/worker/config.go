@@ -0,0 +1,100 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/config"
+)
+
+// Config はバックグラウンドのジョブの設定
+type Config struct {
+	// PollInterval はジョブを実行する時期になったかを確認する間隔
+	PollInterval time.Duration
+	// JobTimeout は1回のジョブの実行にかけられる最大の時間。ロックもこの時間を過ぎたら他のインスタンスが取り直せる
+	JobTimeout time.Duration
+	// RetryBackoff はジョブが失敗してからやり直すまでの最初の間隔。続けて失敗するたびに倍にし、ジョブの間隔を上限にする
+	RetryBackoff time.Duration
+
+	// CardRefreshInterval はカードを更新するジョブの実行間隔
+	CardRefreshInterval time.Duration
+	// CardStaleAfter は最後に更新してからこの時間が過ぎたカードを更新する
+	CardStaleAfter time.Duration
+	// CardBatchSize は1回のジョブで更新するカードの最大数
+	CardBatchSize int
+
+	// CommunityRefreshInterval は期間中のコミュニティのHighlightedCardを計算し直す間隔
+	CommunityRefreshInterval time.Duration
+	// CommunityBatchSize は1回のジョブで計算し直すコミュニティの最大数
+	CommunityBatchSize int
+
+	// PurgeInterval は復元期間を過ぎたコミュニティを物理削除するジョブの実行間隔
+	PurgeInterval time.Duration
+}
+
+// LoadConfig は環境変数からバックグラウンドのジョブの設定を読み込む
+func LoadConfig() (Config, error) {
+	cfg := Config{
+		PollInterval:             time.Minute,
+		JobTimeout:               10 * time.Minute,
+		RetryBackoff:             5 * time.Minute,
+		CardRefreshInterval:      time.Hour,
+		CardStaleAfter:           24 * time.Hour,
+		CardBatchSize:            500,
+		CommunityRefreshInterval: 15 * time.Minute,
+		CommunityBatchSize:       50,
+		PurgeInterval:            24 * time.Hour,
+	}
+
+	var err error
+	if cfg.PollInterval, err = config.Duration("WORKER_POLL_INTERVAL", cfg.PollInterval); err != nil {
+		return Config{}, err
+	}
+	if cfg.JobTimeout, err = config.Duration("WORKER_JOB_TIMEOUT", cfg.JobTimeout); err != nil {
+		return Config{}, err
+	}
+	if cfg.RetryBackoff, err = config.Duration("WORKER_RETRY_BACKOFF", cfg.RetryBackoff); err != nil {
+		return Config{}, err
+	}
+	if cfg.CardRefreshInterval, err = config.Duration("WORKER_CARD_REFRESH_INTERVAL", cfg.CardRefreshInterval); err != nil {
+		return Config{}, err
+	}
+	if cfg.CardStaleAfter, err = config.Duration("WORKER_CARD_STALE_AFTER", cfg.CardStaleAfter); err != nil {
+		return Config{}, err
+	}
+	if cfg.CardBatchSize, err = config.Int("WORKER_CARD_BATCH_SIZE", cfg.CardBatchSize); err != nil {
+		return Config{}, err
+	}
+	if cfg.CommunityRefreshInterval, err = config.Duration("WORKER_COMMUNITY_REFRESH_INTERVAL", cfg.CommunityRefreshInterval); err != nil {
+		return Config{}, err
+	}
+	if cfg.CommunityBatchSize, err = config.Int("WORKER_COMMUNITY_BATCH_SIZE", cfg.CommunityBatchSize); err != nil {
+		return Config{}, err
+	}
+	if cfg.PurgeInterval, err = config.Duration("WORKER_PURGE_INTERVAL", cfg.PurgeInterval); err != nil {
+		return Config{}, err
+	}
+
+	if err := cfg.validate(); err != nil {
+		return Config{}, err
+	}
+
+	return cfg, nil
+}
+
+func (c Config) validate() error {
+	if c.PollInterval <= 0 || c.JobTimeout <= 0 || c.RetryBackoff <= 0 {
+		return fmt.Errorf("WORKER_POLL_INTERVAL, WORKER_JOB_TIMEOUT and WORKER_RETRY_BACKOFF must be positive")
+	}
+	if c.CardRefreshInterval <= 0 || c.CommunityRefreshInterval <= 0 || c.PurgeInterval <= 0 {
+		return fmt.Errorf("worker job intervals must be positive")
+	}
+	if c.CardStaleAfter < 0 {
+		return fmt.Errorf("WORKER_CARD_STALE_AFTER must not be negative")
+	}
+	if c.CardBatchSize <= 0 || c.CommunityBatchSize <= 0 {
+		return fmt.Errorf("WORKER_CARD_BATCH_SIZE and WORKER_COMMUNITY_BATCH_SIZE must be positive")
+	}
+
+	return nil
+}
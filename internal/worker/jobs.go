@@ -0,0 +1,53 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/service"
+)
+
+// ジョブの名前
+const (
+	JobRefreshCards       = "refresh-cards"
+	JobRefreshCommunities = "refresh-communities"
+	JobPurgeCommunities   = "purge-communities"
+)
+
+// CardService はジョブが必要とするカードのサービスのインターフェース
+type CardService interface {
+	RefreshStaleCards(ctx context.Context, refreshedBefore time.Time, limit int, githubClient service.GitHubClient) (int, error)
+}
+
+// CommunityService はジョブが必要とするコミュニティのサービスのインターフェース
+type CommunityService interface {
+	RefreshActiveCommunities(ctx context.Context, now time.Time, refreshedBefore time.Time, limit int, githubClient service.GitHubClient) (int, error)
+	PurgeExpiredCommunities(ctx context.Context) (int, error)
+}
+
+// NewJobs は定期的に実行するジョブを作る
+// GitHub APIはユーザーのトークンではなく githubClient（GitHub App）で呼び出す
+func NewJobs(cfg Config, cardService CardService, communityService CommunityService, githubClient service.GitHubClient) []Job {
+	return []Job{
+		{
+			Name:     JobRefreshCards,
+			Interval: cfg.CardRefreshInterval,
+			Run: func(ctx context.Context) (int, error) {
+				return cardService.RefreshStaleCards(ctx, time.Now().Add(-cfg.CardStaleAfter), cfg.CardBatchSize, githubClient)
+			},
+		},
+		{
+			Name:     JobRefreshCommunities,
+			Interval: cfg.CommunityRefreshInterval,
+			Run: func(ctx context.Context) (int, error) {
+				now := time.Now()
+				return communityService.RefreshActiveCommunities(ctx, now, now.Add(-cfg.CommunityRefreshInterval), cfg.CommunityBatchSize, githubClient)
+			},
+		},
+		{
+			Name:     JobPurgeCommunities,
+			Interval: cfg.PurgeInterval,
+			Run:      communityService.PurgeExpiredCommunities,
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+	"github.com/google/uuid"
+)
+
+// lockMargin はジョブのタイムアウトに加えてロックを持っておく時間
+const lockMargin = time.Minute
+
+// maxRetryLookback は続けて失敗した回数を数えるために読み込む実行の記録の最大数
+const maxRetryLookback = 10
+
+// JobRepository はSchedulerが必要とするRepositoryのインターフェース
+type JobRepository interface {
+	TryLock(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error)
+	Unlock(ctx context.Context, name string, owner string) error
+	CreateRun(ctx context.Context, run *domain.JobRun) error
+	UpdateRun(ctx context.Context, run *domain.JobRun) error
+	FindRuns(ctx context.Context, jobName string, limit int) ([]domain.JobRun, error)
+}
+
+// Job は定期的に実行する処理
+type Job struct {
+	Name string
+	// Interval は前回成功してから次に実行するまでの間隔。失敗した場合はこれを上限にバックオフしてやり直す
+	Interval time.Duration
+	// Run は処理を実行し、処理したカードやコミュニティの数を返す
+	Run func(ctx context.Context) (int, error)
+}
+
+// Scheduler はジョブを定期的に実行する
+// 複数のインスタンスで動かしても、PostgreSQLのロックと前回の実行日時で同じジョブは間隔ごとに1回だけ実行する
+type Scheduler struct {
+	repo       JobRepository
+	jobs       []Job
+	config     Config
+	instanceID string
+	now        func() time.Time
+}
+
+func NewScheduler(repo JobRepository, jobs []Job, cfg Config) *Scheduler {
+	return &Scheduler{
+		repo:       repo,
+		jobs:       jobs,
+		config:     cfg,
+		instanceID: uuid.NewString(),
+		now:        time.Now,
+	}
+}
+
+// Run は ctx がキャンセルされるまで、実行する時期になったジョブを実行し続ける
+func (s *Scheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for _, job := range s.jobs {
+			if _, err := s.run(ctx, job, false); err != nil {
+				log.Printf("Job %s: %v", job.Name, err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunJob は指定したジョブを前回の実行日時に関わらずすぐに実行する
+// 他のインスタンスが実行中の場合は実行せずにnilを返す
+func (s *Scheduler) RunJob(ctx context.Context, name string) (*domain.JobRun, error) {
+	for _, job := range s.jobs {
+		if job.Name == name {
+			return s.run(ctx, job, true)
+		}
+	}
+	return nil, fmt.Errorf("%w: unknown job: %s", domain.ErrInvalidArgument, name)
+}
+
+// run はロックを取得してジョブを実行し、実行の記録を返す
+// 他のインスタンスが実行中の場合と、force でなく次に実行する時期（nextRunAt）になっていない場合は実行せずにnilを返す
+func (s *Scheduler) run(ctx context.Context, job Job, force bool) (*domain.JobRun, error) {
+	locked, err := s.repo.TryLock(ctx, job.Name, s.instanceID, s.config.JobTimeout+lockMargin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock job: %w", err)
+	}
+	if !locked {
+		return nil, nil
+	}
+	defer func() {
+		if err := s.repo.Unlock(context.WithoutCancel(ctx), job.Name, s.instanceID); err != nil {
+			log.Printf("Failed to unlock job %s: %v", job.Name, err)
+		}
+	}()
+
+	if !force {
+		runs, err := s.repo.FindRuns(ctx, job.Name, maxRetryLookback)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get last runs: %w", err)
+		}
+		if next, ok := s.nextRunAt(job, runs); ok && s.now().Before(next) {
+			return nil, nil
+		}
+	}
+
+	run := domain.NewJobRun(job.Name, s.instanceID, s.now())
+	if err := s.repo.CreateRun(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to create job run: %w", err)
+	}
+
+	jobCtx, cancel := context.WithTimeout(ctx, s.config.JobTimeout)
+	processed, jobErr := job.Run(jobCtx)
+	cancel()
+
+	run.Finish(s.now(), processed, jobErr)
+	if err := s.repo.UpdateRun(context.WithoutCancel(ctx), run); err != nil {
+		return run, fmt.Errorf("failed to update job run: %w", err)
+	}
+
+	if jobErr != nil {
+		log.Printf("Job %s failed after processing %d: %v", job.Name, processed, jobErr)
+	} else {
+		log.Printf("Job %s succeeded: processed %d", job.Name, processed)
+	}
+	return run, nil
+}
+
+// nextRunAt は新しい順に並んだ実行の記録から、ジョブを次に実行する日時を返す。一度も実行していない場合はfalseを返す
+// 前回成功した場合は Interval の後、失敗した場合は続けて失敗した回数に応じて RetryBackoff を倍にした後（Interval が上限）に実行する
+func (s *Scheduler) nextRunAt(job Job, runs []domain.JobRun) (time.Time, bool) {
+	if len(runs) == 0 {
+		return time.Time{}, false
+	}
+
+	last := runs[0]
+	if last.Status == domain.JobStatusSucceeded {
+		return last.StartedAt.Add(job.Interval), true
+	}
+
+	failures := 0
+	for _, run := range runs {
+		if run.Status == domain.JobStatusSucceeded {
+			break
+		}
+		failures++
+	}
+
+	wait := s.config.RetryBackoff
+	for i := 1; i < failures && wait < job.Interval; i++ {
+		wait *= 2
+	}
+	return last.StartedAt.Add(min(wait, job.Interval)), true
+}
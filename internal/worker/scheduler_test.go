@@ -0,0 +1,191 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/furarico/octo-deck-api/internal/domain"
+)
+
+// fakeJobRepository はメモリに保存するJobRepository
+type fakeJobRepository struct {
+	lockedBy map[string]string
+	runs     []*domain.JobRun
+}
+
+func newFakeJobRepository() *fakeJobRepository {
+	return &fakeJobRepository{lockedBy: make(map[string]string)}
+}
+
+func (r *fakeJobRepository) TryLock(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error) {
+	if _, ok := r.lockedBy[name]; ok {
+		return false, nil
+	}
+	r.lockedBy[name] = owner
+	return true, nil
+}
+
+func (r *fakeJobRepository) Unlock(ctx context.Context, name string, owner string) error {
+	if r.lockedBy[name] == owner {
+		delete(r.lockedBy, name)
+	}
+	return nil
+}
+
+func (r *fakeJobRepository) CreateRun(ctx context.Context, run *domain.JobRun) error {
+	r.runs = append(r.runs, run)
+	return nil
+}
+
+func (r *fakeJobRepository) UpdateRun(ctx context.Context, run *domain.JobRun) error {
+	return nil
+}
+
+func (r *fakeJobRepository) FindRuns(ctx context.Context, jobName string, limit int) ([]domain.JobRun, error) {
+	var runs []domain.JobRun
+	for i := len(r.runs) - 1; i >= 0 && len(runs) < limit; i-- {
+		if r.runs[i].JobName == jobName {
+			runs = append(runs, *r.runs[i])
+		}
+	}
+	return runs, nil
+}
+
+var testConfig = Config{PollInterval: time.Minute, JobTimeout: time.Minute, RetryBackoff: 5 * time.Minute}
+
+func TestScheduler_Run(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	calls := 0
+	job := Job{
+		Name:     "test",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) (int, error) {
+			calls++
+			return 3, nil
+		},
+	}
+	repo := newFakeJobRepository()
+	scheduler := NewScheduler(repo, []Job{job}, testConfig)
+	scheduler.now = func() time.Time { return now }
+
+	run, err := scheduler.run(ctx, job, false)
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	if run == nil || run.Status != domain.JobStatusSucceeded || run.ProcessedCount != 3 {
+		t.Fatalf("run = %+v, want succeeded with 3 processed", run)
+	}
+	if len(repo.lockedBy) != 0 {
+		t.Errorf("lock was not released: %v", repo.lockedBy)
+	}
+
+	// 前回成功してから間隔が過ぎていない場合は実行しない
+	now = now.Add(30 * time.Minute)
+	if run, err := scheduler.run(ctx, job, false); err != nil || run != nil {
+		t.Errorf("run() = %+v, %v, want skipped", run, err)
+	}
+	// すぐに実行する場合は間隔に関わらず実行する
+	if run, err := scheduler.RunJob(ctx, "test"); err != nil || run == nil {
+		t.Errorf("RunJob() = %+v, %v, want run", run, err)
+	}
+
+	now = now.Add(2 * time.Hour)
+	if run, err := scheduler.run(ctx, job, false); err != nil || run == nil {
+		t.Errorf("run() = %+v, %v, want run", run, err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+}
+
+// 他のインスタンスがロックしている場合は実行しない
+func TestScheduler_Locked(t *testing.T) {
+	job := Job{
+		Name:     "test",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) (int, error) {
+			t.Error("job should not run while locked")
+			return 0, nil
+		},
+	}
+	repo := newFakeJobRepository()
+	repo.lockedBy["test"] = "other-instance"
+	scheduler := NewScheduler(repo, []Job{job}, testConfig)
+
+	run, err := scheduler.RunJob(context.Background(), "test")
+	if err != nil || run != nil {
+		t.Errorf("RunJob() = %+v, %v, want skipped", run, err)
+	}
+	if repo.lockedBy["test"] != "other-instance" {
+		t.Errorf("lock of other instance was released")
+	}
+}
+
+// 失敗した実行は記録し、続けて失敗するたびに間隔を倍にして（ジョブの間隔が上限）やり直す
+func TestScheduler_Failed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	calls := 0
+	jobErr := errors.New("github api error")
+	job := Job{
+		Name:     "test",
+		Interval: 15 * time.Minute,
+		Run: func(ctx context.Context) (int, error) {
+			calls++
+			return 1, jobErr
+		},
+	}
+	repo := newFakeJobRepository()
+	scheduler := NewScheduler(repo, []Job{job}, testConfig)
+	scheduler.now = func() time.Time { return now }
+
+	run, err := scheduler.run(ctx, job, false)
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	if run.Status != domain.JobStatusFailed || run.Error != "github api error" || run.FinishedAt == nil {
+		t.Errorf("run = %+v, want failed", run)
+	}
+
+	steps := []struct {
+		name    string
+		advance time.Duration
+		wantRun bool
+	}{
+		{name: "失敗した直後はやり直さない", advance: time.Minute, wantRun: false},
+		{name: "1回目の失敗から RetryBackoff が過ぎたらやり直す", advance: 4 * time.Minute, wantRun: true},
+		{name: "2回続けて失敗したら倍の間隔が過ぎるまでやり直さない", advance: 5 * time.Minute, wantRun: false},
+		{name: "2回続けて失敗してから倍の間隔が過ぎたらやり直す", advance: 5 * time.Minute, wantRun: true},
+		{name: "続けて失敗してもジョブの間隔より長くは待たない", advance: 15 * time.Minute, wantRun: true},
+	}
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		before := calls
+		if _, err := scheduler.run(ctx, job, false); err != nil {
+			t.Fatalf("%s: run() error = %v", step.name, err)
+		}
+		if ran := calls > before; ran != step.wantRun {
+			t.Errorf("%s: ran = %v, want %v", step.name, ran, step.wantRun)
+		}
+	}
+
+	// 成功したら次はジョブの間隔が過ぎるまで実行しない
+	jobErr = nil
+	now = now.Add(15 * time.Minute)
+	if run, err := scheduler.run(ctx, job, false); err != nil || run == nil || run.Status != domain.JobStatusSucceeded {
+		t.Fatalf("run() = %+v, %v, want succeeded", run, err)
+	}
+	now = now.Add(10 * time.Minute)
+	if run, err := scheduler.run(ctx, job, false); err != nil || run != nil {
+		t.Errorf("run() = %+v, %v, want skipped", run, err)
+	}
+
+	if _, err := scheduler.RunJob(ctx, "unknown"); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Errorf("RunJob(unknown) error = %v, want %v", err, domain.ErrInvalidArgument)
+	}
+}